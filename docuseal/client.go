@@ -0,0 +1,143 @@
+// Package docuseal is the importable Go client for the DocuSeal API.
+//
+// A Client groups the API by resource. Each group is an interface, so code that
+// depends on, say, TemplatesService can be unit-tested with the in-memory fakes in
+// the docusealfake package instead of an HTTP server:
+//
+//	client := docuseal.NewClient("https://docuseal.example.com", apiKey, docuseal.WithTimeout(10*time.Second))
+//	pending, err := client.Submissions.List(ctx, &docuseal.ListSubmissionsOptions{Status: "pending", Limit: 50})
+//
+// List methods take an options struct; a nil options pointer means no filters.
+// The package follows semantic versioning with the CLI: exported identifiers are
+// not removed or changed incompatibly within a major version.
+package docuseal
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+)
+
+// Client gives access to the DocuSeal API.
+type Client struct {
+	Templates   TemplatesService
+	Submissions SubmissionsService
+	Submitters  SubmittersService
+	Webhooks    WebhooksService
+}
+
+// NewClient returns a Client for the DocuSeal instance at baseURL (e.g.
+// https://docuseal.example.com; "/api" is appended when missing, see WithAPIPath).
+func NewClient(baseURL, apiKey string, opts ...Option) *Client {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	c := api.NewWithOptions(baseURL, apiKey, o.client...)
+	return &Client{
+		Templates:   &templatesService{c},
+		Submissions: &submissionsService{c},
+		Submitters:  &submittersService{c},
+		Webhooks:    &webhooksService{c},
+	}
+}
+
+// Option configures a Client.
+type Option func(*options)
+
+// options collects the settings of the internal client.
+type options struct {
+	client []api.ClientOption
+}
+
+func withClientOption(opt api.ClientOption) Option {
+	return func(o *options) { o.client = append(o.client, opt) }
+}
+
+// WithTimeout sets the timeout of each HTTP request (default 30s).
+func WithTimeout(d time.Duration) Option { return withClientOption(api.WithTimeout(d)) }
+
+// WithRetries sets how often rate-limited (HTTP 429) requests are retried (default 3).
+func WithRetries(n int) Option { return withClientOption(api.WithRetries(n)) }
+
+// WithRetryBaseDelay sets the base delay of the exponential backoff between retries.
+func WithRetryBaseDelay(d time.Duration) Option {
+	return withClientOption(api.WithRetryBaseDelay(d))
+}
+
+// WithInsecureSkipVerify disables TLS certificate verification. Only use it for testing.
+func WithInsecureSkipVerify() Option { return withClientOption(api.WithInsecureSkipVerify()) }
+
+// WithAPIPath sets the path of the API below baseURL (default "/api"; "/" for baseURL
+// itself), for instances behind a path-based reverse proxy.
+func WithAPIPath(path string) Option { return withClientOption(api.WithAPIPath(path)) }
+
+// WithHeaders sends extra headers with every request, e.g. those an access gateway requires.
+func WithHeaders(h http.Header) Option { return withClientOption(api.WithHeaders(h)) }
+
+// WithLogger sends retries and circuit breaker trips to l, and with debug enabled a
+// record of each request and response, secrets redacted.
+func WithLogger(l *slog.Logger) Option { return withClientOption(api.WithLogger(l)) }
+
+// APIError is a non-success response from the API. Use errors.As to inspect the
+// errors returned by the client.
+type APIError struct {
+	StatusCode int
+	// Body is the full response body, which may contain sensitive data.
+	Body string
+
+	msg string // the client's message, with the body shortened and redacted
+}
+
+func (e *APIError) Error() string {
+	if e.msg != "" {
+		return e.msg
+	}
+	return fmt.Sprintf("API error (status %d): %s", e.StatusCode, e.Body)
+}
+
+// AuthError means the API key was rejected.
+type AuthError struct {
+	Reason string
+}
+
+func (e *AuthError) Error() string {
+	return fmt.Sprintf("authentication failed: %s", e.Reason)
+}
+
+// RateLimitError means the API rate limit was exceeded and retries ran out.
+type RateLimitError struct {
+	// RetryAfter is the number of seconds until requests are allowed again.
+	RetryAfter int
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("rate limit exceeded, retry after %d seconds", e.RetryAfter)
+}
+
+// ValidationError means a request was rejected before it was sent.
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("validation error on field '%s': %s", e.Field, e.Message)
+}
+
+// CircuitBreakerError means requests are paused after repeated failures.
+type CircuitBreakerError struct{}
+
+func (e *CircuitBreakerError) Error() string {
+	return "circuit breaker open: too many consecutive failures, requests temporarily blocked"
+}
+
+// IsNotFound reports whether err is an API response with status 404.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
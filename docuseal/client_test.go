@@ -0,0 +1,72 @@
+package docuseal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestSubmissionsList_OptionsBecomeQuery(t *testing.T) {
+	var got url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/submissions" {
+			t.Errorf("path = %s, want /api/submissions", r.URL.Path)
+		}
+		got = r.URL.Query()
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": []Submission{{ID: 7}}})
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-key", WithRetries(0))
+	subs, err := client.Submissions.List(context.Background(), &ListSubmissionsOptions{
+		Limit: 5, TemplateID: 3, Status: "pending", Query: "jane", After: 10,
+	})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(subs) != 1 || subs[0].ID != 7 {
+		t.Errorf("List() = %+v, want one submission with ID 7", subs)
+	}
+	want := map[string]string{"limit": "5", "template_id": "3", "status": "pending", "q": "jane", "after": "10"}
+	for k, v := range want {
+		if got.Get(k) != v {
+			t.Errorf("query %s = %q, want %q (query %v)", k, got.Get(k), v, got)
+		}
+	}
+	if got.Has("archived") || got.Has("before") {
+		t.Errorf("unset options should not be sent, got %v", got)
+	}
+}
+
+func TestTemplatesList_NilOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "" {
+			t.Errorf("query = %q, want none", r.URL.RawQuery)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": []Template{}})
+	}))
+	defer server.Close()
+
+	if _, err := NewClient(server.URL, "test-key").Templates.List(context.Background(), nil); err != nil {
+		t.Fatalf("List(nil) error = %v", err)
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"error":"Not found"}`, http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	_, err := NewClient(server.URL, "test-key", WithRetries(0)).Webhooks.Get(context.Background(), 1)
+	if !IsNotFound(err) {
+		t.Errorf("IsNotFound(%v) = false, want true", err)
+	}
+	if IsNotFound(errors.New("boom")) {
+		t.Error("IsNotFound(plain error) = true, want false")
+	}
+}
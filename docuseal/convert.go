@@ -0,0 +1,290 @@
+package docuseal
+
+import (
+	"errors"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+)
+
+// The SDK owns its types so internal changes cannot leak into its API. The functions
+// below copy values across the boundary; TestTypesMatchAPI fails when a field is added
+// to one side only.
+
+// convertSlice maps in with f, keeping nil slices nil so they encode the same.
+func convertSlice[T, U any](in []T, f func(T) U) []U {
+	if in == nil {
+		return nil
+	}
+	out := make([]U, len(in))
+	for i, v := range in {
+		out[i] = f(v)
+	}
+	return out
+}
+
+// convertPtr maps in with f, keeping nil pointers nil.
+func convertPtr[T, U any](in *T, f func(T) U) *U {
+	if in == nil {
+		return nil
+	}
+	out := f(*in)
+	return &out
+}
+
+func fromAPITemplate(t api.Template) Template {
+	return Template{
+		ID:             t.ID,
+		Slug:           t.Slug,
+		Name:           t.Name,
+		FolderName:     t.FolderName,
+		CreatedAt:      t.CreatedAt,
+		UpdatedAt:      t.UpdatedAt,
+		ArchivedAt:     t.ArchivedAt,
+		ExternalID:     t.ExternalID,
+		Source:         t.Source,
+		ApplicationKey: t.ApplicationKey,
+		Fields:         convertSlice(t.Fields, fromAPIField),
+		Submitters:     convertSlice(t.Submitters, fromAPIRole),
+		DocumentsCount: t.DocumentsCount,
+		SharedLink:     t.SharedLink,
+		Preferences:    t.Preferences,
+		Schema:         convertSlice(t.Schema, fromAPISchemaItem),
+		Author:         convertPtr(t.Author, fromAPIUser),
+		FolderID:       t.FolderID,
+		AuthorID:       t.AuthorID,
+	}
+}
+
+func fromAPIField(f api.Field) Field {
+	return Field{
+		UUID:      f.UUID,
+		Name:      f.Name,
+		Type:      f.Type,
+		Required:  f.Required,
+		Submitter: f.Submitter,
+		Areas:     convertSlice(f.Areas, fromAPIArea),
+		Options:   f.Options,
+	}
+}
+
+func fromAPIArea(a api.Area) Area {
+	return Area{X: a.X, Y: a.Y, W: a.W, H: a.H, Page: a.Page}
+}
+
+func fromAPIRole(r api.Role) Role {
+	return Role{UUID: r.UUID, Name: r.Name}
+}
+
+func fromAPISchemaItem(s api.SchemaItem) SchemaItem {
+	return SchemaItem{AttachmentUUID: s.AttachmentUUID, Name: s.Name}
+}
+
+func fromAPITemplateRef(t api.TemplateRef) TemplateRef {
+	return TemplateRef{ID: t.ID, Name: t.Name, CreatedAt: t.CreatedAt, UpdatedAt: t.UpdatedAt}
+}
+
+func fromAPISubmission(s api.Submission) Submission {
+	return Submission{
+		ID:                  s.ID,
+		Slug:                s.Slug,
+		Source:              s.Source,
+		Status:              s.Status,
+		CreatedAt:           s.CreatedAt,
+		UpdatedAt:           s.UpdatedAt,
+		ArchivedAt:          s.ArchivedAt,
+		CompletedAt:         s.CompletedAt,
+		TemplateID:          s.TemplateID,
+		TemplateName:        s.TemplateName,
+		Submitters:          convertSlice(s.Submitters, fromAPISubmitter),
+		Documents:           convertSlice(s.Documents, fromAPIDocument),
+		Name:                s.Name,
+		SubmittersOrder:     s.SubmittersOrder,
+		AuditLogURL:         s.AuditLogURL,
+		CombinedDocumentURL: s.CombinedDocumentURL,
+		ExpireAt:            s.ExpireAt,
+	}
+}
+
+func fromAPISubmitter(s api.Submitter) Submitter {
+	return Submitter{
+		ID:               s.ID,
+		Slug:             s.Slug,
+		SubmissionID:     s.SubmissionID,
+		UUID:             s.UUID,
+		Email:            s.Email,
+		Phone:            s.Phone,
+		Name:             s.Name,
+		Role:             s.Role,
+		Status:           s.Status,
+		SentAt:           s.SentAt,
+		OpenedAt:         s.OpenedAt,
+		CompletedAt:      s.CompletedAt,
+		DeclinedAt:       s.DeclinedAt,
+		CreatedAt:        s.CreatedAt,
+		UpdatedAt:        s.UpdatedAt,
+		ExternalID:       s.ExternalID,
+		ApplicationKey:   s.ApplicationKey,
+		Metadata:         s.Metadata,
+		Values:           convertSlice(s.Values, fromAPIFieldValue),
+		Documents:        convertSlice(s.Documents, fromAPIDocument),
+		EmbedSrc:         s.EmbedSrc,
+		Preferences:      s.Preferences,
+		Template:         convertPtr(s.Template, fromAPITemplateRef),
+		SubmissionEvents: convertSlice(s.SubmissionEvents, fromAPISubmissionEvent),
+	}
+}
+
+func fromAPISubmissionEvent(e api.SubmissionEvent) SubmissionEvent {
+	return SubmissionEvent{ID: e.ID, SubmitterID: e.SubmitterID, EventType: e.EventType, EventTimestamp: e.EventTimestamp}
+}
+
+func fromAPIFieldValue(v api.FieldValue) FieldValue {
+	return FieldValue{Field: v.Field, Value: v.Value}
+}
+
+func fromAPIDocument(d api.Document) Document {
+	return Document{Name: d.Name, URL: d.URL}
+}
+
+func fromAPIUser(u api.User) User {
+	return User{ID: u.ID, FirstName: u.FirstName, LastName: u.LastName, Email: u.Email}
+}
+
+func fromAPIWebhook(w api.Webhook) Webhook {
+	return Webhook{
+		ID:        w.ID,
+		URL:       w.URL,
+		Events:    w.Events,
+		Secret:    w.Secret,
+		Active:    w.Active,
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+	}
+}
+
+func fromAPIArchiveResponse(r api.ArchiveResponse) ArchiveResponse {
+	return ArchiveResponse{ID: r.ID, ArchivedAt: r.ArchivedAt}
+}
+
+func toAPICreateSubmissionRequest(r CreateSubmissionRequest) api.CreateSubmissionRequest {
+	return api.CreateSubmissionRequest{
+		TemplateID:           r.TemplateID,
+		SendEmail:            r.SendEmail,
+		SendSMS:              r.SendSMS,
+		Order:                r.Order,
+		Message:              convertPtr(r.Message, toAPIMessage),
+		CompletedRedirectURL: r.CompletedRedirectURL,
+		BCCCompleted:         r.BCCCompleted,
+		ReplyTo:              r.ReplyTo,
+		ExpireAt:             r.ExpireAt,
+		Submitters:           convertSlice(r.Submitters, toAPISubmitterRequest),
+	}
+}
+
+func toAPICreateSubmissionsFromEmailsRequest(r CreateSubmissionsFromEmailsRequest) api.CreateSubmissionsFromEmailsRequest {
+	return api.CreateSubmissionsFromEmailsRequest{
+		TemplateID: r.TemplateID,
+		Emails:     r.Emails,
+		SendEmail:  r.SendEmail,
+		Message:    convertPtr(r.Message, toAPIMessage),
+	}
+}
+
+func toAPISubmitterRequest(r SubmitterRequest) api.SubmitterRequest {
+	return api.SubmitterRequest{Email: r.Email, Name: r.Name, Phone: r.Phone, Role: r.Role, Values: r.Values}
+}
+
+func toAPIMessage(m Message) api.Message {
+	return api.Message{Subject: m.Subject, Body: m.Body}
+}
+
+func toAPIUpdateSubmitterRequest(r UpdateSubmitterRequest) api.UpdateSubmitterRequest {
+	return api.UpdateSubmitterRequest{
+		Email:                r.Email,
+		Name:                 r.Name,
+		Phone:                r.Phone,
+		Completed:            r.Completed,
+		SendEmail:            r.SendEmail,
+		SendSMS:              r.SendSMS,
+		Values:               r.Values,
+		Metadata:             r.Metadata,
+		Message:              convertPtr(r.Message, toAPIMessage),
+		ExternalID:           r.ExternalID,
+		ReplyTo:              r.ReplyTo,
+		CompletedRedirectURL: r.CompletedRedirectURL,
+		RequirePhone2FA:      r.RequirePhone2FA,
+		Fields:               convertSlice(r.Fields, toAPIFieldConfig),
+	}
+}
+
+func toAPIFieldConfig(f FieldConfig) api.FieldConfig {
+	return api.FieldConfig{Name: f.Name, DefaultValue: f.DefaultValue, ReadOnly: f.ReadOnly, Validation: f.Validation}
+}
+
+func toAPIUpdateTemplateDocumentsRequest(r UpdateTemplateDocumentsRequest) api.UpdateTemplateDocumentsRequest {
+	return api.UpdateTemplateDocumentsRequest{
+		Documents: convertSlice(r.Documents, toAPITemplateDocumentOperation),
+		Merge:     r.Merge,
+	}
+}
+
+func toAPITemplateDocumentOperation(o TemplateDocumentOperation) api.TemplateDocumentOperation {
+	return api.TemplateDocumentOperation{
+		File:     o.File,
+		HTML:     o.HTML,
+		Name:     o.Name,
+		Position: o.Position,
+		Replace:  o.Replace,
+		Remove:   o.Remove,
+	}
+}
+
+func toAPICreateWebhookRequest(r CreateWebhookRequest) api.CreateWebhookRequest {
+	return api.CreateWebhookRequest{URL: r.URL, Events: r.Events}
+}
+
+func toAPIUpdateWebhookRequest(r UpdateWebhookRequest) api.UpdateWebhookRequest {
+	return api.UpdateWebhookRequest{URL: r.URL, Events: r.Events, Active: r.Active}
+}
+
+// fromAPIError replaces the client's typed errors with the SDK's, keeping the message.
+func fromAPIError(err error) error {
+	var (
+		apiErr       *api.APIError
+		authErr      *api.AuthError
+		rateLimitErr *api.RateLimitError
+		validErr     *api.ValidationError
+		breakerErr   *api.CircuitBreakerError
+	)
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &apiErr):
+		return &APIError{StatusCode: apiErr.StatusCode, Body: apiErr.Body, msg: apiErr.Error()}
+	case errors.As(err, &authErr):
+		return &AuthError{Reason: authErr.Reason}
+	case errors.As(err, &rateLimitErr):
+		return &RateLimitError{RetryAfter: rateLimitErr.RetryAfter}
+	case errors.As(err, &validErr):
+		return &ValidationError{Field: validErr.Field, Message: validErr.Message}
+	case errors.As(err, &breakerErr):
+		return &CircuitBreakerError{}
+	}
+	return err
+}
+
+// result converts the value and error of a client call.
+func result[T, U any](v *T, err error, f func(T) U) (*U, error) {
+	if err != nil {
+		return nil, fromAPIError(err)
+	}
+	return convertPtr(v, f), nil
+}
+
+// results converts the slice and error of a client call.
+func results[T, U any](v []T, err error, f func(T) U) ([]U, error) {
+	if err != nil {
+		return nil, fromAPIError(err)
+	}
+	return convertSlice(v, f), nil
+}
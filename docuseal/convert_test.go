@@ -0,0 +1,105 @@
+package docuseal
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+)
+
+// filled returns a T with every field set, the same way for any type, so types with
+// the same fields encode to the same JSON.
+func filled[T any]() T {
+	var v T
+	fill(reflect.ValueOf(&v).Elem())
+	return v
+}
+
+func fill(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Struct:
+		if v.Type() == reflect.TypeOf(time.Time{}) {
+			v.Set(reflect.ValueOf(time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)))
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				fill(v.Field(i))
+			}
+		}
+	case reflect.Pointer:
+		v.Set(reflect.New(v.Type().Elem()))
+		fill(v.Elem())
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		fill(v.Index(0))
+	case reflect.Map:
+		v.Set(reflect.MakeMap(v.Type()))
+		v.SetMapIndex(reflect.ValueOf("key"), reflect.ValueOf("value"))
+	case reflect.Interface:
+		v.Set(reflect.ValueOf("value"))
+	case reflect.String:
+		v.SetString("value")
+	case reflect.Int:
+		v.SetInt(7)
+	case reflect.Float64:
+		v.SetFloat(0.5)
+	case reflect.Bool:
+		v.SetBool(true)
+	}
+}
+
+func jsonString(t *testing.T, v any) string {
+	t.Helper()
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+// checkConversion fails when From and To differ in fields or tags, or when convert
+// drops a field.
+func checkConversion[From, To any](t *testing.T, convert func(From) To) {
+	t.Helper()
+	name := reflect.TypeOf((*To)(nil)).Elem().Name()
+	from, to := jsonString(t, filled[From]()), jsonString(t, filled[To]())
+	if from != to {
+		t.Errorf("%s fields differ:\n%s\n%s", name, from, to)
+	}
+	if got := jsonString(t, convert(filled[From]())); got != from {
+		t.Errorf("%s conversion drops fields:\n got %s\nwant %s", name, got, from)
+	}
+}
+
+func TestTypesMatchAPI(t *testing.T) {
+	checkConversion(t, fromAPITemplate)
+	checkConversion(t, fromAPISubmission)
+	checkConversion(t, fromAPISubmitter)
+	checkConversion(t, fromAPIWebhook)
+	checkConversion(t, fromAPIArchiveResponse)
+	checkConversion(t, fromAPIDocument)
+
+	checkConversion(t, toAPICreateSubmissionRequest)
+	checkConversion(t, toAPICreateSubmissionsFromEmailsRequest)
+	checkConversion(t, toAPIUpdateSubmitterRequest)
+	checkConversion(t, toAPIUpdateTemplateDocumentsRequest)
+	checkConversion(t, toAPICreateWebhookRequest)
+	checkConversion(t, toAPIUpdateWebhookRequest)
+}
+
+func TestFromAPIError(t *testing.T) {
+	err := fromAPIError(&api.APIError{StatusCode: 422, Body: `{"error":"bad","token":"s3cret"}`})
+	apiErr, ok := err.(*APIError)
+	if !ok || apiErr.StatusCode != 422 || apiErr.Error() != (&api.APIError{StatusCode: 422, Body: apiErr.Body}).Error() {
+		t.Errorf("fromAPIError(APIError) = %#v", err)
+	}
+	if _, ok := fromAPIError(&api.RateLimitError{RetryAfter: 3}).(*RateLimitError); !ok {
+		t.Error("fromAPIError(RateLimitError) is not a *RateLimitError")
+	}
+	if fromAPIError(nil) != nil {
+		t.Error("fromAPIError(nil) != nil")
+	}
+}
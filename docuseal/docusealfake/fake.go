@@ -0,0 +1,229 @@
+// Package docusealfake provides in-memory implementations of the docuseal service
+// interfaces, so code built on the SDK can be unit-tested without an HTTP server.
+//
+//	fake := docusealfake.New()
+//	tpl := fake.AddTemplate(docuseal.Template{Name: "NDA"})
+//	svc := NewOnboarding(fake.Client()) // code under test
+//	...
+//	subs, _ := fake.Client().Submissions.List(ctx, &docuseal.ListSubmissionsOptions{TemplateID: tpl.ID})
+//
+// The services share one store and behave like the API where tests are likely to
+// notice: lists are newest first, honor Limit (10 by default) and the After/Before
+// cursors, and hide archived items unless Archived is set; submissions created from a
+// template get submitters that Submitters returns; unknown IDs fail with a 404
+// *docuseal.APIError. File uploads are not read.
+package docusealfake
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docuseal/docuseal-cli/docuseal"
+)
+
+// defaultLimit is the page size the API uses when no limit is given.
+const defaultLimit = 10
+
+// Fake is an in-memory DocuSeal account.
+type Fake struct {
+	// Now stamps created, updated and archived times (default time.Now).
+	Now func() time.Time
+
+	mu          sync.Mutex
+	nextID      int
+	templates   map[int]*docuseal.Template
+	submissions map[int]*docuseal.Submission
+	submitters  map[int]*docuseal.Submitter
+	webhooks    map[int]*docuseal.Webhook
+	errs        map[string]error
+}
+
+// New returns an empty Fake.
+func New() *Fake {
+	return &Fake{
+		Now:         time.Now,
+		templates:   map[int]*docuseal.Template{},
+		submissions: map[int]*docuseal.Submission{},
+		submitters:  map[int]*docuseal.Submitter{},
+		webhooks:    map[int]*docuseal.Webhook{},
+		errs:        map[string]error{},
+	}
+}
+
+// Client returns a docuseal.Client whose services read and write the fake.
+func (f *Fake) Client() *docuseal.Client {
+	return &docuseal.Client{
+		Templates:   (*Templates)(f),
+		Submissions: (*Submissions)(f),
+		Submitters:  (*Submitters)(f),
+		Webhooks:    (*Webhooks)(f),
+	}
+}
+
+// Fail makes every later call of method (e.g. "Templates.Get" or "Submissions.Create")
+// return err. A nil err clears the failure.
+func (f *Fake) Fail(method string, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err == nil {
+		delete(f.errs, method)
+		return
+	}
+	f.errs[method] = err
+}
+
+// AddTemplate stores t, assigning an ID and timestamps when unset, and returns the stored copy.
+func (f *Fake) AddTemplate(t docuseal.Template) *docuseal.Template {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.addTemplate(t)
+}
+
+func (f *Fake) addTemplate(t docuseal.Template) *docuseal.Template {
+	f.stamp(&t.ID, &t.CreatedAt, &t.UpdatedAt)
+	if t.Slug == "" {
+		t.Slug = slug("tpl", t.ID)
+	}
+	f.templates[t.ID] = &t
+	return clone(&t)
+}
+
+// AddSubmission stores s and its submitters, assigning IDs and timestamps when unset,
+// and returns the stored copy.
+func (f *Fake) AddSubmission(s docuseal.Submission) *docuseal.Submission {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.addSubmission(s)
+}
+
+func (f *Fake) addSubmission(s docuseal.Submission) *docuseal.Submission {
+	f.stamp(&s.ID, &s.CreatedAt, &s.UpdatedAt)
+	if s.Slug == "" {
+		s.Slug = slug("sub", s.ID)
+	}
+	if s.Status == "" {
+		s.Status = "pending"
+	}
+	if t, ok := f.templates[s.TemplateID]; ok && s.TemplateName == "" {
+		s.TemplateName = t.Name
+	}
+	for _, sub := range s.Submitters {
+		f.stamp(&sub.ID, &sub.CreatedAt, &sub.UpdatedAt)
+		if sub.Slug == "" {
+			sub.Slug = slug("signer", sub.ID)
+		}
+		sub.SubmissionID = s.ID
+		if sub.Status == "" {
+			sub.Status = "awaiting"
+		}
+		f.submitters[sub.ID] = &sub
+	}
+	// Submitters live in their own map so updates show up in the submission.
+	s.Submitters = nil
+	f.submissions[s.ID] = &s
+	return f.submission(s.ID)
+}
+
+// submission returns a copy of submission id with its current submitters.
+func (f *Fake) submission(id int) *docuseal.Submission {
+	s := clone(f.submissions[id])
+	s.Submitters = f.submittersOf(id)
+	return s
+}
+
+func (f *Fake) submittersOf(submissionID int) []docuseal.Submitter {
+	var out []docuseal.Submitter
+	for _, sub := range f.submitters {
+		if sub.SubmissionID == submissionID {
+			out = append(out, *sub)
+		}
+	}
+	slices.SortFunc(out, func(a, b docuseal.Submitter) int { return a.ID - b.ID })
+	return out
+}
+
+// AddWebhook stores w, assigning an ID and timestamps when unset, and returns the stored copy.
+func (f *Fake) AddWebhook(w docuseal.Webhook) *docuseal.Webhook {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.stamp(&w.ID, &w.CreatedAt, &w.UpdatedAt)
+	f.webhooks[w.ID] = &w
+	return clone(&w)
+}
+
+// stamp assigns the next ID when id is unset and fills zero timestamps. IDs only grow,
+// so newest-first ordering matches creation order.
+func (f *Fake) stamp(id *int, created, updated *time.Time) {
+	if *id == 0 {
+		f.nextID++
+		*id = f.nextID
+	} else if *id > f.nextID {
+		f.nextID = *id
+	}
+	now := f.Now().UTC()
+	if created.IsZero() {
+		*created = now
+	}
+	if updated.IsZero() {
+		*updated = *created
+	}
+}
+
+// call returns the error a call of method should fail with: the context's, or the one
+// configured with Fail.
+func (f *Fake) call(ctx context.Context, method string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.errs[method]
+}
+
+// slug derives a stable, unique slug from an ID.
+func slug(prefix string, id int) string {
+	return fmt.Sprintf("%s-%06d", prefix, id)
+}
+
+func notFound(kind string, id int) error {
+	return &docuseal.APIError{StatusCode: http.StatusNotFound, Body: fmt.Sprintf(`{"error":"%s %d not found"}`, kind, id)}
+}
+
+func clone[T any](v *T) *T {
+	c := *v
+	return &c
+}
+
+// page returns copies of the items that keep accepts, newest first, limited and
+// positioned by the API's after/before cursors.
+func page[T any](items map[int]*T, limit, after, before int, keep func(*T) bool) []T {
+	ids := make([]int, 0, len(items))
+	for id, item := range items {
+		if (after > 0 && id <= after) || (before > 0 && id >= before) || !keep(item) {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	slices.Reverse(ids)
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+	out := make([]T, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, *items[id])
+	}
+	return out
+}
+
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
@@ -0,0 +1,112 @@
+package docusealfake
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/docuseal/docuseal-cli/docuseal"
+)
+
+func TestTemplatesList_PagingAndArchive(t *testing.T) {
+	ctx := context.Background()
+	fake := New()
+	for _, name := range []string{"A", "B", "C"} {
+		fake.AddTemplate(docuseal.Template{Name: name, FolderName: "HR"})
+	}
+	c := fake.Client()
+
+	got, err := c.Templates.List(ctx, &docuseal.ListTemplatesOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(got) != 2 || got[0].Name != "C" || got[1].Name != "B" {
+		t.Fatalf("List(limit 2) = %v, want C, B", names(got))
+	}
+	got, _ = c.Templates.List(ctx, &docuseal.ListTemplatesOptions{Before: got[1].ID})
+	if len(got) != 1 || got[0].Name != "A" {
+		t.Fatalf("List(before B) = %v, want A", names(got))
+	}
+
+	if _, err := c.Templates.Archive(ctx, got[0].ID); err != nil {
+		t.Fatalf("Archive() error = %v", err)
+	}
+	active, _ := c.Templates.List(ctx, nil)
+	archived, _ := c.Templates.List(ctx, &docuseal.ListTemplatesOptions{Archived: true})
+	if len(active) != 2 || len(archived) != 1 || archived[0].Name != "A" {
+		t.Errorf("active = %v, archived = %v", names(active), names(archived))
+	}
+}
+
+func TestSubmissionsCreate_SharesSubmitters(t *testing.T) {
+	ctx := context.Background()
+	fake := New()
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	fake.Now = func() time.Time { return now }
+	tpl := fake.AddTemplate(docuseal.Template{Name: "NDA"})
+	c := fake.Client()
+
+	signers, err := c.Submissions.Create(ctx, &docuseal.CreateSubmissionRequest{
+		TemplateID: tpl.ID,
+		Submitters: []docuseal.SubmitterRequest{{Email: "jane@example.com", Role: "Signer"}},
+	})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if len(signers) != 1 || signers[0].Status != "awaiting" || signers[0].SubmissionID == 0 {
+		t.Fatalf("Create() = %+v, want one awaiting submitter", signers)
+	}
+
+	if _, err := c.Submitters.Update(ctx, signers[0].ID, &docuseal.UpdateSubmitterRequest{Completed: true}); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	sub, err := c.Submissions.Get(ctx, signers[0].SubmissionID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if sub.Status != "completed" || sub.TemplateName != "NDA" || !sub.CompletedAt.Equal(now) {
+		t.Errorf("submission = %+v, want completed NDA at %v", sub, now)
+	}
+	if len(sub.Submitters) != 1 || sub.Submitters[0].Status != "completed" {
+		t.Errorf("submission submitters = %+v, want the updated submitter", sub.Submitters)
+	}
+
+	found, _ := c.Submissions.List(ctx, &docuseal.ListSubmissionsOptions{Query: "JANE"})
+	if len(found) != 1 {
+		t.Errorf("List(query) = %d submissions, want 1", len(found))
+	}
+}
+
+func TestFake_ErrorsAndNotFound(t *testing.T) {
+	ctx := context.Background()
+	fake := New()
+	c := fake.Client()
+
+	if _, err := c.Submitters.Get(ctx, 42); !docuseal.IsNotFound(err) {
+		t.Errorf("Get(missing) error = %v, want not found", err)
+	}
+	if _, err := c.Submissions.Create(ctx, &docuseal.CreateSubmissionRequest{
+		TemplateID: 9, Submitters: []docuseal.SubmitterRequest{{Email: "a@example.com"}},
+	}); !docuseal.IsNotFound(err) {
+		t.Errorf("Create(missing template) error = %v, want not found", err)
+	}
+
+	boom := errors.New("boom")
+	fake.Fail("Webhooks.List", boom)
+	if _, err := c.Webhooks.List(ctx, nil); !errors.Is(err, boom) {
+		t.Errorf("List() error = %v, want %v", err, boom)
+	}
+	fake.Fail("Webhooks.List", nil)
+	if _, err := c.Webhooks.List(ctx, nil); err != nil {
+		t.Errorf("List() after clearing error = %v", err)
+	}
+}
+
+func names(templates []docuseal.Template) []string {
+	var out []string
+	for _, t := range templates {
+		out = append(out, t.Name)
+	}
+	return out
+}
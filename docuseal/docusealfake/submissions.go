@@ -0,0 +1,204 @@
+package docusealfake
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/docuseal/docuseal-cli/docuseal"
+)
+
+// Submissions implements docuseal.SubmissionsService on a Fake.
+type Submissions Fake
+
+var _ docuseal.SubmissionsService = (*Submissions)(nil)
+
+func (s *Submissions) List(ctx context.Context, opts *docuseal.ListSubmissionsOptions) ([]docuseal.Submission, error) {
+	f := (*Fake)(s)
+	if err := f.call(ctx, "Submissions.List"); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &docuseal.ListSubmissionsOptions{}
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	items := page(f.submissions, opts.Limit, opts.After, opts.Before, func(sub *docuseal.Submission) bool {
+		if (sub.ArchivedAt != nil) != opts.Archived ||
+			(opts.TemplateID != 0 && sub.TemplateID != opts.TemplateID) ||
+			(opts.Status != "" && sub.Status != opts.Status) ||
+			(opts.Slug != "" && sub.Slug != opts.Slug) {
+			return false
+		}
+		if opts.TemplateFolder != "" {
+			t, ok := f.templates[sub.TemplateID]
+			if !ok || t.FolderName != opts.TemplateFolder {
+				return false
+			}
+		}
+		if opts.Query != "" {
+			for _, signer := range f.submittersOf(sub.ID) {
+				if matchesQuery(&signer, opts.Query) {
+					return true
+				}
+			}
+			return containsFold(sub.Name, opts.Query)
+		}
+		return true
+	})
+	for i := range items {
+		items[i].Submitters = f.submittersOf(items[i].ID)
+	}
+	return items, nil
+}
+
+func (s *Submissions) Get(ctx context.Context, id int) (*docuseal.Submission, error) {
+	f := (*Fake)(s)
+	if err := f.call(ctx, "Submissions.Get"); err != nil {
+		return nil, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.submissions[id]; !ok {
+		return nil, notFound("submission", id)
+	}
+	return f.submission(id), nil
+}
+
+func (s *Submissions) Create(ctx context.Context, req *docuseal.CreateSubmissionRequest) ([]docuseal.Submitter, error) {
+	sub, err := s.fromTemplate(ctx, "Submissions.Create", req)
+	if err != nil {
+		return nil, err
+	}
+	return sub.Submitters, nil
+}
+
+func (s *Submissions) Init(ctx context.Context, req *docuseal.CreateSubmissionRequest) (*docuseal.Submission, error) {
+	return s.fromTemplate(ctx, "Submissions.Init", req)
+}
+
+// fromTemplate stores a submission of req.TemplateID with one submitter per request entry.
+func (s *Submissions) fromTemplate(ctx context.Context, method string, req *docuseal.CreateSubmissionRequest) (*docuseal.Submission, error) {
+	f := (*Fake)(s)
+	if err := f.call(ctx, method); err != nil {
+		return nil, err
+	}
+	if len(req.Submitters) == 0 {
+		return nil, &docuseal.ValidationError{Field: "submitters", Message: "at least one submitter is required"}
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.templates[req.TemplateID]; !ok {
+		return nil, notFound("template", req.TemplateID)
+	}
+	return f.addSubmission(docuseal.Submission{
+		TemplateID:      req.TemplateID,
+		Source:          "api",
+		SubmittersOrder: req.Order,
+		Submitters:      submittersFrom(req.Submitters),
+	}), nil
+}
+
+func (s *Submissions) CreateFromPDF(ctx context.Context, filePath string, opts *docuseal.CreateSubmissionFromDocumentOptions) (*docuseal.Submission, error) {
+	return s.fromDocument(ctx, "Submissions.CreateFromPDF", opts)
+}
+
+func (s *Submissions) CreateFromDOCX(ctx context.Context, filePath string, opts *docuseal.CreateSubmissionFromDocumentOptions) (*docuseal.Submission, error) {
+	return s.fromDocument(ctx, "Submissions.CreateFromDOCX", opts)
+}
+
+func (s *Submissions) CreateFromHTML(ctx context.Context, html string, opts *docuseal.CreateSubmissionFromDocumentOptions) (*docuseal.Submission, error) {
+	return s.fromDocument(ctx, "Submissions.CreateFromHTML", opts)
+}
+
+func (s *Submissions) fromDocument(ctx context.Context, method string, opts *docuseal.CreateSubmissionFromDocumentOptions) (*docuseal.Submission, error) {
+	f := (*Fake)(s)
+	if err := f.call(ctx, method); err != nil {
+		return nil, err
+	}
+	if opts == nil || len(opts.Submitters) == 0 {
+		return nil, &docuseal.ValidationError{Field: "submitters", Message: "at least one submitter is required"}
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.addSubmission(docuseal.Submission{
+		Name:       opts.Name,
+		Source:     "api",
+		Submitters: submittersFrom(opts.Submitters),
+	}), nil
+}
+
+// CreateFromEmails stores one single-signer submission per comma- or space-separated address.
+func (s *Submissions) CreateFromEmails(ctx context.Context, req *docuseal.CreateSubmissionsFromEmailsRequest) ([]docuseal.Submitter, error) {
+	f := (*Fake)(s)
+	if err := f.call(ctx, "Submissions.CreateFromEmails"); err != nil {
+		return nil, err
+	}
+	emails := strings.FieldsFunc(req.Emails, func(r rune) bool { return r == ',' || r == ' ' || r == '\n' })
+	if len(emails) == 0 {
+		return nil, &docuseal.ValidationError{Field: "emails", Message: "at least one email is required"}
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	t, ok := f.templates[req.TemplateID]
+	if !ok {
+		return nil, notFound("template", req.TemplateID)
+	}
+	role := "First Party"
+	if len(t.Submitters) > 0 {
+		role = t.Submitters[0].Name
+	}
+	var out []docuseal.Submitter
+	for _, email := range emails {
+		sub := f.addSubmission(docuseal.Submission{
+			TemplateID: req.TemplateID,
+			Source:     "api",
+			Submitters: []docuseal.Submitter{{Email: email, Role: role}},
+		})
+		out = append(out, sub.Submitters...)
+	}
+	return out, nil
+}
+
+func (s *Submissions) Documents(ctx context.Context, id int) ([]docuseal.Document, error) {
+	f := (*Fake)(s)
+	if err := f.call(ctx, "Submissions.Documents"); err != nil {
+		return nil, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	sub, ok := f.submissions[id]
+	if !ok {
+		return nil, notFound("submission", id)
+	}
+	return append([]docuseal.Document(nil), sub.Documents...), nil
+}
+
+func (s *Submissions) Archive(ctx context.Context, id int) (*docuseal.ArchiveResponse, error) {
+	f := (*Fake)(s)
+	if err := f.call(ctx, "Submissions.Archive"); err != nil {
+		return nil, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	sub, ok := f.submissions[id]
+	if !ok {
+		return nil, notFound("submission", id)
+	}
+	now := f.Now().UTC()
+	sub.ArchivedAt, sub.UpdatedAt = &now, now
+	return &docuseal.ArchiveResponse{ID: id, ArchivedAt: now}, nil
+}
+
+func submittersFrom(reqs []docuseal.SubmitterRequest) []docuseal.Submitter {
+	out := make([]docuseal.Submitter, 0, len(reqs))
+	for _, r := range reqs {
+		signer := docuseal.Submitter{Email: r.Email, Name: r.Name, Phone: r.Phone, Role: r.Role}
+		for field, value := range r.Values {
+			signer.Values = append(signer.Values, docuseal.FieldValue{Field: field, Value: value})
+		}
+		slices.SortFunc(signer.Values, func(a, b docuseal.FieldValue) int { return strings.Compare(a.Field, b.Field) })
+		out = append(out, signer)
+	}
+	return out
+}
@@ -0,0 +1,113 @@
+package docusealfake
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/docuseal/docuseal-cli/docuseal"
+)
+
+// Submitters implements docuseal.SubmittersService on a Fake.
+type Submitters Fake
+
+var _ docuseal.SubmittersService = (*Submitters)(nil)
+
+func (s *Submitters) List(ctx context.Context, opts *docuseal.ListSubmittersOptions) ([]docuseal.Submitter, error) {
+	f := (*Fake)(s)
+	if err := f.call(ctx, "Submitters.List"); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &docuseal.ListSubmittersOptions{}
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return page(f.submitters, opts.Limit, opts.After, opts.Before, func(signer *docuseal.Submitter) bool {
+		return (opts.SubmissionID == 0 || signer.SubmissionID == opts.SubmissionID) &&
+			(opts.Slug == "" || signer.Slug == opts.Slug) &&
+			(opts.ExternalID == "" || signer.ExternalID == opts.ExternalID) &&
+			(opts.Query == "" || matchesQuery(signer, opts.Query))
+	}), nil
+}
+
+func (s *Submitters) Get(ctx context.Context, id int) (*docuseal.Submitter, error) {
+	f := (*Fake)(s)
+	if err := f.call(ctx, "Submitters.Get"); err != nil {
+		return nil, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	signer, ok := f.submitters[id]
+	if !ok {
+		return nil, notFound("submitter", id)
+	}
+	return clone(signer), nil
+}
+
+// Update applies the non-empty fields of req. Completed marks the submitter completed,
+// and the submission too once every submitter has completed.
+func (s *Submitters) Update(ctx context.Context, id int, req *docuseal.UpdateSubmitterRequest) (*docuseal.Submitter, error) {
+	f := (*Fake)(s)
+	if err := f.call(ctx, "Submitters.Update"); err != nil {
+		return nil, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	signer, ok := f.submitters[id]
+	if !ok {
+		return nil, notFound("submitter", id)
+	}
+	now := f.Now().UTC()
+	if req.Email != "" {
+		signer.Email = req.Email
+	}
+	if req.Name != "" {
+		signer.Name = req.Name
+	}
+	if req.Phone != "" {
+		signer.Phone = req.Phone
+	}
+	if req.ExternalID != "" {
+		signer.ExternalID = req.ExternalID
+	}
+	if len(req.Metadata) > 0 {
+		signer.Metadata = maps.Clone(req.Metadata)
+	}
+	for _, field := range slices.Sorted(maps.Keys(req.Values)) {
+		i := slices.IndexFunc(signer.Values, func(v docuseal.FieldValue) bool { return v.Field == field })
+		if i < 0 {
+			signer.Values = append(signer.Values, docuseal.FieldValue{Field: field})
+			i = len(signer.Values) - 1
+		}
+		signer.Values[i].Value = req.Values[field]
+	}
+	if req.Completed && signer.CompletedAt == nil {
+		signer.Status, signer.CompletedAt = "completed", &now
+		f.completeSubmission(signer.SubmissionID, now)
+	}
+	signer.UpdatedAt = now
+	return clone(signer), nil
+}
+
+// completeSubmission marks submission id completed when all its submitters have.
+func (f *Fake) completeSubmission(id int, now time.Time) {
+	sub, ok := f.submissions[id]
+	if !ok {
+		return
+	}
+	for _, signer := range f.submittersOf(id) {
+		if signer.CompletedAt == nil {
+			return
+		}
+	}
+	sub.Status, sub.CompletedAt, sub.UpdatedAt = "completed", &now, now
+}
+
+// matchesQuery reports whether query appears in the submitter's name, email or phone.
+func matchesQuery(signer *docuseal.Submitter, query string) bool {
+	return containsFold(signer.Name, query) || containsFold(signer.Email, query) ||
+		strings.Contains(signer.Phone, query)
+}
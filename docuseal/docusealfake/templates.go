@@ -0,0 +1,189 @@
+package docusealfake
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/docuseal/docuseal-cli/docuseal"
+)
+
+// Templates implements docuseal.TemplatesService on a Fake.
+type Templates Fake
+
+var _ docuseal.TemplatesService = (*Templates)(nil)
+
+func (s *Templates) List(ctx context.Context, opts *docuseal.ListTemplatesOptions) ([]docuseal.Template, error) {
+	f := (*Fake)(s)
+	if err := f.call(ctx, "Templates.List"); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &docuseal.ListTemplatesOptions{}
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return page(f.templates, opts.Limit, opts.After, opts.Before, func(t *docuseal.Template) bool {
+		return (t.ArchivedAt != nil) == opts.Archived && (opts.Folder == "" || t.FolderName == opts.Folder)
+	}), nil
+}
+
+func (s *Templates) Get(ctx context.Context, id int) (*docuseal.Template, error) {
+	f := (*Fake)(s)
+	if err := f.call(ctx, "Templates.Get"); err != nil {
+		return nil, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	t, ok := f.templates[id]
+	if !ok {
+		return nil, notFound("template", id)
+	}
+	return clone(t), nil
+}
+
+func (s *Templates) CreateFromPDF(ctx context.Context, filePath string, opts *docuseal.CreateTemplateOptions) (*docuseal.Template, error) {
+	return s.create(ctx, "Templates.CreateFromPDF", filePath, opts)
+}
+
+func (s *Templates) CreateFromDOCX(ctx context.Context, filePath string, opts *docuseal.CreateTemplateOptions) (*docuseal.Template, error) {
+	return s.create(ctx, "Templates.CreateFromDOCX", filePath, opts)
+}
+
+func (s *Templates) CreateFromHTML(ctx context.Context, html string, opts *docuseal.CreateTemplateOptions) (*docuseal.Template, error) {
+	return s.create(ctx, "Templates.CreateFromHTML", "", opts)
+}
+
+// create stores a template named after opts, or after the uploaded file when unnamed.
+func (s *Templates) create(ctx context.Context, method, filePath string, opts *docuseal.CreateTemplateOptions) (*docuseal.Template, error) {
+	f := (*Fake)(s)
+	if err := f.call(ctx, method); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &docuseal.CreateTemplateOptions{}
+	}
+	t := docuseal.Template{Name: opts.Name, FolderName: opts.Folder, ExternalID: opts.ExternalID}
+	if t.Name == "" && filePath != "" {
+		base := filepath.Base(filePath)
+		t.Name = strings.TrimSuffix(base, filepath.Ext(base))
+	}
+	if opts.SharedLink != nil {
+		t.SharedLink = *opts.SharedLink
+	}
+	return f.AddTemplate(t), nil
+}
+
+func (s *Templates) Clone(ctx context.Context, id int, opts *docuseal.CloneTemplateOptions) (*docuseal.Template, error) {
+	f := (*Fake)(s)
+	if err := f.call(ctx, "Templates.Clone"); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &docuseal.CloneTemplateOptions{}
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	src, ok := f.templates[id]
+	if !ok {
+		return nil, notFound("template", id)
+	}
+	t := *src
+	t.ID, t.ArchivedAt, t.ExternalID = 0, nil, ""
+	t.Slug, t.CreatedAt, t.UpdatedAt = "", time.Time{}, time.Time{}
+	if opts.Name != "" {
+		t.Name = opts.Name
+	} else {
+		t.Name = fmt.Sprintf("%s (Clone)", src.Name)
+	}
+	if opts.Folder != "" {
+		t.FolderName = opts.Folder
+	}
+	return f.addTemplate(t), nil
+}
+
+func (s *Templates) Merge(ctx context.Context, ids []int, opts *docuseal.MergeTemplatesOptions) (*docuseal.Template, error) {
+	f := (*Fake)(s)
+	if err := f.call(ctx, "Templates.Merge"); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &docuseal.MergeTemplatesOptions{}
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	t := docuseal.Template{Name: opts.Name, FolderName: opts.Folder}
+	var names []string
+	for _, id := range ids {
+		src, ok := f.templates[id]
+		if !ok {
+			return nil, notFound("template", id)
+		}
+		names = append(names, src.Name)
+		t.Fields = append(t.Fields, src.Fields...)
+		t.Submitters = append(t.Submitters, src.Submitters...)
+		t.Schema = append(t.Schema, src.Schema...)
+	}
+	if t.Name == "" {
+		t.Name = strings.Join(names, " + ")
+	}
+	return f.addTemplate(t), nil
+}
+
+func (s *Templates) Update(ctx context.Context, id int, opts *docuseal.UpdateTemplateOptions) (*docuseal.Template, error) {
+	f := (*Fake)(s)
+	if err := f.call(ctx, "Templates.Update"); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &docuseal.UpdateTemplateOptions{}
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	t, ok := f.templates[id]
+	if !ok {
+		return nil, notFound("template", id)
+	}
+	if opts.Name != "" {
+		t.Name = opts.Name
+	}
+	if opts.Folder != "" {
+		t.FolderName = opts.Folder
+	}
+	t.UpdatedAt = f.Now().UTC()
+	return clone(t), nil
+}
+
+func (s *Templates) Archive(ctx context.Context, id int) (*docuseal.ArchiveResponse, error) {
+	f := (*Fake)(s)
+	if err := f.call(ctx, "Templates.Archive"); err != nil {
+		return nil, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	t, ok := f.templates[id]
+	if !ok {
+		return nil, notFound("template", id)
+	}
+	now := f.Now().UTC()
+	t.ArchivedAt, t.UpdatedAt = &now, now
+	return &docuseal.ArchiveResponse{ID: id, ArchivedAt: now}, nil
+}
+
+// UpdateDocuments records the change as an update; the fake keeps no documents.
+func (s *Templates) UpdateDocuments(ctx context.Context, id int, req *docuseal.UpdateTemplateDocumentsRequest) (*docuseal.Template, error) {
+	f := (*Fake)(s)
+	if err := f.call(ctx, "Templates.UpdateDocuments"); err != nil {
+		return nil, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	t, ok := f.templates[id]
+	if !ok {
+		return nil, notFound("template", id)
+	}
+	t.UpdatedAt = f.Now().UTC()
+	return clone(t), nil
+}
@@ -0,0 +1,88 @@
+package docusealfake
+
+import (
+	"context"
+
+	"github.com/docuseal/docuseal-cli/docuseal"
+)
+
+// Webhooks implements docuseal.WebhooksService on a Fake.
+type Webhooks Fake
+
+var _ docuseal.WebhooksService = (*Webhooks)(nil)
+
+func (s *Webhooks) List(ctx context.Context, opts *docuseal.ListWebhooksOptions) ([]docuseal.Webhook, error) {
+	f := (*Fake)(s)
+	if err := f.call(ctx, "Webhooks.List"); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &docuseal.ListWebhooksOptions{}
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return page(f.webhooks, opts.Limit, opts.After, opts.Before, func(*docuseal.Webhook) bool { return true }), nil
+}
+
+func (s *Webhooks) Get(ctx context.Context, id int) (*docuseal.Webhook, error) {
+	f := (*Fake)(s)
+	if err := f.call(ctx, "Webhooks.Get"); err != nil {
+		return nil, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	w, ok := f.webhooks[id]
+	if !ok {
+		return nil, notFound("webhook", id)
+	}
+	return clone(w), nil
+}
+
+func (s *Webhooks) Create(ctx context.Context, req *docuseal.CreateWebhookRequest) (*docuseal.Webhook, error) {
+	f := (*Fake)(s)
+	if err := f.call(ctx, "Webhooks.Create"); err != nil {
+		return nil, err
+	}
+	if req.URL == "" {
+		return nil, &docuseal.ValidationError{Field: "url", Message: "is required"}
+	}
+	return f.AddWebhook(docuseal.Webhook{URL: req.URL, Events: append([]string(nil), req.Events...), Active: true}), nil
+}
+
+func (s *Webhooks) Update(ctx context.Context, id int, req *docuseal.UpdateWebhookRequest) (*docuseal.Webhook, error) {
+	f := (*Fake)(s)
+	if err := f.call(ctx, "Webhooks.Update"); err != nil {
+		return nil, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	w, ok := f.webhooks[id]
+	if !ok {
+		return nil, notFound("webhook", id)
+	}
+	if req.URL != "" {
+		w.URL = req.URL
+	}
+	if req.Events != nil {
+		w.Events = append([]string(nil), req.Events...)
+	}
+	if req.Active != nil {
+		w.Active = *req.Active
+	}
+	w.UpdatedAt = f.Now().UTC()
+	return clone(w), nil
+}
+
+func (s *Webhooks) Delete(ctx context.Context, id int) error {
+	f := (*Fake)(s)
+	if err := f.call(ctx, "Webhooks.Delete"); err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.webhooks[id]; !ok {
+		return notFound("webhook", id)
+	}
+	delete(f.webhooks, id)
+	return nil
+}
@@ -0,0 +1,114 @@
+package docuseal
+
+import (
+	"context"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+)
+
+// SubmissionsService manages submissions: signing requests sent from a template or document.
+type SubmissionsService interface {
+	List(ctx context.Context, opts *ListSubmissionsOptions) ([]Submission, error)
+	Get(ctx context.Context, id int) (*Submission, error)
+	// Create sends a template for signing and returns the created submitters.
+	Create(ctx context.Context, req *CreateSubmissionRequest) ([]Submitter, error)
+	// CreateFromPDF, CreateFromDOCX and CreateFromHTML send a one-off document without a template.
+	CreateFromPDF(ctx context.Context, filePath string, opts *CreateSubmissionFromDocumentOptions) (*Submission, error)
+	CreateFromDOCX(ctx context.Context, filePath string, opts *CreateSubmissionFromDocumentOptions) (*Submission, error)
+	CreateFromHTML(ctx context.Context, html string, opts *CreateSubmissionFromDocumentOptions) (*Submission, error)
+	// CreateFromEmails creates one submission per email address.
+	CreateFromEmails(ctx context.Context, req *CreateSubmissionsFromEmailsRequest) ([]Submitter, error)
+	// Init creates a submission without notifying anyone, e.g. for embedded signing.
+	Init(ctx context.Context, req *CreateSubmissionRequest) (*Submission, error)
+	Documents(ctx context.Context, id int) ([]Document, error)
+	Archive(ctx context.Context, id int) (*ArchiveResponse, error)
+}
+
+// ListSubmissionsOptions filters SubmissionsService.List.
+type ListSubmissionsOptions struct {
+	// Limit caps the number of submissions returned (server default when 0).
+	Limit      int
+	TemplateID int
+	// Status filters by submission status (e.g. pending or completed).
+	Status string
+	// Query searches submitter names, emails and phone numbers.
+	Query          string
+	Slug           string
+	TemplateFolder string
+	Archived       bool
+	// After and Before page by submission ID.
+	After  int
+	Before int
+}
+
+// CreateSubmissionFromDocumentOptions describes a submission sent from a one-off document.
+type CreateSubmissionFromDocumentOptions struct {
+	Name       string
+	Submitters []SubmitterRequest
+	// Variables fill [[variable]] placeholders; they only apply to CreateFromDOCX.
+	Variables map[string]string
+}
+
+type submissionsService struct{ c *api.Client }
+
+func (s *submissionsService) List(ctx context.Context, opts *ListSubmissionsOptions) ([]Submission, error) {
+	if opts == nil {
+		opts = &ListSubmissionsOptions{}
+	}
+	subs, err := s.c.ListSubmissions(ctx, opts.Limit, opts.TemplateID, opts.Status, opts.Query, opts.Slug, opts.TemplateFolder, opts.Archived, opts.After, opts.Before)
+	return results(subs, err, fromAPISubmission)
+}
+
+func (s *submissionsService) Get(ctx context.Context, id int) (*Submission, error) {
+	sub, err := s.c.GetSubmission(ctx, id)
+	return result(sub, err, fromAPISubmission)
+}
+
+func (s *submissionsService) Create(ctx context.Context, req *CreateSubmissionRequest) ([]Submitter, error) {
+	subs, err := s.c.CreateSubmission(ctx, convertPtr(req, toAPICreateSubmissionRequest))
+	return results(subs, err, fromAPISubmitter)
+}
+
+func (s *submissionsService) CreateFromPDF(ctx context.Context, filePath string, opts *CreateSubmissionFromDocumentOptions) (*Submission, error) {
+	if opts == nil {
+		opts = &CreateSubmissionFromDocumentOptions{}
+	}
+	sub, err := s.c.CreateSubmissionFromPDF(ctx, filePath, convertSlice(opts.Submitters, toAPISubmitterRequest), opts.Name)
+	return result(sub, err, fromAPISubmission)
+}
+
+func (s *submissionsService) CreateFromDOCX(ctx context.Context, filePath string, opts *CreateSubmissionFromDocumentOptions) (*Submission, error) {
+	if opts == nil {
+		opts = &CreateSubmissionFromDocumentOptions{}
+	}
+	sub, err := s.c.CreateSubmissionFromDOCX(ctx, filePath, convertSlice(opts.Submitters, toAPISubmitterRequest), opts.Name, opts.Variables)
+	return result(sub, err, fromAPISubmission)
+}
+
+func (s *submissionsService) CreateFromHTML(ctx context.Context, html string, opts *CreateSubmissionFromDocumentOptions) (*Submission, error) {
+	if opts == nil {
+		opts = &CreateSubmissionFromDocumentOptions{}
+	}
+	sub, err := s.c.CreateSubmissionFromHTML(ctx, html, convertSlice(opts.Submitters, toAPISubmitterRequest), opts.Name)
+	return result(sub, err, fromAPISubmission)
+}
+
+func (s *submissionsService) CreateFromEmails(ctx context.Context, req *CreateSubmissionsFromEmailsRequest) ([]Submitter, error) {
+	subs, err := s.c.CreateSubmissionsFromEmails(ctx, convertPtr(req, toAPICreateSubmissionsFromEmailsRequest))
+	return results(subs, err, fromAPISubmitter)
+}
+
+func (s *submissionsService) Init(ctx context.Context, req *CreateSubmissionRequest) (*Submission, error) {
+	sub, err := s.c.InitSubmission(ctx, convertPtr(req, toAPICreateSubmissionRequest))
+	return result(sub, err, fromAPISubmission)
+}
+
+func (s *submissionsService) Documents(ctx context.Context, id int) ([]Document, error) {
+	docs, err := s.c.GetSubmissionDocuments(ctx, id)
+	return results(docs, err, fromAPIDocument)
+}
+
+func (s *submissionsService) Archive(ctx context.Context, id int) (*ArchiveResponse, error) {
+	res, err := s.c.ArchiveSubmission(ctx, id)
+	return result(res, err, fromAPIArchiveResponse)
+}
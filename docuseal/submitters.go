@@ -0,0 +1,48 @@
+package docuseal
+
+import (
+	"context"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+)
+
+// SubmittersService manages submitters, the parties that sign a submission.
+type SubmittersService interface {
+	List(ctx context.Context, opts *ListSubmittersOptions) ([]Submitter, error)
+	Get(ctx context.Context, id int) (*Submitter, error)
+	Update(ctx context.Context, id int, req *UpdateSubmitterRequest) (*Submitter, error)
+}
+
+// ListSubmittersOptions filters SubmittersService.List.
+type ListSubmittersOptions struct {
+	// Limit caps the number of submitters returned (server default when 0).
+	Limit        int
+	SubmissionID int
+	// Query searches names, emails and phone numbers.
+	Query      string
+	Slug       string
+	ExternalID string
+	// After and Before page by submitter ID.
+	After  int
+	Before int
+}
+
+type submittersService struct{ c *api.Client }
+
+func (s *submittersService) List(ctx context.Context, opts *ListSubmittersOptions) ([]Submitter, error) {
+	if opts == nil {
+		opts = &ListSubmittersOptions{}
+	}
+	subs, err := s.c.ListSubmitters(ctx, opts.Limit, opts.SubmissionID, opts.Query, opts.Slug, opts.ExternalID, opts.After, opts.Before)
+	return results(subs, err, fromAPISubmitter)
+}
+
+func (s *submittersService) Get(ctx context.Context, id int) (*Submitter, error) {
+	sub, err := s.c.GetSubmitter(ctx, id)
+	return result(sub, err, fromAPISubmitter)
+}
+
+func (s *submittersService) Update(ctx context.Context, id int, req *UpdateSubmitterRequest) (*Submitter, error) {
+	sub, err := s.c.UpdateSubmitter(ctx, id, convertPtr(req, toAPIUpdateSubmitterRequest))
+	return result(sub, err, fromAPISubmitter)
+}
@@ -0,0 +1,140 @@
+package docuseal
+
+import (
+	"context"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+)
+
+// TemplatesService manages templates.
+type TemplatesService interface {
+	List(ctx context.Context, opts *ListTemplatesOptions) ([]Template, error)
+	Get(ctx context.Context, id int) (*Template, error)
+	// CreateFromPDF and CreateFromDOCX upload a local file as a new template.
+	CreateFromPDF(ctx context.Context, filePath string, opts *CreateTemplateOptions) (*Template, error)
+	CreateFromDOCX(ctx context.Context, filePath string, opts *CreateTemplateOptions) (*Template, error)
+	CreateFromHTML(ctx context.Context, html string, opts *CreateTemplateOptions) (*Template, error)
+	Clone(ctx context.Context, id int, opts *CloneTemplateOptions) (*Template, error)
+	// Merge combines several templates into a new one.
+	Merge(ctx context.Context, ids []int, opts *MergeTemplatesOptions) (*Template, error)
+	Update(ctx context.Context, id int, opts *UpdateTemplateOptions) (*Template, error)
+	Archive(ctx context.Context, id int) (*ArchiveResponse, error)
+	UpdateDocuments(ctx context.Context, id int, req *UpdateTemplateDocumentsRequest) (*Template, error)
+}
+
+// ListTemplatesOptions filters TemplatesService.List.
+type ListTemplatesOptions struct {
+	// Limit caps the number of templates returned (server default when 0).
+	Limit    int
+	Folder   string
+	Archived bool
+	// After and Before page by template ID.
+	After  int
+	Before int
+}
+
+// CreateTemplateOptions describes a template created from a file or HTML.
+type CreateTemplateOptions struct {
+	Name       string
+	Folder     string
+	ExternalID string
+	// SharedLink enables or disables the public signing link (server default when nil).
+	SharedLink *bool
+
+	// HTMLHeader, HTMLFooter and Size (e.g. "A4", "Letter") only apply to CreateFromHTML.
+	HTMLHeader string
+	HTMLFooter string
+	Size       string
+}
+
+// CloneTemplateOptions names the copy made by TemplatesService.Clone.
+type CloneTemplateOptions struct {
+	Name   string
+	Folder string
+}
+
+// MergeTemplatesOptions names the template made by TemplatesService.Merge.
+type MergeTemplatesOptions struct {
+	Name   string
+	Folder string
+}
+
+// UpdateTemplateOptions holds the fields changed by TemplatesService.Update; empty
+// fields are left unchanged.
+type UpdateTemplateOptions struct {
+	Name   string
+	Folder string
+}
+
+type templatesService struct{ c *api.Client }
+
+func (s *templatesService) List(ctx context.Context, opts *ListTemplatesOptions) ([]Template, error) {
+	if opts == nil {
+		opts = &ListTemplatesOptions{}
+	}
+	tpls, err := s.c.ListTemplates(ctx, opts.Limit, opts.Folder, opts.Archived, opts.After, opts.Before)
+	return results(tpls, err, fromAPITemplate)
+}
+
+func (s *templatesService) Get(ctx context.Context, id int) (*Template, error) {
+	tpl, err := s.c.GetTemplate(ctx, id)
+	return result(tpl, err, fromAPITemplate)
+}
+
+func (s *templatesService) CreateFromPDF(ctx context.Context, filePath string, opts *CreateTemplateOptions) (*Template, error) {
+	if opts == nil {
+		opts = &CreateTemplateOptions{}
+	}
+	tpl, err := s.c.CreateTemplateFromPDF(ctx, opts.Name, filePath, opts.Folder, opts.ExternalID, opts.SharedLink)
+	return result(tpl, err, fromAPITemplate)
+}
+
+func (s *templatesService) CreateFromDOCX(ctx context.Context, filePath string, opts *CreateTemplateOptions) (*Template, error) {
+	if opts == nil {
+		opts = &CreateTemplateOptions{}
+	}
+	tpl, err := s.c.CreateTemplateFromDOCX(ctx, opts.Name, filePath, opts.Folder, opts.ExternalID, opts.SharedLink)
+	return result(tpl, err, fromAPITemplate)
+}
+
+func (s *templatesService) CreateFromHTML(ctx context.Context, html string, opts *CreateTemplateOptions) (*Template, error) {
+	if opts == nil {
+		opts = &CreateTemplateOptions{}
+	}
+	tpl, err := s.c.CreateTemplateFromHTML(ctx, opts.Name, html, opts.Folder, opts.ExternalID, opts.HTMLHeader, opts.HTMLFooter, opts.Size, opts.SharedLink)
+	return result(tpl, err, fromAPITemplate)
+}
+
+func (s *templatesService) Clone(ctx context.Context, id int, opts *CloneTemplateOptions) (*Template, error) {
+	if opts == nil {
+		opts = &CloneTemplateOptions{}
+	}
+	tpl, err := s.c.CloneTemplate(ctx, id, opts.Name, opts.Folder)
+	return result(tpl, err, fromAPITemplate)
+}
+
+func (s *templatesService) Merge(ctx context.Context, ids []int, opts *MergeTemplatesOptions) (*Template, error) {
+	if opts == nil {
+		opts = &MergeTemplatesOptions{}
+	}
+	tpl, err := s.c.MergeTemplates(ctx, ids, opts.Name, opts.Folder)
+	return result(tpl, err, fromAPITemplate)
+}
+
+func (s *templatesService) Update(ctx context.Context, id int, opts *UpdateTemplateOptions) (*Template, error) {
+	if opts == nil {
+		opts = &UpdateTemplateOptions{}
+	}
+	tpl, err := s.c.UpdateTemplate(ctx, id, opts.Name, opts.Folder)
+	return result(tpl, err, fromAPITemplate)
+}
+
+func (s *templatesService) Archive(ctx context.Context, id int) (*ArchiveResponse, error) {
+	res, err := s.c.ArchiveTemplate(ctx, id)
+	return result(res, err, fromAPIArchiveResponse)
+}
+
+func (s *templatesService) UpdateDocuments(ctx context.Context, id int, req *UpdateTemplateDocumentsRequest) (*Template, error) {
+	tpl, err := s.c.UpdateTemplateDocuments(ctx, id, convertPtr(req, toAPIUpdateTemplateDocumentsRequest))
+	return result(tpl, err, fromAPITemplate)
+}
@@ -0,0 +1,254 @@
+package docuseal
+
+import "time"
+
+// Template is a document with fields that submissions are sent from.
+type Template struct {
+	ID             int            `json:"id"`
+	Slug           string         `json:"slug"`
+	Name           string         `json:"name"`
+	FolderName     string         `json:"folder_name"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+	ArchivedAt     *time.Time     `json:"archived_at"`
+	ExternalID     string         `json:"external_id,omitempty"`
+	Source         string         `json:"source,omitempty"`
+	ApplicationKey string         `json:"application_key,omitempty"`
+	Fields         []Field        `json:"fields"`
+	Submitters     []Role         `json:"submitters"`
+	DocumentsCount int            `json:"documents_count,omitempty"`
+	SharedLink     bool           `json:"shared_link,omitempty"`
+	Preferences    map[string]any `json:"preferences,omitempty"`
+	Schema         []SchemaItem   `json:"schema"`
+	Author         *User          `json:"author,omitempty"`
+	FolderID       int            `json:"folder_id,omitempty"`
+	AuthorID       int            `json:"author_id,omitempty"`
+}
+
+// Field is a form field of a template.
+type Field struct {
+	UUID      string   `json:"uuid"`
+	Name      string   `json:"name"`
+	Type      string   `json:"type"`
+	Required  bool     `json:"required"`
+	Submitter string   `json:"submitter_uuid,omitempty"`
+	Areas     []Area   `json:"areas"`
+	Options   []string `json:"options"`
+}
+
+// Area places a field on a document page.
+type Area struct {
+	X    float64 `json:"x"`
+	Y    float64 `json:"y"`
+	W    float64 `json:"w"`
+	H    float64 `json:"h"`
+	Page int     `json:"page"`
+}
+
+// Role is a submitter role of a template, e.g. "Signer".
+type Role struct {
+	UUID string `json:"uuid"`
+	Name string `json:"name"`
+}
+
+// SchemaItem is a document attached to a template.
+type SchemaItem struct {
+	AttachmentUUID string `json:"attachment_uuid"`
+	Name           string `json:"name"`
+}
+
+// TemplateRef is the short form of a template embedded in a submitter.
+type TemplateRef struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Submission is a signing request sent from a template or document.
+type Submission struct {
+	ID                  int         `json:"id"`
+	Slug                string      `json:"slug"`
+	Source              string      `json:"source"`
+	Status              string      `json:"status"`
+	CreatedAt           time.Time   `json:"created_at"`
+	UpdatedAt           time.Time   `json:"updated_at"`
+	ArchivedAt          *time.Time  `json:"archived_at"`
+	CompletedAt         *time.Time  `json:"completed_at"`
+	TemplateID          int         `json:"template_id,omitempty"`
+	TemplateName        string      `json:"template_name,omitempty"`
+	Submitters          []Submitter `json:"submitters"`
+	Documents           []Document  `json:"documents"`
+	Name                string      `json:"name,omitempty"`
+	SubmittersOrder     string      `json:"submitters_order,omitempty"`
+	AuditLogURL         string      `json:"audit_log_url,omitempty"`
+	CombinedDocumentURL string      `json:"combined_document_url,omitempty"`
+	ExpireAt            *time.Time  `json:"expire_at,omitempty"`
+}
+
+// Submitter is a party that signs a submission.
+type Submitter struct {
+	ID               int               `json:"id"`
+	Slug             string            `json:"slug"`
+	SubmissionID     int               `json:"submission_id"`
+	UUID             string            `json:"uuid"`
+	Email            string            `json:"email"`
+	Phone            string            `json:"phone,omitempty"`
+	Name             string            `json:"name,omitempty"`
+	Role             string            `json:"role"`
+	Status           string            `json:"status"`
+	SentAt           *time.Time        `json:"sent_at"`
+	OpenedAt         *time.Time        `json:"opened_at"`
+	CompletedAt      *time.Time        `json:"completed_at"`
+	DeclinedAt       *time.Time        `json:"declined_at"`
+	CreatedAt        time.Time         `json:"created_at"`
+	UpdatedAt        time.Time         `json:"updated_at"`
+	ExternalID       string            `json:"external_id,omitempty"`
+	ApplicationKey   string            `json:"application_key,omitempty"`
+	Metadata         map[string]any    `json:"metadata,omitempty"`
+	Values           []FieldValue      `json:"values"`
+	Documents        []Document        `json:"documents"`
+	EmbedSrc         string            `json:"embed_src,omitempty"`
+	Preferences      map[string]any    `json:"preferences,omitempty"`
+	Template         *TemplateRef      `json:"template,omitempty"`
+	SubmissionEvents []SubmissionEvent `json:"submission_events"`
+}
+
+// SubmissionEvent records a step of a submitter, e.g. when the form was viewed.
+type SubmissionEvent struct {
+	ID             int       `json:"id"`
+	SubmitterID    int       `json:"submitter_id"`
+	EventType      string    `json:"event_type"`
+	EventTimestamp time.Time `json:"event_timestamp"`
+}
+
+// FieldValue is the value a submitter entered in a field.
+type FieldValue struct {
+	Field string `json:"field"`
+	Value any    `json:"value"`
+}
+
+// Document is a signed or generated document.
+type Document struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// User is a user of the DocuSeal account.
+type User struct {
+	ID        int    `json:"id"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Email     string `json:"email"`
+}
+
+// Webhook is a subscription to submission and form events.
+type Webhook struct {
+	ID        int       `json:"id"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	Secret    string    `json:"secret,omitempty"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ArchiveResponse is the result of archiving a template or submission.
+type ArchiveResponse struct {
+	ID         int       `json:"id"`
+	ArchivedAt time.Time `json:"archived_at"`
+}
+
+// CreateSubmissionRequest sends a template for signing.
+type CreateSubmissionRequest struct {
+	TemplateID           int                `json:"template_id"`
+	SendEmail            bool               `json:"send_email"`
+	SendSMS              bool               `json:"send_sms,omitempty"`
+	Order                string             `json:"order,omitempty"`
+	Message              *Message           `json:"message,omitempty"`
+	CompletedRedirectURL string             `json:"completed_redirect_url,omitempty"`
+	BCCCompleted         string             `json:"bcc_completed,omitempty"`
+	ReplyTo              string             `json:"reply_to,omitempty"`
+	ExpireAt             string             `json:"expire_at,omitempty"`
+	Submitters           []SubmitterRequest `json:"submitters"`
+}
+
+// CreateSubmissionsFromEmailsRequest creates one submission per email address.
+type CreateSubmissionsFromEmailsRequest struct {
+	TemplateID int      `json:"template_id"`
+	Emails     string   `json:"emails"`
+	SendEmail  bool     `json:"send_email"`
+	Message    *Message `json:"message,omitempty"`
+}
+
+// SubmitterRequest describes a submitter of a new submission.
+type SubmitterRequest struct {
+	Email  string         `json:"email"`
+	Name   string         `json:"name,omitempty"`
+	Phone  string         `json:"phone,omitempty"`
+	Role   string         `json:"role"`
+	Values map[string]any `json:"values,omitempty"`
+}
+
+// Message customizes the email sent to submitters.
+type Message struct {
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+// UpdateSubmitterRequest holds the fields changed by SubmittersService.Update; zero
+// fields are left unchanged.
+type UpdateSubmitterRequest struct {
+	Email                string         `json:"email,omitempty"`
+	Name                 string         `json:"name,omitempty"`
+	Phone                string         `json:"phone,omitempty"`
+	Completed            bool           `json:"completed,omitempty"`
+	SendEmail            bool           `json:"send_email,omitempty"`
+	SendSMS              bool           `json:"send_sms,omitempty"`
+	Values               map[string]any `json:"values,omitempty"`
+	Metadata             map[string]any `json:"metadata,omitempty"`
+	Message              *Message       `json:"message,omitempty"`
+	ExternalID           string         `json:"external_id,omitempty"`
+	ReplyTo              string         `json:"reply_to,omitempty"`
+	CompletedRedirectURL string         `json:"completed_redirect_url,omitempty"`
+	RequirePhone2FA      bool           `json:"require_phone_2fa,omitempty"`
+	Fields               []FieldConfig  `json:"fields,omitempty"`
+}
+
+// FieldConfig sets the default value and behavior of a field for one submitter.
+type FieldConfig struct {
+	Name         string `json:"name"`
+	DefaultValue any    `json:"default_value,omitempty"`
+	ReadOnly     bool   `json:"readonly,omitempty"`
+	Validation   string `json:"validation,omitempty"`
+}
+
+// UpdateTemplateDocumentsRequest adds, replaces or removes documents of a template.
+type UpdateTemplateDocumentsRequest struct {
+	Documents []TemplateDocumentOperation `json:"documents"`
+	Merge     bool                        `json:"merge,omitempty"`
+}
+
+// TemplateDocumentOperation adds, replaces or removes one document.
+type TemplateDocumentOperation struct {
+	File     string `json:"file,omitempty"`
+	HTML     string `json:"html,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Position int    `json:"position,omitempty"`
+	Replace  bool   `json:"replace,omitempty"`
+	Remove   bool   `json:"remove,omitempty"`
+}
+
+// CreateWebhookRequest subscribes url to events.
+type CreateWebhookRequest struct {
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+}
+
+// UpdateWebhookRequest holds the fields changed by WebhooksService.Update; empty
+// fields are left unchanged.
+type UpdateWebhookRequest struct {
+	URL    string   `json:"url,omitempty"`
+	Events []string `json:"events,omitempty"`
+	Active *bool    `json:"active,omitempty"`
+}
@@ -0,0 +1,54 @@
+package docuseal
+
+import (
+	"context"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+)
+
+// WebhooksService manages webhook subscriptions.
+type WebhooksService interface {
+	List(ctx context.Context, opts *ListWebhooksOptions) ([]Webhook, error)
+	Get(ctx context.Context, id int) (*Webhook, error)
+	Create(ctx context.Context, req *CreateWebhookRequest) (*Webhook, error)
+	Update(ctx context.Context, id int, req *UpdateWebhookRequest) (*Webhook, error)
+	Delete(ctx context.Context, id int) error
+}
+
+// ListWebhooksOptions filters WebhooksService.List.
+type ListWebhooksOptions struct {
+	// Limit caps the number of webhooks returned (server default when 0).
+	Limit int
+	// After and Before page by webhook ID.
+	After  int
+	Before int
+}
+
+type webhooksService struct{ c *api.Client }
+
+func (s *webhooksService) List(ctx context.Context, opts *ListWebhooksOptions) ([]Webhook, error) {
+	if opts == nil {
+		opts = &ListWebhooksOptions{}
+	}
+	hooks, err := s.c.ListWebhooks(ctx, opts.Limit, opts.After, opts.Before)
+	return results(hooks, err, fromAPIWebhook)
+}
+
+func (s *webhooksService) Get(ctx context.Context, id int) (*Webhook, error) {
+	hook, err := s.c.GetWebhook(ctx, id)
+	return result(hook, err, fromAPIWebhook)
+}
+
+func (s *webhooksService) Create(ctx context.Context, req *CreateWebhookRequest) (*Webhook, error) {
+	hook, err := s.c.CreateWebhook(ctx, convertPtr(req, toAPICreateWebhookRequest))
+	return result(hook, err, fromAPIWebhook)
+}
+
+func (s *webhooksService) Update(ctx context.Context, id int, req *UpdateWebhookRequest) (*Webhook, error) {
+	hook, err := s.c.UpdateWebhook(ctx, id, convertPtr(req, toAPIUpdateWebhookRequest))
+	return result(hook, err, fromAPIWebhook)
+}
+
+func (s *webhooksService) Delete(ctx context.Context, id int) error {
+	return fromAPIError(s.c.DeleteWebhook(ctx, id))
+}
@@ -4,12 +4,16 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"math/rand/v2"
+	"mime"
 	"net/http"
+	"net/url"
 	"regexp"
 	"strings"
 	"sync"
@@ -23,8 +27,15 @@ const (
 	maxRetries         = 3
 	baseDelay          = 1 * time.Second
 	maxErrorBodyLength = 500
+
+	// DefaultAPIPath is appended to the base URL unless WithAPIPath says otherwise.
+	DefaultAPIPath = "/api"
 )
 
+// APIPathCandidates are the API paths DetectAPIPath tries, in order: the standard
+// layout, then a gateway that serves the API at the base URL itself.
+var APIPathCandidates = []string{DefaultAPIPath, ""}
+
 var (
 	sanitizePatterns     []*regexp.Regexp
 	sanitizePatternsOnce sync.Once
@@ -38,8 +49,14 @@ type Client struct {
 	InsecureSkipVerify bool
 	cb                 *circuitBreaker
 
-	maxRetries int
-	baseDelay  time.Duration
+	root         string
+	apiPath      string
+	headers      http.Header
+	maxRetries   int
+	baseDelay    time.Duration
+	requestHooks []RequestHook
+	tracer       *Tracer
+	logger       *slog.Logger
 }
 
 // ClientOption is a functional option for configuring the Client
@@ -49,12 +66,86 @@ type ClientOption func(*Client)
 func WithInsecureSkipVerify() ClientOption {
 	return func(c *Client) {
 		c.InsecureSkipVerify = true
-		transport := http.DefaultTransport.(*http.Transport).Clone()
-		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-		c.HTTP.Transport = transport
+		c.tlsConfig().InsecureSkipVerify = true // #nosec G402 -- explicitly requested by the user
+	}
+}
+
+// WithRootCAs verifies server certificates against pool instead of the system roots.
+func WithRootCAs(pool *x509.CertPool) ClientOption {
+	return func(c *Client) {
+		if pool != nil {
+			c.tlsConfig().RootCAs = pool
+		}
+	}
+}
+
+// WithClientCertificate presents cert to servers that ask for one (mutual TLS).
+func WithClientCertificate(cert tls.Certificate) ClientOption {
+	return func(c *Client) {
+		cfg := c.tlsConfig()
+		cfg.Certificates = append(cfg.Certificates, cert)
 	}
 }
 
+// WithMinTLSVersion refuses connections below version (e.g. tls.VersionTLS13).
+func WithMinTLSVersion(version uint16) ClientOption {
+	return func(c *Client) {
+		if version != 0 {
+			c.tlsConfig().MinVersion = version
+		}
+	}
+}
+
+// WithProxy sends requests through proxy instead of the one HTTPS_PROXY and HTTP_PROXY
+// select. Credentials in the URL's user info are sent as Proxy-Authorization.
+func WithProxy(proxy *url.URL) ClientOption {
+	return func(c *Client) {
+		if proxy != nil {
+			c.transport().Proxy = http.ProxyURL(proxy)
+		}
+	}
+}
+
+// WithAPIPath sets the path of the API below the base URL (default /api). "" or "/"
+// means the API is served at the base URL itself, e.g. behind a path-based proxy.
+func WithAPIPath(path string) ClientOption {
+	return func(c *Client) {
+		c.apiPath = normalizeAPIPath(path)
+	}
+}
+
+// WithHeaders sends extra headers with every request, e.g. the CF-Access-Client-Id
+// a gateway requires. They cannot replace the authentication and content headers.
+func WithHeaders(h http.Header) ClientOption {
+	return func(c *Client) {
+		for k, vs := range h {
+			for _, v := range vs {
+				c.headers.Add(k, v)
+			}
+		}
+	}
+}
+
+// transport returns the client's own *http.Transport, replacing a shared or foreign one
+// with a clone of http.DefaultTransport so options can change it.
+func (c *Client) transport() *http.Transport {
+	if t, ok := c.HTTP.Transport.(*http.Transport); ok && t != http.DefaultTransport {
+		return t
+	}
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	c.HTTP.Transport = t
+	return t
+}
+
+// tlsConfig returns the transport's TLS configuration, creating it when unset.
+func (c *Client) tlsConfig() *tls.Config {
+	t := c.transport()
+	if t.TLSClientConfig == nil {
+		t.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	return t.TLSClientConfig
+}
+
 // WithTimeout sets the HTTP client timeout.
 func WithTimeout(d time.Duration) ClientOption {
 	return func(c *Client) {
@@ -82,6 +173,26 @@ func WithRetryBaseDelay(d time.Duration) ClientOption {
 	}
 }
 
+// WithLogger sends the client's retries and circuit breaker trips to l, and, when l
+// has debug enabled, a record of each HTTP request and response with secrets redacted.
+func WithLogger(l *slog.Logger) ClientOption {
+	return func(c *Client) {
+		if l != nil {
+			c.logger = l
+		}
+	}
+}
+
+// WithRequestHook registers a hook that runs before each request is sent.
+// Hooks run once per logical request (not per retry attempt), in registration order.
+func WithRequestHook(h RequestHook) ClientOption {
+	return func(c *Client) {
+		if h != nil {
+			c.requestHooks = append(c.requestHooks, h)
+		}
+	}
+}
+
 // New creates a new DocuSeal API client
 func New(baseURL, apiKey string) *Client {
 	return NewWithOptions(baseURL, apiKey)
@@ -89,41 +200,117 @@ func New(baseURL, apiKey string) *Client {
 
 // NewWithOptions creates a new DocuSeal API client with custom options
 func NewWithOptions(baseURL, apiKey string, opts ...ClientOption) *Client {
-	// Ensure baseURL doesn't have trailing slash
-	baseURL = strings.TrimSuffix(baseURL, "/")
-	// Ensure it ends with /api
-	if !strings.HasSuffix(baseURL, "/api") {
-		baseURL = baseURL + "/api"
-	}
-
 	client := &Client{
-		BaseURL: baseURL,
-		APIKey:  apiKey,
-		HTTP:    &http.Client{Timeout: defaultTimeout},
-		cb:      newCircuitBreaker(),
+		APIKey: apiKey,
+		HTTP:   &http.Client{Timeout: defaultTimeout},
+		cb:     newCircuitBreaker(),
 
+		root:       strings.TrimSuffix(baseURL, "/"),
+		apiPath:    DefaultAPIPath,
+		headers:    http.Header{},
 		maxRetries: maxRetries,
 		baseDelay:  baseDelay,
+		logger:     slog.New(slog.DiscardHandler),
 	}
 
 	for _, opt := range opts {
 		opt(client)
 	}
+	client.BaseURL = joinAPIPath(client.root, client.apiPath)
+	// Wrapped last, so the transport options above still find the *http.Transport.
+	if client.tracer != nil || client.logger.Enabled(context.Background(), slog.LevelDebug) {
+		base := client.HTTP.Transport
+		if base == nil {
+			base = http.DefaultTransport
+		}
+		client.HTTP.Transport = &tracingTransport{base: base, tracer: client.tracer, logger: client.logger}
+	}
 
 	return client
 }
 
+// normalizeAPIPath returns path with one leading slash and no trailing one ("" for the root).
+func normalizeAPIPath(path string) string {
+	path = strings.Trim(strings.TrimSpace(path), "/")
+	if path == "" {
+		return ""
+	}
+	return "/" + path
+}
+
+// joinAPIPath appends apiPath to root unless root already ends with it.
+func joinAPIPath(root, apiPath string) string {
+	if strings.HasSuffix(root, apiPath) {
+		return root
+	}
+	return root + apiPath
+}
+
+// APIPath returns the path of the API below the base URL.
+func (c *Client) APIPath() string {
+	return c.apiPath
+}
+
+// DetectAPIPath finds where the API is served by requesting /user below each of
+// APIPathCandidates, and switches the client to the first that answers with JSON
+// (a DocuSeal API answers 200, or 401 for a bad key, where a wrong path typically
+// yields a 404 or an HTML page).
+func (c *Client) DetectAPIPath(ctx context.Context) (string, error) {
+	var tried []string
+	for _, candidate := range APIPathCandidates {
+		base := joinAPIPath(c.root, candidate)
+		ok, err := c.probeAPI(ctx, base)
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			c.apiPath, c.BaseURL = candidate, base
+			return candidate, nil
+		}
+		tried = append(tried, base+"/user")
+	}
+	return "", fmt.Errorf("no DocuSeal API found (tried %s)", strings.Join(tried, ", "))
+}
+
+// probeAPI reports whether GET base/user answers like the DocuSeal API.
+func (c *Client) probeAPI(ctx context.Context, base string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, base+"/user", nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to create request: %w", err)
+	}
+	c.setDefaultHeaders(req.Header)
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("request failed: %w", err)
+	}
+	_ = resp.Body.Close()
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	return resp.StatusCode != http.StatusNotFound && resp.StatusCode < 500 && mediaType == "application/json", nil
+}
+
 // do performs an HTTP request with retry logic for rate limiting
 func (c *Client) do(ctx context.Context, method, path string, body any, result any) error {
 	// Check circuit breaker
 	if c.cb.isOpen() {
+		c.logger.Debug("circuit breaker open; request not sent", "method", method, "url", c.BaseURL+path)
 		return &CircuitBreakerError{}
 	}
 
+	if len(c.requestHooks) > 0 {
+		prepared := c.prepareRequest(method, path, body)
+		for _, hook := range c.requestHooks {
+			if err := hook(ctx, prepared); err != nil {
+				return err
+			}
+		}
+	}
+
 	var lastErr error
 
 	for attempt := 0; attempt <= c.maxRetries; attempt++ {
-		err := c.doOnce(ctx, method, path, body, result)
+		info := attemptInfo{Attempt: attempt + 1, MaxAttempts: c.maxRetries + 1, Breaker: c.cb.state()}
+		err := c.doOnce(withAttempt(ctx, info), method, path, body, result)
 		if err == nil {
 			c.cb.recordSuccess()
 			return nil
@@ -134,7 +321,7 @@ func (c *Client) do(ctx context.Context, method, path string, body any, result a
 		if errors.As(err, &apiErr) {
 			// Auth errors - don't retry, use generic message
 			if apiErr.StatusCode == 401 || apiErr.StatusCode == 403 {
-				c.cb.recordFailure()
+				c.recordFailure()
 				return &AuthError{Reason: "invalid API key or insufficient permissions"}
 			}
 
@@ -145,6 +332,8 @@ func (c *Client) do(ctx context.Context, method, path string, body any, result a
 					maxJitter := int64(delay / 2)
 					jitter := time.Duration(rand.Int64N(maxJitter)) // #nosec G404 -- jitter for retry backoff, not security
 					sleepDuration := delay + jitter
+					c.logger.Info("rate limited; retrying", "method", method, "path", path,
+						"attempt", attempt+2, "max_attempts", c.maxRetries+1, "delay_ms", sleepDuration.Milliseconds())
 
 					select {
 					case <-ctx.Done():
@@ -161,7 +350,7 @@ func (c *Client) do(ctx context.Context, method, path string, body any, result a
 
 			// Record failure for 5xx errors
 			if apiErr.StatusCode >= 500 {
-				c.cb.recordFailure()
+				c.recordFailure()
 			}
 		}
 
@@ -172,6 +361,14 @@ func (c *Client) do(ctx context.Context, method, path string, body any, result a
 	return lastErr
 }
 
+// recordFailure counts a failure towards the circuit breaker.
+func (c *Client) recordFailure() {
+	c.cb.recordFailure()
+	if c.cb.isOpen() {
+		c.logger.Warn("circuit breaker tripped; requests blocked", "blocked_ms", c.cb.resetTimeout.Milliseconds())
+	}
+}
+
 // doOnce performs a single HTTP request
 func (c *Client) doOnce(ctx context.Context, method, path string, body any, result any) error {
 	var bodyReader io.Reader
@@ -189,9 +386,7 @@ func (c *Client) doOnce(ctx context.Context, method, path string, body any, resu
 		return fmt.Errorf("failed to create request: %w", err)
 	}
 
-	req.Header.Set("X-Auth-Token", c.APIKey)
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
+	c.setDefaultHeaders(req.Header)
 
 	// Add idempotency key for POST requests
 	if method == http.MethodPost {
@@ -240,6 +435,17 @@ func (c *Client) doOnce(ctx context.Context, method, path string, body any, resu
 	return nil
 }
 
+// setDefaultHeaders sets the headers sent with every request: those of WithHeaders,
+// then the authentication and content headers.
+func (c *Client) setDefaultHeaders(h http.Header) {
+	for k, vs := range c.headers {
+		h[k] = append([]string(nil), vs...)
+	}
+	h.Set("X-Auth-Token", c.APIKey)
+	h.Set("Content-Type", "application/json")
+	h.Set("Accept", "application/json")
+}
+
 // Get performs a GET request
 func (c *Client) Get(ctx context.Context, path string, result any) error {
 	return c.do(ctx, http.MethodGet, path, nil, result)
@@ -285,12 +491,16 @@ func initSanitizePatterns() {
 
 // sanitizeErrorBody truncates and redacts sensitive information from error response bodies
 func sanitizeErrorBody(body string) string {
-	sanitizePatternsOnce.Do(initSanitizePatterns)
-
 	// Truncate to max length
 	if len(body) > maxErrorBodyLength {
 		body = body[:maxErrorBodyLength] + "... (truncated)"
 	}
+	return redactSecrets(body)
+}
+
+// redactSecrets replaces the values of API keys, tokens, passwords and the like in a body.
+func redactSecrets(body string) string {
+	sanitizePatternsOnce.Do(initSanitizePatterns)
 
 	// Redact common sensitive patterns
 	for _, pattern := range sanitizePatterns {
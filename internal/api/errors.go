@@ -105,6 +105,19 @@ func (cb *circuitBreaker) recordFailure() {
 	cb.lastFailure = time.Now()
 }
 
+// state describes the breaker for debug output: "open", or "closed" with the failure count.
+func (cb *circuitBreaker) state() string {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if cb.failures >= cb.maxFailures {
+		return "open"
+	}
+	if cb.failures > 0 {
+		return fmt.Sprintf("closed, %d/%d failures", cb.failures, cb.maxFailures)
+	}
+	return "closed"
+}
+
 func (cb *circuitBreaker) reset() {
 	// Note: called with lock held, don't lock again
 	cb.failures = 0
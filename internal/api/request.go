@@ -0,0 +1,221 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// apiKeyHeader carries the API key; curl commands read it from $DOCUSEAL_API_KEY.
+	apiKeyHeader = "X-Auth-Token"
+	// redactedValue replaces secrets in printed requests.
+	redactedValue = "[REDACTED]"
+	// base64PreviewLength is how many payload characters are kept when shortening base64 data.
+	base64PreviewLength = 16
+	// minShortenedBase64Length is the shortest bare base64 string that gets shortened.
+	minShortenedBase64Length = 256
+)
+
+var (
+	base64Re = regexp.MustCompile(`^[A-Za-z0-9+/\r\n]+={0,2}$`)
+
+	// publicHeaders are printed as is. Every other value, including the headers of
+	// WithHeaders (gateway credentials, tenant IDs), is replaced with redactedValue.
+	publicHeaders = map[string]bool{
+		"Accept":          true,
+		"Content-Type":    true,
+		"Idempotency-Key": true,
+		"User-Agent":      true,
+	}
+)
+
+// PreparedRequest describes a request the client is about to send.
+type PreparedRequest struct {
+	Method string
+	URL    string
+	Header http.Header
+	Body   any
+}
+
+// RequestHook inspects a request before it is sent.
+// Returning an error aborts the request without sending it.
+type RequestHook func(ctx context.Context, req *PreparedRequest) error
+
+// ErrDryRun is matched by errors returned for requests that were not sent due to dry-run mode.
+var ErrDryRun = errors.New("dry run: request not sent")
+
+// DryRunError is returned instead of sending a mutating request when dry-run mode is enabled.
+type DryRunError struct {
+	Request *PreparedRequest
+}
+
+func (e *DryRunError) Error() string {
+	return fmt.Sprintf("dry run: %s %s not sent", e.Request.Method, e.Request.URL)
+}
+
+// Is reports whether target is ErrDryRun.
+func (e *DryRunError) Is(target error) bool {
+	return target == ErrDryRun
+}
+
+// IsDryRun checks if an error is a dry-run interception.
+func IsDryRun(err error) bool {
+	return errors.Is(err, ErrDryRun)
+}
+
+// WithDryRun configures the client to build mutating requests without sending them.
+// Read-only (GET) requests are still sent so identifiers can be resolved.
+func WithDryRun() ClientOption {
+	return WithRequestHook(func(_ context.Context, req *PreparedRequest) error {
+		if IsMutatingMethod(req.Method) {
+			return &DryRunError{Request: req}
+		}
+		return nil
+	})
+}
+
+// IsMutatingMethod reports whether an HTTP method changes server state.
+func IsMutatingMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
+
+// prepareRequest builds the request description passed to hooks.
+func (c *Client) prepareRequest(method, path string, body any) *PreparedRequest {
+	header := http.Header{}
+	c.setDefaultHeaders(header)
+	return &PreparedRequest{
+		Method: method,
+		URL:    c.BaseURL + path,
+		Header: header,
+		Body:   body,
+	}
+}
+
+// Redacted returns a JSON-friendly view of the request with secrets redacted
+// and base64 document payloads shortened.
+func (r *PreparedRequest) Redacted() map[string]any {
+	out := map[string]any{
+		"method":  r.Method,
+		"url":     r.URL,
+		"headers": RedactHeaders(r.Header),
+	}
+	if r.Body != nil {
+		out["body"] = ShortenBase64(r.Body)
+	}
+	return out
+}
+
+// Curl returns an equivalent curl command line.
+// The API key is read from $DOCUSEAL_API_KEY instead of being printed, other
+// header values (including a gateway's Authorization or Cookie) are redacted, and
+// base64 document payloads are shortened.
+func (r *PreparedRequest) Curl() string {
+	var b strings.Builder
+	b.WriteString("curl -X " + r.Method + " " + shellQuote(r.URL))
+
+	keys := make([]string, 0, len(r.Header))
+	for k := range r.Header {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v := r.Header.Get(k)
+		if http.CanonicalHeaderKey(k) == apiKeyHeader {
+			b.WriteString(" \\\n  -H \"" + k + ": $DOCUSEAL_API_KEY\"")
+			continue
+		}
+		if !publicHeaders[http.CanonicalHeaderKey(k)] {
+			v = redactedValue
+		}
+		b.WriteString(" \\\n  -H " + shellQuote(k+": "+v))
+	}
+
+	if r.Body != nil {
+		data, err := json.Marshal(ShortenBase64(r.Body))
+		if err == nil {
+			b.WriteString(" \\\n  --data-raw " + shellQuote(string(data)))
+		}
+	}
+	return b.String()
+}
+
+// RedactHeaders returns a flat copy of h with all but the content and idempotency
+// header values replaced.
+func RedactHeaders(h http.Header) map[string]string {
+	out := make(map[string]string, len(h))
+	for k := range h {
+		if !publicHeaders[http.CanonicalHeaderKey(k)] {
+			out[k] = redactedValue
+			continue
+		}
+		out[k] = h.Get(k)
+	}
+	return out
+}
+
+// ShortenBase64 returns a JSON-normalized copy of v where base64 payloads
+// (data URIs and long bare base64 strings) are replaced by a short preview.
+// Values that cannot be normalized are returned unchanged.
+func ShortenBase64(v any) any {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var normalized any
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return v
+	}
+	return shortenWalk(normalized)
+}
+
+func shortenWalk(v any) any {
+	switch tv := v.(type) {
+	case map[string]any:
+		for k, el := range tv {
+			tv[k] = shortenWalk(el)
+		}
+		return tv
+	case []any:
+		for i, el := range tv {
+			tv[i] = shortenWalk(el)
+		}
+		return tv
+	case string:
+		return shortenBase64String(tv)
+	default:
+		return v
+	}
+}
+
+func shortenBase64String(s string) string {
+	if strings.HasPrefix(s, "data:") {
+		if idx := strings.Index(s, ";base64,"); idx > 0 {
+			prefix := s[:idx+len(";base64,")]
+			payload := s[len(prefix):]
+			if len(payload) > base64PreviewLength {
+				return prefix + payload[:base64PreviewLength] + fmt.Sprintf("...(%d base64 chars)", len(payload))
+			}
+		}
+		return s
+	}
+	if len(s) >= minShortenedBase64Length && base64Re.MatchString(s) {
+		return s[:base64PreviewLength] + fmt.Sprintf("...(%d base64 chars)", len(s))
+	}
+	return s
+}
+
+// shellQuote quotes s for POSIX shells.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,130 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestClient_DryRunSkipsMutatingRequests(t *testing.T) {
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewWithOptions(server.URL, "test-key", WithDryRun())
+
+	var result map[string]any
+	if err := client.Get(context.Background(), "/templates/1", &result); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	err := client.Post(context.Background(), "/submissions", map[string]any{"template_id": 1}, &result)
+	var dr *DryRunError
+	if !errors.As(err, &dr) {
+		t.Fatalf("Post() error = %v, want DryRunError", err)
+	}
+	if !IsDryRun(err) {
+		t.Errorf("IsDryRun() = false, want true")
+	}
+	if dr.Request.Method != http.MethodPost || dr.Request.URL != server.URL+"/api/submissions" {
+		t.Errorf("prepared request = %s %s", dr.Request.Method, dr.Request.URL)
+	}
+
+	if len(methods) != 1 || methods[0] != http.MethodGet {
+		t.Errorf("server saw %v, want only GET", methods)
+	}
+}
+
+func TestClient_RequestHookAbort(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	wantErr := errors.New("blocked")
+	client := NewWithOptions(server.URL, "test-key", WithRequestHook(func(_ context.Context, req *PreparedRequest) error {
+		return wantErr
+	}))
+
+	if err := client.Delete(context.Background(), "/webhooks/1", nil); !errors.Is(err, wantErr) {
+		t.Fatalf("Delete() error = %v, want %v", err, wantErr)
+	}
+	if called {
+		t.Errorf("request was sent despite hook error")
+	}
+}
+
+func TestPreparedRequest_Redacted(t *testing.T) {
+	client := New("https://example.com", "super-secret")
+	payload := strings.Repeat("QUJD", 100)
+	req := client.prepareRequest(http.MethodPost, "/templates/pdf", map[string]any{
+		"name":      "Contract",
+		"documents": []map[string]any{{"file": "data:application/pdf;base64," + payload}},
+		"raw":       payload,
+	})
+
+	out := req.Redacted()
+	headers := out["headers"].(map[string]string)
+	if headers["X-Auth-Token"] != "[REDACTED]" {
+		t.Errorf("X-Auth-Token = %q, want redacted", headers["X-Auth-Token"])
+	}
+
+	body := out["body"].(map[string]any)
+	file := body["documents"].([]any)[0].(map[string]any)["file"].(string)
+	if !strings.HasPrefix(file, "data:application/pdf;base64,QUJD") || strings.Contains(file, payload) {
+		t.Errorf("file not shortened: %q", file)
+	}
+	if raw := body["raw"].(string); strings.Contains(raw, payload) {
+		t.Errorf("bare base64 not shortened: %q", raw)
+	}
+	if body["name"] != "Contract" {
+		t.Errorf("name = %v, want Contract", body["name"])
+	}
+}
+
+func TestPreparedRequest_RedactsExtraHeaders(t *testing.T) {
+	client := NewWithOptions("https://example.com", "k", WithHeaders(http.Header{"X-Tenant": {"acme-secret"}}))
+	req := client.prepareRequest(http.MethodDelete, "/templates/1", nil)
+
+	headers := req.Redacted()["headers"].(map[string]string)
+	if headers["X-Tenant"] != "[REDACTED]" || headers["Accept"] != "application/json" {
+		t.Errorf("headers = %v, want X-Tenant redacted and Accept shown", headers)
+	}
+	if curl := req.Curl(); strings.Contains(curl, "acme-secret") || !strings.Contains(curl, "'X-Tenant: [REDACTED]'") {
+		t.Errorf("curl does not redact X-Tenant:\n%s", curl)
+	}
+}
+
+func TestPreparedRequest_CurlGatewayAuthorization(t *testing.T) {
+	client := NewWithOptions("https://example.com", "k", WithHeaders(http.Header{"Authorization": {"Bearer gateway-secret"}}))
+	curl := client.prepareRequest(http.MethodDelete, "/templates/1", nil).Curl()
+	if strings.Contains(curl, "gateway-secret") || !strings.Contains(curl, "'Authorization: [REDACTED]'") || strings.Count(curl, "$DOCUSEAL_API_KEY") != 1 {
+		t.Errorf("curl must redact the gateway's Authorization and only use $DOCUSEAL_API_KEY for X-Auth-Token:\n%s", curl)
+	}
+}
+
+func TestPreparedRequest_Curl(t *testing.T) {
+	client := New("https://example.com", "super-secret")
+	req := client.prepareRequest(http.MethodPut, "/submitters/7", map[string]any{"name": "O'Brien"})
+
+	curl := req.Curl()
+	if strings.Contains(curl, "super-secret") {
+		t.Fatalf("curl leaks API key: %s", curl)
+	}
+	for _, want := range []string{
+		"curl -X PUT 'https://example.com/api/submitters/7'",
+		`-H "X-Auth-Token: $DOCUSEAL_API_KEY"`,
+		`--data-raw '{"name":"O'\''Brien"}'`,
+	} {
+		if !strings.Contains(curl, want) {
+			t.Errorf("curl missing %q:\n%s", want, curl)
+		}
+	}
+}
@@ -8,7 +8,7 @@ import (
 )
 
 // ListSubmitters retrieves submitters with optional filtering
-func (c *Client) ListSubmitters(ctx context.Context, limit int, submissionID int) ([]Submitter, error) {
+func (c *Client) ListSubmitters(ctx context.Context, limit int, submissionID int, query, slug, externalID string, after, before int) ([]Submitter, error) {
 	params := url.Values{}
 	if limit > 0 {
 		params.Set("limit", strconv.Itoa(limit))
@@ -16,6 +16,21 @@ func (c *Client) ListSubmitters(ctx context.Context, limit int, submissionID int
 	if submissionID > 0 {
 		params.Set("submission_id", strconv.Itoa(submissionID))
 	}
+	if query != "" {
+		params.Set("q", query)
+	}
+	if slug != "" {
+		params.Set("slug", slug)
+	}
+	if externalID != "" {
+		params.Set("external_id", externalID)
+	}
+	if after > 0 {
+		params.Set("after", strconv.Itoa(after))
+	}
+	if before > 0 {
+		params.Set("before", strconv.Itoa(before))
+	}
 
 	path := "/submitters"
 	if len(params) > 0 {
@@ -0,0 +1,355 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxDebugBodyLength is how much of a body the debug log shows; HAR files keep it all.
+const maxDebugBodyLength = 2000
+
+// Tracer records the HTTP exchanges of the clients it is given to with WithTracer for
+// WriteHAR. Header values are redacted like in PreparedRequest.Redacted, and bodies
+// have base64 payloads shortened and secrets redacted like error bodies.
+type Tracer struct {
+	// Creator and Version name the program in HAR files.
+	Creator string
+	Version string
+
+	mu      sync.Mutex
+	entries []harEntry
+}
+
+// WithTracer records every request of the client with t.
+func WithTracer(t *Tracer) ClientOption {
+	return func(c *Client) {
+		c.tracer = t
+	}
+}
+
+// attemptKey carries the attemptInfo of a request to the tracing transport.
+type attemptKey struct{}
+
+// attemptInfo describes where a request stands in the client's retry loop.
+type attemptInfo struct {
+	Attempt     int
+	MaxAttempts int
+	Breaker     string
+}
+
+func (a attemptInfo) String() string {
+	return fmt.Sprintf("attempt %d/%d, circuit %s", a.Attempt, a.MaxAttempts, a.Breaker)
+}
+
+// tracingTransport is the RoundTripper installed around the client's transport when
+// it has a tracer or a logger with debug enabled.
+type tracingTransport struct {
+	base   http.RoundTripper
+	tracer *Tracer
+	logger *slog.Logger
+}
+
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	info, _ := req.Context().Value(attemptKey{}).(attemptInfo)
+	reqBody, err := requestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	reqType := mediaType(req.Header.Get("Content-Type"))
+	t.logRequest(req, info, reqBody, reqType)
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	elapsed := time.Since(start)
+	if err != nil {
+		t.logger.Debug("<--", "method", req.Method, "url", req.URL.Redacted(),
+			"duration_ms", elapsed.Milliseconds(), "error", err.Error())
+		t.tracer.record(req, info, reqBody, reqType, nil, nil, start, elapsed, err)
+		return nil, err
+	}
+
+	respBody, readErr := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	elapsed = time.Since(start)
+	respType := mediaType(resp.Header.Get("Content-Type"))
+	t.logResponse(req, resp, elapsed, respBody, respType)
+	t.tracer.record(req, info, reqBody, reqType, resp, respBody, start, elapsed, nil)
+	if readErr != nil {
+		return nil, readErr
+	}
+	return resp, nil
+}
+
+// requestBody returns the body of req without consuming it.
+func requestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return io.ReadAll(body)
+	}
+	data, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	return data, err
+}
+
+func mediaType(contentType string) string {
+	mt, _, _ := mime.ParseMediaType(contentType)
+	return mt
+}
+
+func (t *tracingTransport) logRequest(req *http.Request, info attemptInfo, body []byte, bodyType string) {
+	if !t.logger.Enabled(req.Context(), slog.LevelDebug) {
+		return
+	}
+	attrs := []any{"method", req.Method, "url", req.URL.Redacted()}
+	if info.Attempt > 0 {
+		attrs = append(attrs, "attempt", info.Attempt, "max_attempts", info.MaxAttempts, "circuit", info.Breaker)
+	}
+	headers := RedactHeaders(req.Header)
+	group := make([]any, 0, len(headers))
+	for _, name := range sortedKeys(headers) {
+		group = append(group, slog.String(name, headers[name]))
+	}
+	attrs = append(attrs, slog.Group("headers", group...))
+	attrs = append(attrs, debugBody(body, bodyType)...)
+	t.logger.Debug("-->", attrs...)
+}
+
+func (t *tracingTransport) logResponse(req *http.Request, resp *http.Response, elapsed time.Duration, body []byte, bodyType string) {
+	if !t.logger.Enabled(req.Context(), slog.LevelDebug) {
+		return
+	}
+	attrs := []any{"status", resp.StatusCode, "method", req.Method, "url", req.URL.Redacted(), "duration_ms", elapsed.Milliseconds()}
+	attrs = append(attrs, debugBody(body, bodyType)...)
+	t.logger.Debug("<--", attrs...)
+}
+
+// debugBody returns the size and, for text, the traced body as log attributes.
+func debugBody(body []byte, bodyType string) []any {
+	if len(body) == 0 {
+		return nil
+	}
+	attrs := []any{"body_bytes", len(body)}
+	text, ok := traceBody(body, bodyType)
+	if !ok {
+		return append(attrs, "body_type", bodyType)
+	}
+	if r := []rune(text); len(r) > maxDebugBodyLength {
+		text = string(r[:maxDebugBodyLength]) + "... (truncated)"
+	}
+	return append(attrs, "body", text)
+}
+
+// traceBody returns the text of a body with base64 payloads shortened and secrets
+// redacted; ok is false for binary content.
+func traceBody(body []byte, bodyType string) (string, bool) {
+	if bodyType == "application/json" || json.Valid(body) {
+		var v any
+		if err := json.Unmarshal(body, &v); err == nil {
+			if data, err := json.Marshal(ShortenBase64(v)); err == nil {
+				return redactSecrets(string(data)), true
+			}
+		}
+	}
+	if strings.HasPrefix(bodyType, "text/") || bodyType == "" || strings.HasSuffix(bodyType, "+json") || strings.HasSuffix(bodyType, "/xml") {
+		return redactSecrets(string(body)), true
+	}
+	return "", false
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// withAttempt stores where a request stands in the retry loop for the tracing transport.
+func withAttempt(ctx context.Context, info attemptInfo) context.Context {
+	return context.WithValue(ctx, attemptKey{}, info)
+}
+
+// HAR 1.2 (http://www.softwareishard.com/blog/har-12-spec/), as far as browsers need it.
+type (
+	harLog struct {
+		Log harLogBody `json:"log"`
+	}
+	harLogBody struct {
+		Version string     `json:"version"`
+		Creator harCreator `json:"creator"`
+		Entries []harEntry `json:"entries"`
+	}
+	harCreator struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	}
+	harEntry struct {
+		StartedDateTime string      `json:"startedDateTime"`
+		Time            float64     `json:"time"`
+		Request         harRequest  `json:"request"`
+		Response        harResponse `json:"response"`
+		Cache           struct{}    `json:"cache"`
+		Timings         harTimings  `json:"timings"`
+		Comment         string      `json:"comment,omitempty"`
+		Error           string      `json:"_error,omitempty"`
+	}
+	harRequest struct {
+		Method      string       `json:"method"`
+		URL         string       `json:"url"`
+		HTTPVersion string       `json:"httpVersion"`
+		Headers     []harNameVal `json:"headers"`
+		QueryString []harNameVal `json:"queryString"`
+		Cookies     []harNameVal `json:"cookies"`
+		HeadersSize int          `json:"headersSize"`
+		BodySize    int          `json:"bodySize"`
+		PostData    *harPostData `json:"postData,omitempty"`
+	}
+	harResponse struct {
+		Status      int          `json:"status"`
+		StatusText  string       `json:"statusText"`
+		HTTPVersion string       `json:"httpVersion"`
+		Headers     []harNameVal `json:"headers"`
+		Cookies     []harNameVal `json:"cookies"`
+		Content     harContent   `json:"content"`
+		RedirectURL string       `json:"redirectURL"`
+		HeadersSize int          `json:"headersSize"`
+		BodySize    int          `json:"bodySize"`
+	}
+	harNameVal struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	}
+	harPostData struct {
+		MimeType string `json:"mimeType"`
+		Text     string `json:"text"`
+	}
+	harContent struct {
+		Size     int    `json:"size"`
+		MimeType string `json:"mimeType"`
+		Text     string `json:"text,omitempty"`
+	}
+	harTimings struct {
+		Send    float64 `json:"send"`
+		Wait    float64 `json:"wait"`
+		Receive float64 `json:"receive"`
+	}
+)
+
+// publicResponseHeaders are kept in HAR files; other response header values are redacted.
+var publicResponseHeaders = map[string]bool{
+	"Content-Type":   true,
+	"Content-Length": true,
+	"Date":           true,
+	"Location":       true,
+	"Retry-After":    true,
+	"Server":         true,
+	"X-Request-Id":   true,
+}
+
+func (t *Tracer) record(req *http.Request, info attemptInfo, reqBody []byte, reqType string, resp *http.Response, respBody []byte, start time.Time, elapsed time.Duration, err error) {
+	if t == nil {
+		return
+	}
+	ms := float64(elapsed.Microseconds()) / 1000
+	entry := harEntry{
+		StartedDateTime: start.UTC().Format(time.RFC3339Nano),
+		Time:            ms,
+		Request: harRequest{
+			Method:      req.Method,
+			URL:         req.URL.Redacted(),
+			HTTPVersion: "HTTP/1.1",
+			Headers:     harHeaders(RedactHeaders(req.Header)),
+			QueryString: []harNameVal{},
+			Cookies:     []harNameVal{},
+			HeadersSize: -1,
+			BodySize:    len(reqBody),
+		},
+		Response: harResponse{Headers: []harNameVal{}, Cookies: []harNameVal{}, HeadersSize: -1},
+		Timings:  harTimings{Wait: ms},
+	}
+	for name, values := range req.URL.Query() {
+		for _, v := range values {
+			entry.Request.QueryString = append(entry.Request.QueryString, harNameVal{name, v})
+		}
+	}
+	if len(reqBody) > 0 {
+		text, _ := traceBody(reqBody, reqType)
+		entry.Request.PostData = &harPostData{MimeType: reqType, Text: text}
+	}
+	if info.Attempt > 0 {
+		entry.Comment = info.String()
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	if resp != nil {
+		respType := mediaType(resp.Header.Get("Content-Type"))
+		text, _ := traceBody(respBody, respType)
+		headers := map[string]string{}
+		for name := range resp.Header {
+			headers[name] = redactedValue
+			if publicResponseHeaders[name] {
+				headers[name] = resp.Header.Get(name)
+			}
+		}
+		entry.Response = harResponse{
+			Status:      resp.StatusCode,
+			StatusText:  http.StatusText(resp.StatusCode),
+			HTTPVersion: resp.Proto,
+			Headers:     harHeaders(headers),
+			Cookies:     []harNameVal{},
+			Content:     harContent{Size: len(respBody), MimeType: respType, Text: text},
+			RedirectURL: resp.Header.Get("Location"),
+			HeadersSize: -1,
+			BodySize:    len(respBody),
+		}
+	}
+
+	t.mu.Lock()
+	t.entries = append(t.entries, entry)
+	t.mu.Unlock()
+}
+
+func harHeaders(h map[string]string) []harNameVal {
+	out := make([]harNameVal, 0, len(h))
+	for _, name := range sortedKeys(h) {
+		out = append(out, harNameVal{name, h[name]})
+	}
+	return out
+}
+
+// WriteHAR writes the recorded exchanges as a HAR 1.2 file, which browser devtools
+// can import.
+func (t *Tracer) WriteHAR(w io.Writer) error {
+	t.mu.Lock()
+	entries := append([]harEntry{}, t.entries...)
+	t.mu.Unlock()
+
+	creator := harCreator{Name: t.Creator, Version: t.Version}
+	if creator.Name == "" {
+		creator.Name = "docuseal-cli"
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(harLog{Log: harLogBody{Version: "1.2", Creator: creator, Entries: entries}})
+}
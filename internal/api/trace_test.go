@@ -0,0 +1,97 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTracer(t *testing.T) {
+	payload := strings.Repeat("QUJD", 100)
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=abc")
+		if calls == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":1,"token":"resp-secret"}`))
+	}))
+	defer server.Close()
+
+	var log bytes.Buffer
+	tracer := &Tracer{Version: "1.0.0"}
+	logger := slog.New(slog.NewJSONHandler(&log, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client := NewWithOptions(server.URL, "api-secret", WithTracer(tracer), WithLogger(logger), WithRetryBaseDelay(time.Millisecond),
+		WithHeaders(http.Header{"X-Tenant": {"tenant-secret"}}))
+	var result map[string]any
+	if err := client.Post(context.Background(), "/templates/pdf", map[string]any{"file": "data:application/pdf;base64," + payload}, &result); err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+
+	out := log.String()
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		var rec map[string]any
+		if err := json.Unmarshal([]byte(line), &rec); err != nil {
+			t.Fatalf("log line %q is not JSON: %v", line, err)
+		}
+		records = append(records, rec)
+	}
+	var msgs []string
+	for _, rec := range records {
+		msgs = append(msgs, rec["msg"].(string))
+	}
+	want := []string{"-->", "<--", "rate limited; retrying", "-->", "<--"}
+	if strings.Join(msgs, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("log messages = %q, want %q", msgs, want)
+	}
+	if r := records[0]; r["level"] != "DEBUG" || r["method"] != "POST" || r["url"] != server.URL+"/api/templates/pdf" || r["attempt"] != 1.0 || r["max_attempts"] != 4.0 || r["circuit"] != "closed" ||
+		r["headers"].(map[string]any)["X-Tenant"] != "[REDACTED]" || !strings.Contains(r["body"].(string), "(400 base64 chars)") {
+		t.Errorf("request record = %v", r)
+	}
+	if r := records[2]; r["level"] != "INFO" || r["attempt"] != 2.0 {
+		t.Errorf("retry record = %v", r)
+	}
+	if r := records[1]; r["status"] != 429.0 {
+		t.Errorf("rate limited record = %v", r)
+	}
+	if r := records[4]; r["status"] != 200.0 || !strings.Contains(r["body"].(string), `"token": "[REDACTED]"`) {
+		t.Errorf("response record = %v", r)
+	}
+
+	var har bytes.Buffer
+	if err := tracer.WriteHAR(&har); err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"api-secret", "tenant-secret", "resp-secret", "session=abc", payload} {
+		if strings.Contains(out, secret) || strings.Contains(har.String(), secret) {
+			t.Errorf("trace leaks %q", secret)
+		}
+	}
+	var doc struct {
+		Log struct {
+			Version string `json:"version"`
+			Entries []struct {
+				Comment  string `json:"comment"`
+				Response struct {
+					Status int `json:"status"`
+				} `json:"response"`
+			} `json:"entries"`
+		} `json:"log"`
+	}
+	if err := json.Unmarshal(har.Bytes(), &doc); err != nil {
+		t.Fatalf("HAR is not JSON: %v", err)
+	}
+	if e := doc.Log.Entries; doc.Log.Version != "1.2" || len(e) != 2 || e[0].Response.Status != 429 || e[1].Comment != "attempt 2/4, circuit closed" {
+		t.Errorf("HAR = %+v", doc.Log)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"html/template"
+	"log/slog"
 	"net"
 	"net/http"
 	"os"
@@ -31,6 +32,12 @@ type SetupServer struct {
 	shutdown      chan struct{}
 	pendingResult *SetupResult
 	csrfToken     string
+
+	// ClientOptions configure the client that verifies the entered credentials (TLS,
+	// proxy, timeouts).
+	ClientOptions []api.ClientOption
+	// Logger receives the server's warnings (default: slog.Default()).
+	Logger *slog.Logger
 }
 
 // NewSetupServer creates a new setup server
@@ -47,6 +54,7 @@ func NewSetupServer() *SetupServer {
 		result:    make(chan SetupResult, 1),
 		shutdown:  make(chan struct{}),
 		csrfToken: hex.EncodeToString(tokenBytes),
+		Logger:    slog.Default(),
 	}
 }
 
@@ -84,7 +92,7 @@ func (s *SetupServer) Start(ctx context.Context) (*SetupResult, error) {
 	go func() {
 		close(serverReady)
 		if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
-			fmt.Fprintf(os.Stderr, "HTTP server error: %v\n", err)
+			s.Logger.Error("HTTP server error", "error", err.Error())
 		}
 	}()
 
@@ -92,24 +100,24 @@ func (s *SetupServer) Start(ctx context.Context) (*SetupResult, error) {
 	<-serverReady
 	time.Sleep(50 * time.Millisecond) // Give server time to start accepting connections
 	if err := openBrowser(baseURL); err != nil {
-		fmt.Fprintf(os.Stderr, "Failed to open browser: %v\n", err)
+		s.Logger.Warn("failed to open browser; open the URL yourself", "url", baseURL, "error", err.Error())
 	}
 
 	// Wait for result or context cancellation
 	select {
 	case result := <-s.result:
 		if err := server.Shutdown(context.Background()); err != nil {
-			fmt.Fprintf(os.Stderr, "Error shutting down server: %v\n", err)
+			s.Logger.Warn("failed to shut down the setup server", "error", err.Error())
 		}
 		return &result, nil
 	case <-ctx.Done():
 		if err := server.Shutdown(context.Background()); err != nil {
-			fmt.Fprintf(os.Stderr, "Error shutting down server: %v\n", err)
+			s.Logger.Warn("failed to shut down the setup server", "error", err.Error())
 		}
 		return nil, ctx.Err()
 	case <-s.shutdown:
 		if err := server.Shutdown(context.Background()); err != nil {
-			fmt.Fprintf(os.Stderr, "Error shutting down server: %v\n", err)
+			s.Logger.Warn("failed to shut down the setup server", "error", err.Error())
 		}
 		if s.pendingResult != nil {
 			return s.pendingResult, nil
@@ -137,7 +145,7 @@ func (s *SetupServer) handleSetup(w http.ResponseWriter, r *http.Request) {
 
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
 	if err := tmpl.Execute(w, data); err != nil {
-		fmt.Fprintf(os.Stderr, "Error executing template: %v\n", err)
+		s.Logger.Error("failed to render page", "error", err.Error())
 		http.Error(w, "Failed to render page", http.StatusInternalServerError)
 	}
 }
@@ -172,7 +180,7 @@ func (s *SetupServer) handleValidate(w http.ResponseWriter, r *http.Request) {
 	req.BaseURL = strings.TrimSuffix(req.BaseURL, "/")
 
 	// Test the credentials by making an API call
-	client := api.New(req.BaseURL, req.APIKey)
+	client := api.NewWithOptions(req.BaseURL, req.APIKey, s.ClientOptions...)
 	user, err := client.GetUser(r.Context())
 	if err != nil {
 		writeJSON(w, http.StatusOK, map[string]any{
@@ -218,7 +226,7 @@ func (s *SetupServer) handleSubmit(w http.ResponseWriter, r *http.Request) {
 	req.BaseURL = strings.TrimSuffix(req.BaseURL, "/")
 
 	// Validate first
-	client := api.New(req.BaseURL, req.APIKey)
+	client := api.NewWithOptions(req.BaseURL, req.APIKey, s.ClientOptions...)
 	if _, err := client.GetUser(r.Context()); err != nil {
 		writeJSON(w, http.StatusOK, map[string]any{
 			"success": false,
@@ -270,7 +278,7 @@ func (s *SetupServer) handleSuccess(w http.ResponseWriter, r *http.Request) {
 
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
 	if err := tmpl.Execute(w, data); err != nil {
-		fmt.Fprintf(os.Stderr, "Error executing template: %v\n", err)
+		s.Logger.Error("failed to render page", "error", err.Error())
 		http.Error(w, "Failed to render page", http.StatusInternalServerError)
 	}
 }
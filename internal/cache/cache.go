@@ -0,0 +1,121 @@
+// Package cache stores small JSON documents on disk, keyed by profile, so shell completion
+// and identifier lookups stay fast and keep working offline.
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DirName is the cache directory inside the config directory.
+const DirName = "cache"
+
+// Cache is an on-disk store for one profile.
+type Cache struct {
+	// Dir holds the cache files.
+	Dir string
+	// Now is the clock that stamps and ages entries (time.Now from New).
+	Now func() time.Time
+}
+
+// envelope wraps a cached value with the time it was written.
+type envelope struct {
+	SavedAt time.Time       `json:"saved_at"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// New returns the cache for profile under configDir.
+func New(configDir, profile string) *Cache {
+	return &Cache{Dir: filepath.Join(configDir, DirName, safeName(profile)), Now: time.Now}
+}
+
+// Load decodes the entry for key into v and returns its age. ok is false when the entry is
+// missing or unreadable; callers decide whether an old entry is still good enough.
+func (c *Cache) Load(key string, v any) (age time.Duration, ok bool) {
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return 0, false
+	}
+	var env envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return 0, false
+	}
+	if err := json.Unmarshal(env.Data, v); err != nil {
+		return 0, false
+	}
+	return c.Now().Sub(env.SavedAt), true
+}
+
+// Save writes v under key. The file is replaced atomically so concurrent readers never
+// see a partial entry.
+func (c *Cache) Save(key string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to encode cache entry: %w", err)
+	}
+	out, err := json.Marshal(envelope{SavedAt: c.Now().UTC(), Data: data})
+	if err != nil {
+		return fmt.Errorf("failed to encode cache entry: %w", err)
+	}
+	if err := os.MkdirAll(c.Dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(c.Dir, "."+safeName(key)+"-*")
+	if err != nil {
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	if _, err := tmp.Write(out); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), c.path(key)); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the entry for key. A missing entry is not an error.
+func (c *Cache) Delete(key string) error {
+	if err := os.Remove(c.path(key)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete cache entry: %w", err)
+	}
+	return nil
+}
+
+// Clear removes every entry for the profile.
+func (c *Cache) Clear() error {
+	if err := os.RemoveAll(c.Dir); err != nil {
+		return fmt.Errorf("failed to clear cache: %w", err)
+	}
+	return nil
+}
+
+func (c *Cache) path(key string) string {
+	return filepath.Join(c.Dir, safeName(key)+".json")
+}
+
+// safeName turns a profile or key into a file name.
+func safeName(s string) string {
+	s = strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+			return r
+		}
+		return '_'
+	}, s)
+	s = strings.Trim(s, ".")
+	if s == "" {
+		return "default"
+	}
+	return s
+}
@@ -0,0 +1,79 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSaveLoad(t *testing.T) {
+	dir := t.TempDir()
+	c := New(dir, "docuseal.example.com:8443")
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	c.Now = func() time.Time { return now }
+
+	var missing []string
+	if _, ok := c.Load("items", &missing); ok {
+		t.Fatal("Load() ok = true for missing entry")
+	}
+
+	if err := c.Save("items", []string{"a", "b"}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, DirName, "docuseal.example.com_8443", "items.json")); err != nil {
+		t.Fatalf("cache file not written: %v", err)
+	}
+
+	now = now.Add(90 * time.Second)
+	var got []string
+	age, ok := c.Load("items", &got)
+	if !ok {
+		t.Fatal("Load() ok = false after Save")
+	}
+	if age != 90*time.Second {
+		t.Errorf("Load() age = %v, want 90s", age)
+	}
+	if len(got) != 2 || got[0] != "a" || got[1] != "b" {
+		t.Errorf("Load() = %v, want [a b]", got)
+	}
+
+	if err := c.Delete("items"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, ok := c.Load("items", &got); ok {
+		t.Error("Load() ok = true after Delete")
+	}
+	if err := c.Delete("items"); err != nil {
+		t.Errorf("Delete() of missing entry error = %v", err)
+	}
+}
+
+func TestClear(t *testing.T) {
+	dir := t.TempDir()
+	c := New(dir, "p")
+	if err := c.Save("a", 1); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if err := c.Clear(); err != nil {
+		t.Fatalf("Clear() error = %v", err)
+	}
+	var v int
+	if _, ok := c.Load("a", &v); ok {
+		t.Error("Load() ok = true after Clear")
+	}
+}
+
+func TestSafeName(t *testing.T) {
+	tests := map[string]string{
+		"api.docuseal.com": "api.docuseal.com",
+		"host:3000":        "host_3000",
+		"../etc":           "_etc",
+		"":                 "default",
+	}
+	for in, want := range tests {
+		if got := safeName(in); got != want {
+			t.Errorf("safeName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -6,47 +6,53 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var attachmentsCmd = &cobra.Command{
-	Use:     "attachments",
-	Aliases: []string{"attachment", "att"},
-	Short:   "Manage attachments",
-	Long:    `Upload and manage file attachments.`,
+// attachmentsState holds the attachments command state of a CLI.
+type attachmentsState struct {
+	attachmentsCmd       *cobra.Command
+	attachmentsUploadCmd *cobra.Command
+	attachmentsFile      string
 }
 
-var attachmentsUploadCmd = &cobra.Command{
-	Use:   "upload",
-	Short: "Upload an attachment",
-	Long:  `Upload a file as an attachment for use in submissions.`,
-	RunE:  runAttachmentsUpload,
-}
+func (cli *CLI) initAttachments() {
+	cli.attachmentsCmd = &cobra.Command{
+		Use:     "attachments",
+		Aliases: []string{"attachment", "att"},
+		Short:   "Manage attachments",
+		Long:    `Upload and manage file attachments.`,
+	}
 
-var attachmentsFile string
+	cli.attachmentsUploadCmd = &cobra.Command{
+		Use:   "upload",
+		Short: "Upload an attachment",
+		Long:  `Upload a file as an attachment for use in submissions.`,
+		RunE:  cli.runAttachmentsUpload,
+	}
 
-func init() {
-	rootCmd.AddCommand(attachmentsCmd)
-	attachmentsCmd.AddCommand(attachmentsUploadCmd)
+	cli.rootCmd.AddCommand(cli.attachmentsCmd)
+	cli.attachmentsCmd.AddCommand(cli.attachmentsUploadCmd)
+	markMutating(cli.attachmentsUploadCmd)
 
-	attachmentsUploadCmd.Flags().StringVar(&attachmentsFile, "file", "", "File path to upload (required)")
-	mustMarkFlagRequired(attachmentsUploadCmd, "file")
+	cli.attachmentsUploadCmd.Flags().StringVar(&cli.attachmentsFile, "file", "", "File path to upload (required)")
+	mustMarkFlagRequired(cli.attachmentsUploadCmd, "file")
 }
 
-func runAttachmentsUpload(cmd *cobra.Command, args []string) error {
-	client, err := getClient()
+func (cli *CLI) runAttachmentsUpload(cmd *cobra.Command, args []string) error {
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
-	attachment, err := client.CreateAttachment(cmd.Context(), attachmentsFile)
+	attachment, err := client.CreateAttachment(cmd.Context(), cli.attachmentsFile)
 	if err != nil {
 		return fmt.Errorf("failed to upload attachment: %w", err)
 	}
 
-	outputResult(mode, attachment, func() {
-		fmt.Printf("Uploaded attachment: %s\n", attachment.Name)
-		fmt.Printf("ID: %s\n", attachment.ID)
+	cli.outputResult(mode, attachment, func() {
+		fmt.Fprintf(cli.stdout, "Uploaded attachment: %s\n", attachment.Name)
+		fmt.Fprintf(cli.stdout, "ID: %s\n", attachment.ID)
 		if attachment.URL != "" {
-			fmt.Printf("URL: %s\n", attachment.URL)
+			fmt.Fprintf(cli.stdout, "URL: %s\n", attachment.URL)
 		}
 	})
 
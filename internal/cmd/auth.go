@@ -6,94 +6,110 @@ import (
 	"os"
 	"strings"
 
-	"github.com/docuseal/docuseal-cli/internal/api"
 	"github.com/docuseal/docuseal-cli/internal/auth"
 	"github.com/docuseal/docuseal-cli/internal/config"
 	"github.com/docuseal/docuseal-cli/internal/outfmt"
 	"github.com/spf13/cobra"
 )
 
-var authCmd = &cobra.Command{
-	Use:   "auth",
-	Short: "Manage authentication",
-	Long:  `Configure and manage DocuSeal API authentication.`,
+// authState holds the auth command state of a CLI.
+type authState struct {
+	authCmd       *cobra.Command
+	authLoginCmd  *cobra.Command
+	authStatusCmd *cobra.Command
+	authLogoutCmd *cobra.Command
+	authWhoamiCmd *cobra.Command
+	authURL       string
+	authAPIKey    string
 }
 
-var authLoginCmd = &cobra.Command{
-	Use:   "login",
-	Short: "Authenticate via browser",
-	Long: `Authenticate with DocuSeal by opening a browser for interactive login.
+func (cli *CLI) initAuth() {
+	cli.authCmd = &cobra.Command{
+		Use:   "auth",
+		Short: "Manage authentication",
+		Long:  `Configure and manage DocuSeal API authentication.`,
+	}
+
+	cli.authLoginCmd = &cobra.Command{
+		Use:   "login",
+		Short: "Authenticate via browser",
+		Long: `Authenticate with DocuSeal by opening a browser for interactive login.
 
 Credentials are stored securely in the OS keychain and used for all
 subsequent commands unless overridden by environment variables
 (DOCUSEAL_URL, DOCUSEAL_API_KEY).
 
 Use --url and --api-key flags to authenticate from the command line
-without opening a browser.`,
-	Example: `  # Interactive browser-based login (default)
+without opening a browser. --api-path and --header given then are stored
+as the server's profile and apply to later commands.`,
+		Example: `  # Interactive browser-based login (default)
   docuseal auth login
 
   # Login from command line (no browser)
   docuseal auth login --url https://api.docuseal.com --api-key YOUR_API_KEY
 
   # Login with self-hosted instance
-  docuseal auth login --url https://docuseal.example.com --api-key YOUR_API_KEY`,
-	RunE: runAuthLogin,
-}
+  docuseal auth login --url https://docuseal.example.com --api-key YOUR_API_KEY
 
-var authStatusCmd = &cobra.Command{
-	Use:   "status",
-	Short: "Show authentication status",
-	Long:  `Display current authentication configuration and verify connectivity.`,
-	RunE:  runAuthStatus,
-}
+  # Instance behind a path-based proxy and an access gateway (saved as its profile)
+  docuseal auth login --url https://example.com/docuseal --api-key YOUR_API_KEY \
+    --api-path auto --header 'CF-Access-Client-Id: ID' --header 'CF-Access-Client-Secret: SECRET'`,
+		RunE: cli.runAuthLogin,
+	}
 
-var authLogoutCmd = &cobra.Command{
-	Use:   "logout",
-	Short: "Remove stored credentials",
-	Long:  `Remove DocuSeal credentials from the OS keychain.`,
-	RunE:  runAuthLogout,
-}
+	cli.authStatusCmd = &cobra.Command{
+		Use:   "status",
+		Short: "Show authentication status",
+		Long:  `Display current authentication configuration and verify connectivity.`,
+		RunE:  cli.runAuthStatus,
+	}
 
-var authWhoamiCmd = &cobra.Command{
-	Use:   "whoami",
-	Short: "Display current user information",
-	Long:  `Show information about the authenticated user including name and email.`,
-	RunE:  runAuthWhoami,
-}
+	cli.authLogoutCmd = &cobra.Command{
+		Use:   "logout",
+		Short: "Remove stored credentials",
+		Long:  `Remove DocuSeal credentials from the OS keychain.`,
+		RunE:  cli.runAuthLogout,
+	}
 
-var (
-	authURL    string
-	authAPIKey string
-)
+	cli.authWhoamiCmd = &cobra.Command{
+		Use:   "whoami",
+		Short: "Display current user information",
+		Long:  `Show information about the authenticated user including name and email.`,
+		RunE:  cli.runAuthWhoami,
+	}
 
-func init() {
-	rootCmd.AddCommand(authCmd)
-	authCmd.AddCommand(authLoginCmd)
-	authCmd.AddCommand(authStatusCmd)
-	authCmd.AddCommand(authLogoutCmd)
-	authCmd.AddCommand(authWhoamiCmd)
+	cli.rootCmd.AddCommand(cli.authCmd)
+	cli.authCmd.AddCommand(cli.authLoginCmd)
+	cli.authCmd.AddCommand(cli.authStatusCmd)
+	cli.authCmd.AddCommand(cli.authLogoutCmd)
+	cli.authCmd.AddCommand(cli.authWhoamiCmd)
 
-	authLoginCmd.Flags().StringVar(&authURL, "url", "", "DocuSeal instance URL (skips browser)")
-	authLoginCmd.Flags().StringVar(&authAPIKey, "api-key", "", "API key (skips browser)")
+	cli.authLoginCmd.Flags().StringVar(&cli.authURL, "url", "", "DocuSeal instance URL (skips browser)")
+	cli.authLoginCmd.Flags().StringVar(&cli.authAPIKey, "api-key", "", "API key (skips browser)")
 }
 
-func runAuthLogin(cmd *cobra.Command, args []string) error {
+func (cli *CLI) runAuthLogin(cmd *cobra.Command, args []string) error {
 	// CLI-based login when both flags provided
-	if authURL != "" && authAPIKey != "" {
-		return runCLILogin(cmd)
+	if cli.authURL != "" && cli.authAPIKey != "" {
+		return cli.runCLILogin(cmd)
 	}
 
 	// If only one flag provided, error
-	if authURL != "" || authAPIKey != "" {
+	if cli.authURL != "" || cli.authAPIKey != "" {
 		return fmt.Errorf("both --url and --api-key are required for CLI login")
 	}
 
 	// Default: browser-based login
-	if !quiet {
-		fmt.Fprintln(os.Stderr, "Opening browser for authentication...")
-	}
+	cli.getLogger().Info("Trust settings: " + cli.trustReport("").String())
+	cli.getLogger().Info("Opening browser for authentication...")
 	server := auth.NewSetupServer()
+	server.Logger = cli.getLogger()
+	// The URL is entered in the browser, so only --api-path and --header apply.
+	gateway, _, err := cli.gatewayOptions("")
+	if err != nil {
+		return err
+	}
+	server.ClientOptions = append(cli.transportOptions(), gateway...)
 	result, err := server.Start(cmd.Context())
 	if err != nil {
 		return fmt.Errorf("browser login failed: %w", err)
@@ -101,34 +117,33 @@ func runAuthLogin(cmd *cobra.Command, args []string) error {
 	if result.Error != nil {
 		return result.Error
 	}
-	if !quiet {
-		fmt.Fprintln(os.Stderr, "OK: Credentials verified and saved to keychain")
-	}
+	cli.getLogger().Info("OK: Credentials verified and saved to keychain")
 	return nil
 }
 
-func runCLILogin(cmd *cobra.Command) error {
+func (cli *CLI) runCLILogin(cmd *cobra.Command) error {
 	// Validate URL before attempting any API calls
-	if err := validateURL(authURL); err != nil {
+	if err := validateURL(cli.authURL); err != nil {
 		return err
 	}
 
 	// Warn about non-HTTPS usage for non-localhost URLs
-	if !strings.HasPrefix(authURL, "https://") && !isLocalhost(authURL) {
-		if !quiet {
-			fmt.Fprintln(os.Stderr, "WARNING: Using non-HTTPS URL. Credentials will be transmitted insecurely.")
-		}
+	if !strings.HasPrefix(cli.authURL, "https://") && !isLocalhost(cli.authURL) {
+		cli.getLogger().Warn("using a non-HTTPS URL; credentials will be transmitted insecurely", "url", cli.authURL)
 	}
 
 	creds := config.Credentials{
-		URL:    authURL,
-		APIKey: authAPIKey,
+		URL:    cli.authURL,
+		APIKey: cli.authAPIKey,
 	}
+	cli.getLogger().Info("Trust settings: " + cli.trustReport(creds.URL).String())
 
 	// Verify the credentials work by making a test request
-	client := api.New(creds.URL, creds.APIKey)
-	_, err := client.ListTemplates(cmd.Context(), 1, "", false, 0, 0)
+	client, err := cli.newServerClient(cmd.Context(), creds.URL, creds.APIKey)
 	if err != nil {
+		return err
+	}
+	if _, err := client.ListTemplates(cmd.Context(), 1, "", false, 0, 0); err != nil {
 		return fmt.Errorf("failed to verify credentials: %w", err)
 	}
 
@@ -136,15 +151,17 @@ func runCLILogin(cmd *cobra.Command) error {
 	if err := config.Save(creds); err != nil {
 		return fmt.Errorf("failed to save credentials: %w", err)
 	}
-
-	if !quiet {
-		fmt.Fprintln(os.Stderr, "OK: Credentials verified and saved to keychain")
+	// --api-path and --header become the server's profile.
+	if err := cli.saveGatewayProfile(creds.URL, client); err != nil {
+		return fmt.Errorf("failed to save profile: %w", err)
 	}
+
+	cli.getLogger().Info("OK: Credentials verified and saved to keychain")
 	return nil
 }
 
-func runAuthStatus(cmd *cobra.Command, args []string) error {
-	mode := getOutputMode()
+func (cli *CLI) runAuthStatus(cmd *cobra.Command, args []string) error {
+	mode := cli.getOutputMode()
 
 	// Check for environment variable override
 	envURL := os.Getenv("DOCUSEAL_URL")
@@ -152,18 +169,18 @@ func runAuthStatus(cmd *cobra.Command, args []string) error {
 	usingEnv := envURL != "" && envKey != ""
 
 	// Try to load credentials
-	creds, err := config.Load()
+	creds, err := cli.credentials()
 	if err != nil {
 		if mode == outfmt.JSON || mode == outfmt.NDJSON {
-			outputResult(mode, map[string]any{
+			cli.outputResult(mode, map[string]any{
 				"authenticated": false,
 				"source":        "",
 				"error":         err.Error(),
 			}, nil)
 			return nil
 		}
-		fmt.Fprintln(os.Stderr, "Not authenticated")
-		fmt.Fprintln(os.Stderr, "Run 'docuseal auth login' or set DOCUSEAL_URL and DOCUSEAL_API_KEY")
+		fmt.Fprintln(cli.stderr, "Not authenticated")
+		fmt.Fprintln(cli.stderr, "Run 'docuseal auth login' or set DOCUSEAL_URL and DOCUSEAL_API_KEY")
 		return nil
 	}
 
@@ -174,37 +191,44 @@ func runAuthStatus(cmd *cobra.Command, args []string) error {
 	}
 
 	// Test connectivity
-	client := api.New(creds.URL, creds.APIKey)
-	_, testErr := client.ListTemplates(cmd.Context(), 1, "", false, 0, 0)
+	client, testErr := cli.newServerClient(cmd.Context(), creds.URL, creds.APIKey)
+	if testErr == nil {
+		_, testErr = client.ListTemplates(cmd.Context(), 1, "", false, 0, 0)
+	}
 	connected := testErr == nil
 
-	outputResult(mode, map[string]any{
+	result := map[string]any{
 		"authenticated": true,
 		"source":        source,
 		"url":           creds.URL,
 		"connected":     connected,
-	}, func() {
-		fmt.Printf("Authenticated: yes\n")
-		fmt.Printf("Source: %s\n", source)
-		fmt.Printf("URL: %s\n", creds.URL)
+	}
+	if client != nil {
+		result["api_base"] = client.BaseURL
+	}
+	cli.outputResult(mode, result, func() {
+		fmt.Fprintf(cli.stdout, "Authenticated: yes\n")
+		fmt.Fprintf(cli.stdout, "Source: %s\n", source)
+		fmt.Fprintf(cli.stdout, "URL: %s\n", creds.URL)
+		if client != nil {
+			fmt.Fprintf(cli.stdout, "API base: %s\n", client.BaseURL)
+		}
 		if connected {
-			fmt.Printf("Status: connected\n")
+			fmt.Fprintf(cli.stdout, "Status: connected\n")
 		} else {
-			fmt.Printf("Status: connection failed (%v)\n", testErr)
+			fmt.Fprintf(cli.stdout, "Status: connection failed (%v)\n", testErr)
 		}
 	})
 
 	return nil
 }
 
-func runAuthLogout(cmd *cobra.Command, args []string) error {
+func (cli *CLI) runAuthLogout(cmd *cobra.Command, args []string) error {
 	if err := config.Delete(); err != nil {
 		return fmt.Errorf("failed to remove credentials: %w", err)
 	}
 
-	if !quiet {
-		fmt.Fprintln(os.Stderr, "OK: Credentials removed from keychain")
-	}
+	cli.getLogger().Info("OK: Credentials removed from keychain")
 	return nil
 }
 
@@ -236,22 +260,22 @@ func isLocalhost(urlStr string) bool {
 	return host == "localhost" || host == "127.0.0.1" || host == "::1"
 }
 
-func runAuthWhoami(cmd *cobra.Command, args []string) error {
-	client, err := getClient()
+func (cli *CLI) runAuthWhoami(cmd *cobra.Command, args []string) error {
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
 	user, err := client.GetUser(cmd.Context())
 	if err != nil {
 		return fmt.Errorf("failed to get user info: %w", err)
 	}
 
-	outputResult(mode, user, func() {
-		fmt.Printf("ID: %d\n", user.ID)
-		fmt.Printf("Name: %s %s\n", user.FirstName, user.LastName)
-		fmt.Printf("Email: %s\n", user.Email)
+	cli.outputResult(mode, user, func() {
+		fmt.Fprintf(cli.stdout, "ID: %d\n", user.ID)
+		fmt.Fprintf(cli.stdout, "Name: %s %s\n", user.FirstName, user.LastName)
+		fmt.Fprintf(cli.stdout, "Email: %s\n", user.Email)
 	})
 
 	return nil
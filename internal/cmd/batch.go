@@ -0,0 +1,455 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+	"github.com/docuseal/docuseal-cli/internal/outfmt"
+	"github.com/spf13/cobra"
+)
+
+// batchState holds the batch command state of a CLI.
+type batchState struct {
+	batchCmd         *cobra.Command
+	batchFile        string
+	batchConcurrency int
+	batchStopOnError bool
+}
+
+// errSkipped marks operations not run because an earlier one failed under --stop-on-error.
+var errSkipped = errors.New("skipped after earlier failure")
+
+// batchOp is one input line.
+type batchOp struct {
+	Op   string          `json:"op"`
+	ID   json.RawMessage `json:"id,omitempty"`
+	Body json.RawMessage `json:"body,omitempty"`
+}
+
+// batchHandler runs one kind of operation through the typed client.
+type batchHandler struct {
+	mutating bool
+	needsID  bool
+	run      func(ctx context.Context, c *api.Client, id int, body json.RawMessage) (any, error)
+}
+
+// batchOps maps operation names ("<group>.<command>") to handlers.
+var batchOps = map[string]batchHandler{
+	"templates.get": {needsID: true, run: func(ctx context.Context, c *api.Client, id int, _ json.RawMessage) (any, error) {
+		return c.GetTemplate(ctx, id)
+	}},
+	"templates.archive": {mutating: true, needsID: true, run: func(ctx context.Context, c *api.Client, id int, _ json.RawMessage) (any, error) {
+		return c.ArchiveTemplate(ctx, id)
+	}},
+	"templates.clone": {mutating: true, needsID: true, run: func(ctx context.Context, c *api.Client, id int, body json.RawMessage) (any, error) {
+		var req struct {
+			Name   string `json:"name"`
+			Folder string `json:"folder"`
+		}
+		if err := decodeBatchBody(body, &req); err != nil {
+			return nil, err
+		}
+		return c.CloneTemplate(ctx, id, req.Name, req.Folder)
+	}},
+	"templates.update": {mutating: true, needsID: true, run: func(ctx context.Context, c *api.Client, id int, body json.RawMessage) (any, error) {
+		var req struct {
+			Name   string `json:"name"`
+			Folder string `json:"folder"`
+		}
+		if err := decodeBatchBody(body, &req); err != nil {
+			return nil, err
+		}
+		return c.UpdateTemplate(ctx, id, req.Name, req.Folder)
+	}},
+	"templates.merge": {mutating: true, run: func(ctx context.Context, c *api.Client, _ int, body json.RawMessage) (any, error) {
+		var req struct {
+			TemplateIDs []int  `json:"template_ids"`
+			Name        string `json:"name"`
+			Folder      string `json:"folder"`
+		}
+		if err := decodeBatchBody(body, &req); err != nil {
+			return nil, err
+		}
+		return c.MergeTemplates(ctx, req.TemplateIDs, req.Name, req.Folder)
+	}},
+	"submissions.get": {needsID: true, run: func(ctx context.Context, c *api.Client, id int, _ json.RawMessage) (any, error) {
+		return c.GetSubmission(ctx, id)
+	}},
+	"submissions.documents": {needsID: true, run: func(ctx context.Context, c *api.Client, id int, _ json.RawMessage) (any, error) {
+		return c.GetSubmissionDocuments(ctx, id)
+	}},
+	"submissions.archive": {mutating: true, needsID: true, run: func(ctx context.Context, c *api.Client, id int, _ json.RawMessage) (any, error) {
+		return c.ArchiveSubmission(ctx, id)
+	}},
+	"submissions.create": {mutating: true, run: func(ctx context.Context, c *api.Client, _ int, body json.RawMessage) (any, error) {
+		var req api.CreateSubmissionRequest
+		if err := decodeBatchBody(body, &req); err != nil {
+			return nil, err
+		}
+		return c.CreateSubmission(ctx, &req)
+	}},
+	"submissions.create_emails": {mutating: true, run: func(ctx context.Context, c *api.Client, _ int, body json.RawMessage) (any, error) {
+		var req api.CreateSubmissionsFromEmailsRequest
+		if err := decodeBatchBody(body, &req); err != nil {
+			return nil, err
+		}
+		return c.CreateSubmissionsFromEmails(ctx, &req)
+	}},
+	"submitters.get": {needsID: true, run: func(ctx context.Context, c *api.Client, id int, _ json.RawMessage) (any, error) {
+		return c.GetSubmitter(ctx, id)
+	}},
+	"submitters.update": {mutating: true, needsID: true, run: func(ctx context.Context, c *api.Client, id int, body json.RawMessage) (any, error) {
+		var req api.UpdateSubmitterRequest
+		if err := decodeBatchBody(body, &req); err != nil {
+			return nil, err
+		}
+		return c.UpdateSubmitter(ctx, id, &req)
+	}},
+	"webhooks.get": {needsID: true, run: func(ctx context.Context, c *api.Client, id int, _ json.RawMessage) (any, error) {
+		return c.GetWebhook(ctx, id)
+	}},
+	"webhooks.create": {mutating: true, run: func(ctx context.Context, c *api.Client, _ int, body json.RawMessage) (any, error) {
+		var req api.CreateWebhookRequest
+		if err := decodeBatchBody(body, &req); err != nil {
+			return nil, err
+		}
+		return c.CreateWebhook(ctx, &req)
+	}},
+	"webhooks.update": {mutating: true, needsID: true, run: func(ctx context.Context, c *api.Client, id int, body json.RawMessage) (any, error) {
+		var req api.UpdateWebhookRequest
+		if err := decodeBatchBody(body, &req); err != nil {
+			return nil, err
+		}
+		return c.UpdateWebhook(ctx, id, &req)
+	}},
+	"webhooks.delete": {mutating: true, needsID: true, run: func(ctx context.Context, c *api.Client, id int, _ json.RawMessage) (any, error) {
+		if err := c.DeleteWebhook(ctx, id); err != nil {
+			return nil, err
+		}
+		return map[string]any{"id": id, "deleted": true}, nil
+	}},
+}
+
+func (cli *CLI) initBatch() {
+	cli.batchCmd = &cobra.Command{
+		Use:   "batch",
+		Short: "Run many operations from an NDJSON file or stdin",
+		Long: `Run many operations from an NDJSON file (or stdin), one JSON object per line:
+
+  {"op": "submitters.update", "id": 1, "body": {"completed": true}}
+  {"op": "submissions.archive", "id": 5}
+  {"op": "submissions.create", "body": {"template_id": 3, "submitters": [{"email": "a@example.com", "role": "Signer"}]}}
+
+Operations run through a single authenticated client (shared retry and rate-limit
+state) with bounded concurrency. One result line is written per input line, in
+input order:
+
+  {"line": 1, "op": "submitters.update", "id": 1, "ok": true, "result": {...}}
+  {"line": 2, "op": "submissions.archive", "id": 5, "ok": false, "error": {...}}
+
+By default every operation runs; --stop-on-error skips operations that have not
+started after the first failure. --dry-run prints the requests instead of sending
+them. Each operation is checked against the active policy as its command (e.g.
+"submissions archive").
+
+Supported operations:
+` + batchOpsHelp(),
+		Example: `  # Run operations from a file
+  docuseal batch -f ops.ndjson
+
+  # Preview without sending
+  docuseal batch -f ops.ndjson --dry-run
+
+  # Pipe operations, stop at the first failure
+  jq -c '.[] | {op: "submissions.archive", id: .id}' old.json | docuseal batch --stop-on-error`,
+		Args: cobra.NoArgs,
+		RunE: cli.runBatch,
+	}
+
+	cli.rootCmd.AddCommand(cli.batchCmd)
+
+	cli.batchCmd.Flags().StringVarP(&cli.batchFile, "file", "f", "-", "NDJSON file of operations (- for stdin)")
+	cli.batchCmd.Flags().IntVar(&cli.batchConcurrency, "concurrency", 4, "Maximum operations in flight")
+	cli.batchCmd.Flags().BoolVar(&cli.batchStopOnError, "stop-on-error", false, "Skip remaining operations after the first failure")
+}
+
+// batchOpsHelp lists supported operations for the command help.
+func batchOpsHelp() string {
+	names := make([]string, 0, len(batchOps))
+	for name := range batchOps {
+		names = append(names, "  "+name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, "\n")
+}
+
+// normalizeBatchOp accepts "submissions.archive", "submissions archive" and "submissions.create-emails".
+func normalizeBatchOp(op string) string {
+	op = strings.Join(strings.Fields(strings.TrimSpace(op)), ".")
+	return strings.ReplaceAll(op, "-", "_")
+}
+
+// batchOpCommandPath maps an operation to the command path policy rules refer to.
+func batchOpCommandPath(op string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(op, ".", " "), "_", "-")
+}
+
+// decodeBatchBody decodes an operation body strictly so typos are reported.
+func decodeBatchBody(body json.RawMessage, v any) error {
+	if len(body) == 0 {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return &api.ValidationError{Field: "body", Message: err.Error()}
+	}
+	return nil
+}
+
+// parseBatchID accepts a JSON number or a string accepted by parseIDArg.
+func parseBatchID(raw json.RawMessage) (int, error) {
+	var n int
+	if err := json.Unmarshal(raw, &n); err == nil {
+		return n, nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return 0, &api.ValidationError{Field: "id", Message: "must be a number or string"}
+	}
+	id, err := parseIDArg(s)
+	if err != nil {
+		return 0, &api.ValidationError{Field: "id", Message: err.Error()}
+	}
+	return id, nil
+}
+
+// batchLine is a parsed input line ready to run.
+type batchLine struct {
+	line    int
+	op      string
+	id      int
+	body    json.RawMessage
+	handler batchHandler
+	err     error
+}
+
+func parseBatchLine(lineNo int, text string) batchLine {
+	bl := batchLine{line: lineNo}
+	var op batchOp
+	dec := json.NewDecoder(strings.NewReader(text))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&op); err != nil {
+		bl.err = &api.ValidationError{Field: "line", Message: fmt.Sprintf("invalid JSON: %v", err)}
+		return bl
+	}
+	bl.op = normalizeBatchOp(op.Op)
+	bl.body = op.Body
+
+	h, ok := batchOps[bl.op]
+	if !ok {
+		bl.err = &api.ValidationError{Field: "op", Message: fmt.Sprintf("unknown operation %q", op.Op)}
+		return bl
+	}
+	bl.handler = h
+
+	if h.needsID {
+		if len(op.ID) == 0 {
+			bl.err = &api.ValidationError{Field: "id", Message: "is required for " + bl.op}
+			return bl
+		}
+		id, err := parseBatchID(op.ID)
+		if err != nil {
+			bl.err = err
+			return bl
+		}
+		bl.id = id
+	}
+	return bl
+}
+
+func readBatchLines(r io.Reader) ([]batchLine, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16<<20)
+	var lines []batchLine
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		lines = append(lines, parseBatchLine(lineNo, text))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read operations: %w", err)
+	}
+	return lines, nil
+}
+
+func (cli *CLI) runBatch(cmd *cobra.Command, args []string) error {
+	if cli.batchConcurrency < 1 {
+		return &api.ValidationError{Field: "concurrency", Message: "must be >= 1"}
+	}
+
+	var in io.Reader = cli.stdin
+	if cli.batchFile != "-" {
+		f, err := os.Open(cli.batchFile)
+		if err != nil {
+			return fmt.Errorf("failed to open operations file: %w", err)
+		}
+		defer f.Close()
+		in = f
+	}
+
+	lines, err := readBatchLines(in)
+	if err != nil {
+		return err
+	}
+
+	client, err := cli.getClient()
+	if err != nil {
+		return err
+	}
+	mode := cli.getOutputMode()
+
+	p, _ := cli.loadPolicy()
+	for _, bl := range lines {
+		if bl.err == nil && bl.handler.mutating && !cli.isDryRun() {
+			cli.journalRun.activate()
+			break
+		}
+	}
+
+	var succeeded, failed, skipped int
+	runOrdered(cmd.Context(), len(lines), cli.batchConcurrency, cli.batchStopOnError,
+		func(ctx context.Context, i int) (any, error) {
+			bl := lines[i]
+			if bl.err != nil {
+				return nil, bl.err
+			}
+			if !cli.isDryRun() {
+				if err := p.CheckCommand(batchOpCommandPath(bl.op), bl.handler.mutating); err != nil {
+					return nil, err
+				}
+			}
+			return bl.handler.run(ctx, client, bl.id, bl.body)
+		},
+		func(i int, result any, err error) {
+			bl := lines[i]
+			out := map[string]any{"line": bl.line, "op": bl.op, "ok": err == nil}
+			if bl.id != 0 {
+				out["id"] = bl.id
+			}
+
+			var dr *api.DryRunError
+			switch {
+			case errors.As(err, &dr):
+				out["ok"] = true
+				out["dry_run"] = true
+				out["request"] = dr.Request.Redacted()
+				succeeded++
+			case errors.Is(err, errSkipped):
+				out["skipped"] = true
+				skipped++
+			case err != nil:
+				out["error"] = errorPayload(err)
+				failed++
+			default:
+				out["result"] = result
+				succeeded++
+			}
+			cli.writeBatchResult(mode, out)
+		},
+	)
+
+	cli.getLogger().Info(fmt.Sprintf("%d succeeded, %d failed, %d skipped", succeeded, failed, skipped),
+		"succeeded", succeeded, "failed", failed, "skipped", skipped)
+	if failed > 0 {
+		return fmt.Errorf("%d of %d operations failed", failed, len(lines))
+	}
+	return nil
+}
+
+func (cli *CLI) writeBatchResult(mode outfmt.Mode, out map[string]any) {
+	if mode == outfmt.Text {
+		status := "ok"
+		switch {
+		case out["dry_run"] == true:
+			status = "dry-run"
+		case out["skipped"] == true:
+			status = "skipped"
+		case out["ok"] == false:
+			msg := strings.Join(strings.Fields(fmt.Sprint(out["error"].(map[string]any)["error"])), " ")
+			status = "error: " + truncateString(msg, 120)
+		}
+		target := "-"
+		if id, ok := out["id"]; ok {
+			target = fmt.Sprint(id)
+		}
+		fmt.Fprintf(cli.stdout, "%d\t%s\t%s\t%s\n", out["line"], out["op"], target, status)
+		return
+	}
+	if err := outfmt.WriteJSONCompact(cli.stdout, out); err != nil {
+		fmt.Fprintf(cli.stderr, "Error writing output: %v\n", err)
+	}
+}
+
+// runOrdered runs fn for indices 0..n-1 with at most concurrency calls in flight and
+// calls emit for each index in order as results become available. With stopOnError,
+// indices not yet started after the first failure are emitted with errSkipped.
+func runOrdered(ctx context.Context, n, concurrency int, stopOnError bool, fn func(ctx context.Context, i int) (any, error), emit func(i int, result any, err error)) {
+	type outcome struct {
+		result any
+		err    error
+	}
+	results := make([]chan outcome, n)
+	for i := range results {
+		results[i] = make(chan outcome, 1)
+	}
+
+	var stopped atomic.Bool
+	go func() {
+		sem := make(chan struct{}, concurrency)
+		var wg sync.WaitGroup
+		for i := 0; i < n; i++ {
+			sem <- struct{}{}
+			if stopped.Load() || ctx.Err() != nil {
+				<-sem
+				err := errSkipped
+				if ctx.Err() != nil && !stopped.Load() {
+					err = ctx.Err()
+				}
+				results[i] <- outcome{err: err}
+				continue
+			}
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				result, err := fn(ctx, i)
+				if err != nil && stopOnError && !api.IsDryRun(err) {
+					stopped.Store(true)
+				}
+				results[i] <- outcome{result: result, err: err}
+			}(i)
+		}
+		wg.Wait()
+	}()
+
+	for i := 0; i < n; i++ {
+		o := <-results[i]
+		emit(i, o.result, o.err)
+	}
+}
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestParseBatchLine(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    string
+		wantOp  string
+		wantID  int
+		wantErr bool
+	}{
+		{"numeric id", `{"op":"submissions.archive","id":5}`, "submissions.archive", 5, false},
+		{"url id", `{"op":"submitters.update","id":"https://x.test/submitters/7","body":{"completed":true}}`, "submitters.update", 7, false},
+		{"space and hyphen", `{"op":"submissions create-emails","body":{"template_id":1,"emails":"a@b.c"}}`, "submissions.create_emails", 0, false},
+		{"unknown op", `{"op":"submissions.explode","id":1}`, "submissions.explode", 0, true},
+		{"missing id", `{"op":"templates.get"}`, "templates.get", 0, true},
+		{"unknown key", `{"op":"templates.get","id":1,"bdy":{}}`, "", 0, true},
+		{"invalid json", `{"op":`, "", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bl := parseBatchLine(1, tt.line)
+			if (bl.err != nil) != tt.wantErr {
+				t.Fatalf("parseBatchLine() error = %v, wantErr %v", bl.err, tt.wantErr)
+			}
+			if bl.op != tt.wantOp || bl.id != tt.wantID {
+				t.Errorf("parseBatchLine() = %q/%d, want %q/%d", bl.op, bl.id, tt.wantOp, tt.wantID)
+			}
+		})
+	}
+}
+
+func TestRunOrdered(t *testing.T) {
+	fn := func(_ context.Context, i int) (any, error) {
+		// Later items finish first to exercise reordering.
+		time.Sleep(time.Duration(5-i) * time.Millisecond)
+		if i == 1 {
+			return nil, errors.New("boom")
+		}
+		return i, nil
+	}
+
+	t.Run("keep going", func(t *testing.T) {
+		var got []string
+		runOrdered(context.Background(), 5, 3, false, fn, func(i int, result any, err error) {
+			got = append(got, fmt.Sprintf("%d:%v:%v", i, result, err))
+		})
+		want := []string{"0:0:<nil>", "1:<nil>:boom", "2:2:<nil>", "3:3:<nil>", "4:4:<nil>"}
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("emitted %v, want %v", got, want)
+		}
+	})
+
+	t.Run("stop on error", func(t *testing.T) {
+		var skipped []int
+		runOrdered(context.Background(), 5, 1, true, fn, func(i int, _ any, err error) {
+			if errors.Is(err, errSkipped) {
+				skipped = append(skipped, i)
+			}
+		})
+		if fmt.Sprint(skipped) != "[2 3 4]" {
+			t.Errorf("skipped = %v, want [2 3 4]", skipped)
+		}
+	})
+}
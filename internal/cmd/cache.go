@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// cacheState holds the cache command state of a CLI.
+type cacheState struct {
+	cacheCmd        *cobra.Command
+	cacheRefreshCmd *cobra.Command
+	cacheClearCmd   *cobra.Command
+}
+
+func (cli *CLI) initCache() {
+	cli.cacheCmd = &cobra.Command{
+		Use:   "cache",
+		Short: "Manage the local identifier cache",
+		Long: `Manage the local cache used to resolve names and slugs and to power shell completion.
+
+Each profile keeps an index of template and submission IDs, slugs, names and external IDs
+under the config directory (~/.config/docuseal/cache, or DOCUSEAL_CONFIG_DIR). The index
+updates itself incrementally, is refreshed for items changed by this CLI, and is rebuilt
+daily. Pass --no-cache to any command to bypass it.`,
+	}
+
+	cli.cacheRefreshCmd = &cobra.Command{
+		Use:       "refresh [templates|submissions]",
+		Short:     "Rebuild the identifier index",
+		Long:      `Rebuild the identifier index from the API. Without an argument, both templates and submissions are rebuilt.`,
+		ValidArgs: []string{indexTemplates, indexSubmissions},
+		Args:      cobra.MatchAll(cobra.MaximumNArgs(1), cobra.OnlyValidArgs),
+		RunE:      cli.runCacheRefresh,
+	}
+
+	cli.cacheClearCmd = &cobra.Command{
+		Use:   "clear",
+		Short: "Delete the cache for the current profile",
+		Long:  `Delete the identifier index and cached completions for the current profile.`,
+		Args:  cobra.NoArgs,
+		RunE:  cli.runCacheClear,
+	}
+
+	cli.rootCmd.AddCommand(cli.cacheCmd)
+	cli.cacheCmd.AddCommand(cli.cacheRefreshCmd)
+	cli.cacheCmd.AddCommand(cli.cacheClearCmd)
+}
+
+func (cli *CLI) runCacheRefresh(cmd *cobra.Command, args []string) error {
+	client, err := cli.getClient()
+	if err != nil {
+		return err
+	}
+	mode := cli.getOutputMode()
+
+	kinds := []string{indexTemplates, indexSubmissions}
+	if len(args) == 1 {
+		kinds = args
+	}
+
+	counts := map[string]int{}
+	for _, kind := range kinds {
+		col, err := cli.updateIndex(cmd.Context(), client, kind, true)
+		if err != nil {
+			return err
+		}
+		counts[kind] = len(col.Entries)
+	}
+
+	cli.outputResult(mode, map[string]any{"refreshed": counts}, func() {
+		parts := make([]string, 0, len(kinds))
+		for _, kind := range kinds {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[kind], kind))
+		}
+		cli.getUI().Success("Indexed %s", strings.Join(parts, ", "))
+	})
+	return nil
+}
+
+func (cli *CLI) runCacheClear(cmd *cobra.Command, args []string) error {
+	c, err := cli.profileCache()
+	if err != nil {
+		return fmt.Errorf("failed to open cache: %w", err)
+	}
+	mode := cli.getOutputMode()
+
+	indexMu.Lock()
+	err = c.Clear()
+	indexMu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	cli.outputResult(mode, map[string]any{"cleared": true, "path": c.Dir}, func() {
+		cli.getUI().Success("Cleared cache %s", c.Dir)
+	})
+	return nil
+}
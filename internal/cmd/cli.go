@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"context"
+	"io"
+	"os"
+	"time"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+	"github.com/docuseal/docuseal-cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// ClientFactory builds the API client commands talk to. opts carry the settings of the
+// global flags (timeout, retries, TLS, proxy, API path, headers, tracing, dry run) and the CLI's request hooks, and should be
+// passed on. api.NewWithOptions is the default.
+type ClientFactory func(baseURL, apiKey string, opts ...api.ClientOption) *api.Client
+
+// Options configures a CLI built by New. Zero fields fall back to the process defaults.
+type Options struct {
+	// Stdout receives command results and Stderr warnings, progress and errors.
+	Stdout io.Writer
+	Stderr io.Writer
+	// Stdin is read by --stdin, 'batch --file -' and the shell.
+	Stdin io.Reader
+	// Credentials returns the URL and API key to use (default: environment, then keychain).
+	Credentials func() (config.Credentials, error)
+	// NewClient builds the API client (default: api.NewWithOptions).
+	NewClient ClientFactory
+	// Now is the clock used for relative dates, history timestamps and cache ages.
+	Now func() time.Time
+}
+
+// CLI is a docuseal command tree with its own flag values, streams and client factory.
+// CLIs share no per-run state, so several can execute concurrently in one process.
+type CLI struct {
+	stdout      io.Writer
+	stderr      io.Writer
+	stdin       io.Reader
+	credentials func() (config.Credentials, error)
+	newClient   ClientFactory
+	now         func() time.Time
+
+	attachmentsState
+	authState
+	batchState
+	cacheState
+	completionState
+	debugState
+	doctorState
+	eventsState
+	gatewayState
+	helpState
+	historyState
+	indexState
+	logState
+	mcpState
+	pluginState
+	policyState
+	runState
+	rootState
+	schemaState
+	shellState
+	shortcutsState
+	stdinState
+	submissionsState
+	submittersState
+	tableState
+	templatesState
+	toolsState
+	trustState
+	versionState
+	webhooksState
+	whereState
+}
+
+// New builds a fresh command tree configured by opts.
+func New(opts Options) *CLI {
+	cli := &CLI{
+		stdout:      opts.Stdout,
+		stderr:      opts.Stderr,
+		stdin:       opts.Stdin,
+		credentials: opts.Credentials,
+		newClient:   opts.NewClient,
+		now:         opts.Now,
+	}
+	if cli.stdout == nil {
+		cli.stdout = os.Stdout
+	}
+	if cli.stderr == nil {
+		cli.stderr = os.Stderr
+	}
+	if cli.stdin == nil {
+		cli.stdin = os.Stdin
+	}
+	if cli.credentials == nil {
+		cli.credentials = config.Load
+	}
+	if cli.newClient == nil {
+		cli.newClient = api.NewWithOptions
+	}
+	if cli.now == nil {
+		cli.now = time.Now
+	}
+
+	cli.initRoot()
+	cli.rootCmd.SetIn(cli.stdin)
+	cli.rootCmd.SetOut(cli.stdout)
+	cli.rootCmd.SetErr(cli.stderr)
+	cli.initAttachments()
+	cli.initAuth()
+	cli.initBatch()
+	cli.initCache()
+	cli.initCompletion()
+	cli.initDoctor()
+	cli.initEvents()
+	cli.initHelp()
+	cli.initHistory()
+	cli.initMCP()
+	cli.initPlugin()
+	cli.initPolicy()
+	cli.initRun()
+	cli.initSchema()
+	cli.initShell()
+	cli.initShortcuts()
+	cli.initSubmissions()
+	cli.initSubmitters()
+	cli.initTemplates()
+	cli.initTools()
+	cli.initVersion()
+	cli.initWebhooks()
+	return cli
+}
+
+// Root returns the root command, e.g. to add commands before Execute.
+func (cli *CLI) Root() *cobra.Command { return cli.rootCmd }
+
+// Execute runs a command line on a CLI configured from the process environment.
+func Execute(ctx context.Context, args []string) error {
+	return New(Options{}).Execute(ctx, args)
+}
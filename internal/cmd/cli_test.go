@@ -0,0 +1,187 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+	"github.com/docuseal/docuseal-cli/internal/config"
+	"github.com/docuseal/docuseal-cli/internal/outfmt"
+)
+
+func TestNewRunsConcurrently(t *testing.T) {
+	t.Setenv("DOCUSEAL_CONFIG_DIR", t.TempDir())
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/api/templates/")
+		fmt.Fprintf(w, `{"id":%s,"name":"Template %s"}`, id, id)
+	}))
+	defer srv.Close()
+
+	var mu sync.Mutex
+	built := 0
+	newClient := func(baseURL, apiKey string, opts ...api.ClientOption) *api.Client {
+		mu.Lock()
+		built++
+		mu.Unlock()
+		return api.NewWithOptions(baseURL, apiKey, opts...)
+	}
+	creds := func() (config.Credentials, error) {
+		return config.Credentials{URL: srv.URL, APIKey: "test"}, nil
+	}
+
+	const runs = 8
+	outs := make([]bytes.Buffer, runs)
+	errs := make([]error, runs)
+	var wg sync.WaitGroup
+	for i := range runs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			args := []string{"templates", "get", strconv.Itoa(i + 1)}
+			if i%2 == 0 {
+				args = append(args, "-o", "json")
+			}
+			cli := New(Options{Stdout: &outs[i], Stderr: io.Discard, Credentials: creds, NewClient: newClient})
+			errs[i] = cli.Execute(context.Background(), args)
+		}()
+	}
+	wg.Wait()
+
+	for i := range runs {
+		if errs[i] != nil {
+			t.Fatalf("run %d: %v", i+1, errs[i])
+		}
+		out := outs[i].String()
+		if i%2 == 1 {
+			if want := fmt.Sprintf("Template %d", i+1); !strings.Contains(out, want) || strings.HasPrefix(out, "{") {
+				t.Errorf("run %d text output = %q, want %q", i+1, out, want)
+			}
+			continue
+		}
+		var got struct{ ID int }
+		if err := json.Unmarshal(outs[i].Bytes(), &got); err != nil || got.ID != i+1 {
+			t.Errorf("run %d JSON output = %q (%v), want id %d", i+1, out, err, i+1)
+		}
+	}
+	if built != runs {
+		t.Errorf("client factory called %d times, want %d", built, runs)
+	}
+}
+
+func TestNewUsesInjectedClock(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	cli := New(Options{Now: func() time.Time { return now }})
+	if got := cli.now(); !got.Equal(now) {
+		t.Errorf("now() = %v, want %v", got, now)
+	}
+	if cli.Root() == New(Options{}).Root() {
+		t.Error("New() returned a shared command tree")
+	}
+
+	// --format's ago, the cache and the index age all read the injected clock.
+	t.Setenv("DOCUSEAL_CONFIG_DIR", t.TempDir())
+	var out bytes.Buffer
+	cli = New(Options{Stdout: &out, Now: func() time.Time { return now }})
+	cli.formatFlag = "{{ ago .t }}"
+	if err := cli.parseOutputExpressions(); err != nil {
+		t.Fatal(err)
+	}
+	cli.outputResult(outfmt.JSON, map[string]any{"t": "2026-02-28T12:00:00Z"}, nil)
+	if got := strings.TrimSpace(out.String()); got != "24h ago" {
+		t.Errorf("ago = %q, want 24h ago", got)
+	}
+	cli.credentials = func() (config.Credentials, error) {
+		return config.Credentials{URL: "https://example.com", APIKey: "k"}, nil
+	}
+	c, err := cli.profileCache()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Save("k", 1); err != nil {
+		t.Fatal(err)
+	}
+	var v int
+	if age, ok := c.Load("k", &v); !ok || age != 0 {
+		t.Errorf("cache age = %v, %v; want 0 under a fixed clock", age, ok)
+	}
+}
+
+func TestDryRunSendsReadOnlyRequests(t *testing.T) {
+	t.Setenv("DOCUSEAL_CONFIG_DIR", t.TempDir())
+	var mu sync.Mutex
+	var sent []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		sent = append(sent, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"checksum_status":"verified","signatures":[]}`)
+	}))
+	defer srv.Close()
+	creds := func() (config.Credentials, error) {
+		return config.Credentials{URL: srv.URL, APIKey: "test", CreatedAt: time.Now()}, nil
+	}
+	pdf := filepath.Join(t.TempDir(), "signed.pdf")
+	if err := os.WriteFile(pdf, []byte("%PDF-1.4"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	run := func(stdin string, args ...string) string {
+		t.Helper()
+		sent = nil
+		var stdout bytes.Buffer
+		cli := New(Options{Stdin: strings.NewReader(stdin), Stdout: &stdout, Stderr: io.Discard, Credentials: creds})
+		if err := cli.Execute(context.Background(), args); err != nil {
+			t.Fatalf("%q: %v", args, err)
+		}
+		return stdout.String()
+	}
+
+	// Verifying a signature changes nothing, so it runs under --dry-run.
+	out := run("", "tools", "verify-signature", "--file", pdf, "--dry-run", "-o", "json")
+	if !strings.Contains(out, `"checksum_status": "verified"`) || fmt.Sprint(sent) != "[POST /api/tools/verify]" {
+		t.Errorf("verify-signature --dry-run = %q, sent %v; want the verification result", out, sent)
+	}
+
+	// Mutating commands and batch operations are still printed, not sent.
+	out = run("", "submissions", "archive", "5", "--dry-run")
+	if !strings.Contains(out, `"dry_run": true`) || len(sent) != 0 {
+		t.Errorf("submissions archive --dry-run = %q, sent %v; want a preview only", out, sent)
+	}
+	out = run(`{"op":"submissions.archive","id":5}`+"\n", "batch", "--dry-run")
+	if !strings.Contains(out, "\tdry-run") || len(sent) != 0 {
+		t.Errorf("batch --dry-run = %q, sent %v; want a preview only", out, sent)
+	}
+}
+
+func TestOutputExpressionRuntimeErrorFails(t *testing.T) {
+	t.Setenv("DOCUSEAL_CONFIG_DIR", t.TempDir())
+	for _, args := range [][]string{
+		{"version", "--jq", `error("boom")`},
+		{"version", "--format", `{{ index .missing 3 }}`},
+	} {
+		cli := New(Options{Stdout: io.Discard, Stderr: io.Discard})
+		err := cli.Execute(context.Background(), args)
+		if err == nil || ExitCode(err) == 0 {
+			t.Errorf("%q: error = %v (exit %d), want a non-zero exit", args, err, ExitCode(err))
+		}
+	}
+
+	// A later command in the same CLI (the shell) starts clean.
+	cli := New(Options{Stdout: io.Discard, Stderr: io.Discard})
+	_ = cli.Execute(context.Background(), []string{"version", "--jq", `error("boom")`})
+	if err := cli.Execute(context.Background(), []string{"version", "--jq", `.`}); err != nil {
+		t.Errorf("next command error = %v, want nil", err)
+	}
+}
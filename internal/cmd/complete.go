@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// completionTTL is how long cached completions are used before refetching.
+	completionTTL = 5 * time.Minute
+	// completionTimeout bounds the API call made while the user waits on <TAB>.
+	completionTimeout = 3 * time.Second
+	// completionPageSize is how many recent items are offered.
+	completionPageSize = 100
+)
+
+// completionEntry is one cached completion candidate.
+type completionEntry struct {
+	ID     int    `json:"id"`
+	Slug   string `json:"slug,omitempty"`
+	Label  string `json:"label,omitempty"`
+	Folder string `json:"folder,omitempty"`
+}
+
+type completionFetcher func(ctx context.Context, client *api.Client) ([]completionEntry, error)
+
+// completionSources maps cache keys to the requests that fill them.
+var completionSources = map[string]completionFetcher{
+	"completion-templates": func(ctx context.Context, client *api.Client) ([]completionEntry, error) {
+		items, err := client.ListTemplates(ctx, completionPageSize, "", false, 0, 0)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]completionEntry, 0, len(items))
+		for _, t := range items {
+			label := t.Name
+			if t.FolderName != "" {
+				label += " (" + t.FolderName + ")"
+			}
+			out = append(out, completionEntry{ID: t.ID, Slug: t.Slug, Label: label, Folder: t.FolderName})
+		}
+		return out, nil
+	},
+	"completion-submissions": func(ctx context.Context, client *api.Client) ([]completionEntry, error) {
+		items, err := client.ListSubmissions(ctx, completionPageSize, 0, "", "", "", "", false, 0, 0)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]completionEntry, 0, len(items))
+		for _, s := range items {
+			name := s.Name
+			if name == "" {
+				name = s.TemplateName
+			}
+			out = append(out, completionEntry{ID: s.ID, Slug: s.Slug, Label: strings.TrimSpace(name + " [" + s.Status + "]")})
+		}
+		return out, nil
+	},
+	"completion-submitters": func(ctx context.Context, client *api.Client) ([]completionEntry, error) {
+		items, err := client.ListSubmitters(ctx, completionPageSize, 0, "", "", "", 0, 0)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]completionEntry, 0, len(items))
+		for _, s := range items {
+			label := s.Email
+			if label == "" {
+				label = s.Name
+			}
+			out = append(out, completionEntry{ID: s.ID, Slug: s.Slug, Label: fmt.Sprintf("%s (%s, %s)", label, s.Role, s.Status)})
+		}
+		return out, nil
+	},
+	"completion-webhooks": func(ctx context.Context, client *api.Client) ([]completionEntry, error) {
+		items, err := client.ListWebhooks(ctx, completionPageSize, 0, 0)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]completionEntry, 0, len(items))
+		for _, w := range items {
+			out = append(out, completionEntry{ID: w.ID, Label: w.URL})
+		}
+		return out, nil
+	},
+}
+
+// cachedCompletions returns completion entries for key. Fresh cache entries are used as-is;
+// otherwise the API is asked, and if that fails (offline, not authenticated) any stale
+// entries are returned instead.
+func (cli *CLI) cachedCompletions(key string) []completionEntry {
+	c, err := cli.profileCache()
+	if err != nil {
+		return nil
+	}
+
+	var entries []completionEntry
+	if age, ok := c.Load(key, &entries); ok && age < completionTTL && !cli.noCache {
+		return entries
+	}
+
+	client, err := cli.getClient()
+	if err != nil {
+		return entries
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
+	defer cancel()
+	fresh, err := completionSources[key](ctx, client)
+	if err != nil {
+		return entries
+	}
+	_ = c.Save(key, fresh)
+	return fresh
+}
+
+// idCompletion completes the single <id> argument from the cache under key.
+// withSlugs also offers slugs, for commands that accept them.
+func (cli *CLI) idCompletion(key string, withSlugs bool) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return entryCompletions(cli.cachedCompletions(key), toComplete, withSlugs), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// idFlagCompletion completes an ID-valued flag from the cache under key.
+func (cli *CLI) idFlagCompletion(key string) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return entryCompletions(cli.cachedCompletions(key), toComplete, false), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+func entryCompletions(entries []completionEntry, toComplete string, withSlugs bool) []string {
+	var out []string
+	for _, e := range entries {
+		id := strconv.Itoa(e.ID)
+		if strings.HasPrefix(id, toComplete) {
+			out = append(out, completionWithDesc(id, e.Label))
+		}
+		if withSlugs && e.Slug != "" && toComplete != "" && strings.HasPrefix(e.Slug, toComplete) {
+			out = append(out, completionWithDesc(e.Slug, fmt.Sprintf("#%d %s", e.ID, e.Label)))
+		}
+	}
+	return out
+}
+
+func completionWithDesc(value, desc string) string {
+	desc = strings.Join(strings.Fields(desc), " ")
+	if desc == "" {
+		return value
+	}
+	return value + "\t" + desc
+}
+
+// completeFolders completes template folder names seen in the template cache.
+func (cli *CLI) completeFolders(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	seen := map[string]bool{}
+	var out []string
+	for _, e := range cli.cachedCompletions("completion-templates") {
+		if e.Folder == "" || seen[e.Folder] {
+			continue
+		}
+		seen[e.Folder] = true
+		if strings.HasPrefix(strings.ToLower(e.Folder), strings.ToLower(toComplete)) {
+			out = append(out, e.Folder)
+		}
+	}
+	sort.Strings(out)
+	return out, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeWebhookEvents completes event types from api.ValidWebhookEvents.
+func completeWebhookEvents(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	var out []string
+	for event := range api.ValidWebhookEvents {
+		if strings.HasPrefix(event, toComplete) {
+			out = append(out, event)
+		}
+	}
+	sort.Strings(out)
+	return out, cobra.ShellCompDirectiveNoFileComp
+}
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/docuseal/docuseal-cli/internal/cache"
+)
+
+func TestEntryCompletions(t *testing.T) {
+	entries := []completionEntry{
+		{ID: 12, Slug: "abc", Label: "NDA (Legal)"},
+		{ID: 123, Slug: "xyz", Label: "Offer  letter"},
+		{ID: 7, Label: ""},
+	}
+
+	tests := []struct {
+		name       string
+		toComplete string
+		withSlugs  bool
+		want       []string
+	}{
+		{name: "all ids", want: []string{"12\tNDA (Legal)", "123\tOffer letter", "7"}},
+		{name: "id prefix", toComplete: "12", want: []string{"12\tNDA (Legal)", "123\tOffer letter"}},
+		{name: "slugs", toComplete: "x", withSlugs: true, want: []string{"xyz\t#123 Offer letter"}},
+		{name: "slugs need a prefix", withSlugs: true, want: []string{"12\tNDA (Legal)", "123\tOffer letter", "7"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := entryCompletions(entries, tt.toComplete, tt.withSlugs)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("entryCompletions() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCachedCompletionsOfflineUsesStaleEntries(t *testing.T) {
+	cli := New(Options{})
+	dir := t.TempDir()
+	t.Setenv("DOCUSEAL_CONFIG_DIR", dir)
+	t.Setenv("DOCUSEAL_URL", "http://127.0.0.1:1")
+	t.Setenv("DOCUSEAL_API_KEY", "test")
+	t.Setenv("DOCUSEAL_HISTORY", "off")
+
+	c := cache.New(dir, "127.0.0.1:1")
+	if err := c.Save("completion-webhooks", []completionEntry{{ID: 5, Label: "https://example.com/hook"}}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	// Age the entry past the TTL so a refresh is attempted (and fails).
+	path := filepath.Join(c.Dir, "completion-webhooks.json")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var entry map[string]any
+	if err := json.Unmarshal(data, &entry); err != nil {
+		t.Fatal(err)
+	}
+	entry["saved_at"] = "2000-01-01T00:00:00Z"
+	if data, err = json.Marshal(entry); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	got := cli.cachedCompletions("completion-webhooks")
+	if len(got) != 1 || got[0].ID != 5 {
+		t.Errorf("cachedCompletions() = %+v, want stale entry 5", got)
+	}
+}
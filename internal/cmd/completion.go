@@ -2,15 +2,20 @@ package cmd
 
 import (
 	"fmt"
-	"os"
 
 	"github.com/spf13/cobra"
 )
 
-var completionCmd = &cobra.Command{
-	Use:   "completion [bash|zsh|fish|powershell]",
-	Short: "Generate shell completion scripts",
-	Long: `Generate shell completion scripts for docuseal.
+// completionState holds the completion command state of a CLI.
+type completionState struct {
+	completionCmd *cobra.Command
+}
+
+func (cli *CLI) initCompletion() {
+	cli.completionCmd = &cobra.Command{
+		Use:   "completion [bash|zsh|fish|powershell]",
+		Short: "Generate shell completion scripts",
+		Long: `Generate shell completion scripts for docuseal.
 
 To load completions:
 
@@ -46,28 +51,27 @@ PowerShell:
   PS> docuseal completion powershell > docuseal.ps1
   # and source this file from your PowerShell profile.
 `,
-	DisableFlagsInUseLine: true,
-	ValidArgs:             []string{"bash", "zsh", "fish", "powershell"},
-	Args:                  cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
-	RunE:                  runCompletion,
-}
+		DisableFlagsInUseLine: true,
+		ValidArgs:             []string{"bash", "zsh", "fish", "powershell"},
+		Args:                  cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		RunE:                  cli.runCompletion,
+	}
 
-func init() {
-	rootCmd.AddCommand(completionCmd)
+	cli.rootCmd.AddCommand(cli.completionCmd)
 }
 
-func runCompletion(cmd *cobra.Command, args []string) error {
+func (cli *CLI) runCompletion(cmd *cobra.Command, args []string) error {
 	shell := args[0]
 
 	switch shell {
 	case "bash":
-		return rootCmd.GenBashCompletion(os.Stdout)
+		return cli.rootCmd.GenBashCompletion(cli.stdout)
 	case "zsh":
-		return rootCmd.GenZshCompletion(os.Stdout)
+		return cli.rootCmd.GenZshCompletion(cli.stdout)
 	case "fish":
-		return rootCmd.GenFishCompletion(os.Stdout, true)
+		return cli.rootCmd.GenFishCompletion(cli.stdout, true)
 	case "powershell":
-		return rootCmd.GenPowerShellCompletionWithDesc(os.Stdout)
+		return cli.rootCmd.GenPowerShellCompletionWithDesc(cli.stdout)
 	default:
 		return fmt.Errorf("unsupported shell: %s (supported: bash, zsh, fish, powershell)", shell)
 	}
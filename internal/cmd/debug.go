@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+)
+
+// debugState holds --debug and --trace-file and the tracer the latter produces.
+type debugState struct {
+	debug     bool
+	traceFile string
+
+	// tracer is shared by every client of a command, including the commands that
+	// batch, run, mcp and the shell execute in-process; the Execute that created it
+	// writes the trace file to tracePath (the shell resets the flags in between).
+	// --debug only sets the log level, see logState.
+	tracer    *api.Tracer
+	tracePath string
+}
+
+// initDebugFlags registers --debug and --trace-file.
+func (cli *CLI) initDebugFlags() {
+	flags := cli.rootCmd.PersistentFlags()
+	debug, _ := strconv.ParseBool(os.Getenv("DOCUSEAL_DEBUG"))
+	flags.BoolVar(&cli.debug, "debug", debug, "Log each HTTP request and response to stderr, with secrets redacted; same as --log-level debug (env: DOCUSEAL_DEBUG)")
+	flags.StringVar(&cli.traceFile, "trace-file", os.Getenv("DOCUSEAL_TRACE_FILE"), "Write the HTTP requests and responses to a HAR file for browser devtools, with secrets redacted (env: DOCUSEAL_TRACE_FILE)")
+}
+
+// startTrace creates the tracer for --trace-file unless an enclosing command already
+// traces.
+func (cli *CLI) startTrace() {
+	if cli.tracer != nil || cli.traceFile == "" {
+		return
+	}
+	cli.tracer = &api.Tracer{Creator: "docuseal-cli", Version: Version}
+	cli.tracePath = cli.traceFile
+}
+
+// finishTrace writes the trace file and drops the tracer.
+func (cli *CLI) finishTrace() error {
+	tracer, path := cli.tracer, cli.tracePath
+	cli.tracer, cli.tracePath = nil, ""
+	if tracer == nil {
+		return nil
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to write trace file: %w", err)
+	}
+	if err := tracer.WriteHAR(f); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write trace file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write trace file: %w", err)
+	}
+	return nil
+}
+
+// shareTracer makes run record through cli's tracer.
+func (cli *CLI) shareTracer(run *CLI) {
+	run.tracer = cli.tracer
+}
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/docuseal/docuseal-cli/internal/config"
+)
+
+func TestDebugAndTraceFile(t *testing.T) {
+	t.Setenv("DOCUSEAL_CONFIG_DIR", t.TempDir())
+	srv := httptest.NewServer(http.HandlerFunc(docusealHandler))
+	defer srv.Close()
+	creds := func() (config.Credentials, error) {
+		return config.Credentials{URL: srv.URL, APIKey: "test"}, nil
+	}
+
+	dir := t.TempDir()
+	file, trace := filepath.Join(dir, "wf.yaml"), filepath.Join(dir, "trace.har")
+	wf := "name: twice\nsteps:\n  - id: first\n    run: auth whoami\n  - id: second\n    run: auth whoami\n"
+	if err := os.WriteFile(file, []byte(wf), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	var stderr bytes.Buffer
+	cli := New(Options{Stdout: io.Discard, Stderr: &stderr, Credentials: creds})
+	// Workflow steps run in-process; their requests go to the same trace file.
+	if err := cli.Execute(context.Background(), []string{"run", file, "--debug", "--trace-file", trace}); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+
+	if log := stderr.String(); !strings.Contains(log, "[debug] --> method=GET url="+srv.URL+"/api/user attempt=1 max_attempts=4 circuit=closed") || !strings.Contains(log, "[debug] <-- status=200") || strings.Contains(log, `"test"`) {
+		t.Errorf("debug log:\n%s", log)
+	}
+	data, err := os.ReadFile(trace)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var har struct {
+		Log struct {
+			Entries []json.RawMessage `json:"entries"`
+		} `json:"log"`
+	}
+	if err := json.Unmarshal(data, &har); err != nil || len(har.Log.Entries) != 2 {
+		t.Fatalf("trace file has %d entries (%v):\n%s", len(har.Log.Entries), err, data)
+	}
+}
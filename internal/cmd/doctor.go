@@ -0,0 +1,721 @@
+package cmd
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+	"github.com/docuseal/docuseal-cli/internal/config"
+	"github.com/docuseal/docuseal-cli/internal/env"
+	"github.com/docuseal/docuseal-cli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// Doctor check results.
+const (
+	doctorPass = "pass"
+	doctorWarn = "warn"
+	doctorFail = "fail"
+	// doctorSkip marks checks that could not run because an earlier one failed.
+	doctorSkip = "skip"
+)
+
+const (
+	// doctorCertExpiryWarning is how close to expiry a server certificate starts to warn.
+	doctorCertExpiryWarning = 14 * 24 * time.Hour
+	// doctorClockSkewWarning is the clock difference to the server that warns.
+	doctorClockSkewWarning = time.Minute
+)
+
+// doctorEnvVars are the environment variables that change how the CLI behaves.
+var doctorEnvVars = []string{
+	"DOCUSEAL_URL",
+	"DOCUSEAL_API_KEY",
+	"DOCUSEAL_OUTPUT",
+	"DOCUSEAL_COLOR",
+	"DOCUSEAL_TIMEOUT",
+	"DOCUSEAL_RETRIES",
+	"DOCUSEAL_RETRY_BASE_DELAY",
+	"DOCUSEAL_INSECURE_SKIP_VERIFY",
+	"DOCUSEAL_CA_CERT",
+	"DOCUSEAL_CLIENT_CERT",
+	"DOCUSEAL_CLIENT_KEY",
+	"DOCUSEAL_TLS_MIN_VERSION",
+	"DOCUSEAL_PROXY",
+	"DOCUSEAL_API_PATH",
+	"DOCUSEAL_DEBUG",
+	"DOCUSEAL_TRACE_FILE",
+	"DOCUSEAL_LOG_LEVEL",
+	"DOCUSEAL_LOG_FORMAT",
+	"DOCUSEAL_CONFIG_DIR",
+	"DOCUSEAL_CREDENTIALS_DIR",
+	"DOCUSEAL_KEYRING_PASSWORD",
+	"DOCUSEAL_POLICY",
+	"DOCUSEAL_HISTORY",
+	"KEYRING_BACKEND",
+	"KEYRING_FILE_DIR",
+	"CW_CREDENTIALS_DIR",
+	"OPENCLAW_CREDENTIALS_DIR",
+	"HTTPS_PROXY",
+	"HTTP_PROXY",
+	"NO_PROXY",
+}
+
+// doctorPluginEnvVars are set for plugins by the CLI itself.
+var doctorPluginEnvVars = []string{"DOCUSEAL_BIN", "DOCUSEAL_PLUGIN_NAME"}
+
+var doctorColumns = []ui.Column{
+	{Name: "check", Header: "CHECK", Fixed: true},
+	{Name: "status", Header: "STATUS", Fixed: true, Status: true},
+	{Name: "result", Header: "RESULT"},
+}
+
+// doctorCheck is the outcome of one check.
+type doctorCheck struct {
+	Name    string         `json:"name"`
+	Status  string         `json:"status"`
+	Message string         `json:"message"`
+	Hint    string         `json:"hint,omitempty"`
+	Details map[string]any `json:"details,omitempty"`
+}
+
+// doctorReport is the doctor command's result.
+type doctorReport struct {
+	OK      bool           `json:"ok"`
+	Summary map[string]int `json:"summary"`
+	Checks  []doctorCheck  `json:"checks"`
+}
+
+// doctorState holds the doctor command state of a CLI.
+type doctorState struct {
+	doctorCmd *cobra.Command
+}
+
+func (cli *CLI) initDoctor() {
+	cli.doctorCmd = &cobra.Command{
+		Use:   "doctor",
+		Short: "Diagnose configuration and connectivity problems",
+		Long: `Run a checklist of the things that commonly break the CLI and print pass, warn or
+fail for each, with a hint on how to fix it.
+
+Checks, in order:
+  config          where credentials come from (environment over keychain)
+  env             environment overrides, including ones loaded from ~/.openclaw/.env
+  keyring         the keyring backend and whether stored credentials can be read
+  url             the base URL
+  trust           CA roots, client certificate, minimum TLS version and proxy in effect
+  dns, tcp, tls   name resolution, reachability, certificate chain and expiry
+  api             that a DocuSeal API answers under the base URL
+  auth            an authenticated GET /user
+  clock           clock skew against the server's Date header
+  credential_age  age of the stored API key
+
+Checks that depend on a failed one are skipped. The command exits non-zero when a
+check fails.`,
+		Example: `  # Human-readable checklist
+  docuseal doctor
+
+  # Machine-readable report
+  docuseal doctor -o json`,
+		Args: cobra.NoArgs,
+		RunE: cli.runDoctor,
+	}
+
+	cli.rootCmd.AddCommand(cli.doctorCmd)
+}
+
+func (cli *CLI) runDoctor(cmd *cobra.Command, args []string) error {
+	mode := cli.getOutputMode()
+	d := &doctorRun{cli: cli}
+	d.run(cmd.Context())
+
+	report := doctorReport{Summary: map[string]int{doctorPass: 0, doctorWarn: 0, doctorFail: 0, doctorSkip: 0}, Checks: d.checks}
+	for _, c := range d.checks {
+		report.Summary[c.Status]++
+	}
+	report.OK = report.Summary[doctorFail] == 0
+
+	cli.outputResult(mode, report, func() {
+		rows := make([][]string, 0, len(d.checks))
+		for _, c := range d.checks {
+			rows = append(rows, []string{c.Name, c.Status, c.Message})
+		}
+		cli.renderTable(doctorColumns, rows)
+
+		first := true
+		for _, c := range d.checks {
+			if c.Hint == "" || (c.Status != doctorWarn && c.Status != doctorFail) {
+				continue
+			}
+			if first {
+				fmt.Fprintln(cli.stdout, "\nFixes:")
+				first = false
+			}
+			fmt.Fprintf(cli.stdout, "  %s: %s\n", c.Name, c.Hint)
+		}
+		fmt.Fprintf(cli.stdout, "\n%d passed, %d warnings, %d failed, %d skipped\n",
+			report.Summary[doctorPass], report.Summary[doctorWarn], report.Summary[doctorFail], report.Summary[doctorSkip])
+	})
+
+	if !report.OK {
+		return fmt.Errorf("%d doctor check(s) failed", report.Summary[doctorFail])
+	}
+	return nil
+}
+
+// doctorRun carries what earlier checks found to the later ones.
+type doctorRun struct {
+	cli    *CLI
+	checks []doctorCheck
+
+	source   string
+	creds    config.Credentials
+	credsErr error
+	base     *url.URL
+	proxy    *url.URL
+	// apiPath and headers are the gateway settings (profile, --api-path, --header).
+	apiPath string
+	headers http.Header
+	apiBase string
+	// blocked names the failed check that makes the remaining network checks pointless.
+	blocked    string
+	serverDate time.Time
+}
+
+func (d *doctorRun) add(c doctorCheck) {
+	d.checks = append(d.checks, c)
+}
+
+// skip records a check that cannot run after the check named by d.blocked failed.
+func (d *doctorRun) skip(name string) bool {
+	if d.blocked == "" {
+		return false
+	}
+	d.add(doctorCheck{Name: name, Status: doctorSkip, Message: "skipped: " + d.blocked + " check failed"})
+	return true
+}
+
+func (d *doctorRun) run(ctx context.Context) {
+	d.checkConfig()
+	d.checkEnv()
+	d.checkKeyring()
+	d.checkURL()
+	d.checkTrust()
+	d.checkDNS(ctx)
+	d.checkTCP(ctx)
+	d.checkTLS(ctx)
+	d.checkAPI(ctx)
+	d.checkAuth(ctx)
+	d.checkClock()
+	d.checkCredentialAge()
+}
+
+// checkConfig reports where credentials come from. Environment credentials need both
+// variables; a lone DOCUSEAL_URL or DOCUSEAL_API_KEY is silently ignored by Load.
+func (d *doctorRun) checkConfig() {
+	c := doctorCheck{Name: "config", Details: map[string]any{}}
+	envURL, envKey := os.Getenv("DOCUSEAL_URL") != "", os.Getenv("DOCUSEAL_API_KEY") != ""
+	d.source = "keychain"
+	if envURL && envKey {
+		d.source = "environment"
+	}
+	d.creds, d.credsErr = d.cli.credentials()
+	c.Details["source"] = d.source
+
+	switch {
+	case d.credsErr != nil:
+		c.Status, c.Message = doctorFail, "no credentials: "+d.credsErr.Error()
+		c.Hint = "run 'docuseal auth login' or set both DOCUSEAL_URL and DOCUSEAL_API_KEY"
+		d.blocked = "config"
+	case envURL != envKey:
+		set, unset := "DOCUSEAL_URL", "DOCUSEAL_API_KEY"
+		if envKey {
+			set, unset = unset, set
+		}
+		c.Status = doctorWarn
+		c.Message = fmt.Sprintf("%s is set without %s, so it is ignored and the keychain is used", set, unset)
+		c.Hint = fmt.Sprintf("set %s too, or unset %s", unset, set)
+	default:
+		c.Status, c.Message = doctorPass, fmt.Sprintf("credentials from %s for %s", d.source, d.creds.URL)
+		c.Details["url"] = d.creds.URL
+		if d.source == "environment" {
+			if stored, err := config.LoadFromKeychain(); err == nil && stored.URL != "" {
+				c.Message += fmt.Sprintf(" (overriding keychain credentials for %s)", stored.URL)
+				c.Details["overrides"] = stored.URL
+			}
+		}
+	}
+	d.add(c)
+}
+
+// checkEnv lists the environment variables in effect and flags the risky ones.
+func (d *doctorRun) checkEnv() {
+	c := doctorCheck{Name: "env", Status: doctorPass}
+	fromFile := env.OpenClawKeys()
+	var set, loaded, unknown []string
+	for _, name := range doctorEnvVars {
+		if _, ok := os.LookupEnv(name); ok {
+			set = append(set, name)
+		}
+	}
+	for _, name := range fromFile {
+		if slices.Contains(doctorEnvVars, name) {
+			loaded = append(loaded, name)
+		}
+	}
+	for _, kv := range os.Environ() {
+		name, _, _ := strings.Cut(kv, "=")
+		if strings.HasPrefix(name, "DOCUSEAL_") && !slices.Contains(doctorEnvVars, name) && !slices.Contains(doctorPluginEnvVars, name) {
+			unknown = append(unknown, name)
+		}
+	}
+	slices.Sort(unknown)
+	c.Details = map[string]any{"set": set, "openclaw_env": loaded, "unknown": unknown}
+
+	c.Message = "no overrides"
+	if len(set) > 0 {
+		c.Message = strings.Join(set, ", ")
+	}
+	if len(loaded) > 0 {
+		c.Message += fmt.Sprintf(" (%s from %s)", strings.Join(loaded, ", "), env.OpenClawPath())
+	}
+	var hints []string
+	if slices.Contains(loaded, "DOCUSEAL_URL") || slices.Contains(loaded, "DOCUSEAL_API_KEY") {
+		c.Status = doctorWarn
+		hints = append(hints, fmt.Sprintf("credentials come from %s; remove them there if that is unintended", env.OpenClawPath()))
+	}
+	if len(unknown) > 0 {
+		c.Status = doctorWarn
+		hints = append(hints, fmt.Sprintf("%s %s not used by docuseal; check for typos", strings.Join(unknown, ", "), pluralVerb(len(unknown))))
+	}
+	c.Hint = strings.Join(hints, "; ")
+	d.add(c)
+}
+
+func pluralVerb(n int) string {
+	if n == 1 {
+		return "is"
+	}
+	return "are"
+}
+
+// checkKeyring reports the keyring backend and whether stored credentials can be read.
+func (d *doctorRun) checkKeyring() {
+	info := config.DescribeKeyring()
+	c := doctorCheck{Name: "keyring", Details: map[string]any{"keyring": info}}
+	backend := info.Backend
+	if info.ForcedFile != "" {
+		backend += " (forced: " + info.ForcedFile + ")"
+	}
+	if info.Backend == "" {
+		c.Status, c.Message = doctorFail, fmt.Sprintf("no keyring backend could be opened (tried %s)", strings.Join(info.Candidates, ", "))
+		c.Hint = "set KEYRING_BACKEND=file to store credentials in an encrypted file"
+		if d.source == "environment" {
+			c.Status = doctorWarn
+		}
+		d.add(c)
+		return
+	}
+
+	stored, err := config.LoadFromKeychain()
+	switch {
+	case errors.Is(err, config.ErrNotConfigured):
+		c.Status, c.Message = doctorPass, backend+", no stored credentials"
+		if d.source != "environment" {
+			c.Status, c.Hint = doctorWarn, "run 'docuseal auth login' to store credentials"
+		}
+	case err != nil:
+		c.Status, c.Message = doctorFail, fmt.Sprintf("%s, unreadable: %v", backend, err)
+		c.Hint = "unlock the system keyring, or set KEYRING_BACKEND=file"
+		if info.Backend == "file" {
+			c.Hint = fmt.Sprintf("check that DOCUSEAL_KEYRING_PASSWORD matches the one used at login, or remove %s and log in again", info.FileDir)
+		}
+		if d.source == "environment" {
+			c.Status = doctorWarn
+		}
+	default:
+		c.Status, c.Message = doctorPass, fmt.Sprintf("%s, credentials stored for %s", backend, stored.URL)
+		if info.Backend == "file" && info.DefaultPassphrase {
+			c.Status = doctorWarn
+			c.Hint = "the file keyring uses the built-in passphrase; set DOCUSEAL_KEYRING_PASSWORD and log in again to encrypt it with your own"
+		}
+	}
+	d.add(c)
+}
+
+// checkURL validates the base URL that the network checks use.
+func (d *doctorRun) checkURL() {
+	if d.skip("url") {
+		return
+	}
+	c := doctorCheck{Name: "url"}
+	if err := validateURL(d.creds.URL); err != nil {
+		c.Status, c.Message = doctorFail, fmt.Sprintf("%q: %v", d.creds.URL, err)
+		c.Hint = "use the instance's root URL, e.g. https://docuseal.example.com"
+		d.blocked = "url"
+		d.add(c)
+		return
+	}
+	d.base, _ = url.Parse(d.creds.URL)
+	path, headers, err := d.cli.gatewaySettings(d.creds.URL)
+	if err != nil {
+		c.Status, c.Message = doctorFail, err.Error()
+		c.Hint = "fix the profile in " + config.ProfilesFileName + " in the config directory"
+		d.blocked = "url"
+		d.add(c)
+		return
+	}
+	d.apiPath, d.headers = path, headers
+	d.apiBase = api.NewWithOptions(d.creds.URL, "", d.gatewayOptions()...).BaseURL
+	c.Status, c.Message = doctorPass, "API base "+d.apiBase
+	if path == apiPathAuto {
+		c.Message = "API path probed below " + d.creds.URL + " (--api-path auto)"
+	}
+	c.Details = map[string]any{"url": d.creds.URL, "api_base": d.apiBase, "api_path": path, "headers": headerNames(headers)}
+	if d.base.Scheme != "https" && !isLocalhost(d.creds.URL) {
+		c.Status, c.Hint = doctorWarn, "use https:// so the API key is not sent in clear text"
+	}
+
+	d.add(c)
+}
+
+// checkTrust reports the TLS and proxy settings requests use.
+func (d *doctorRun) checkTrust() {
+	if d.skip("trust") {
+		return
+	}
+	d.proxy = d.cli.proxyFor(d.apiBase)
+	report := d.cli.trustReport(d.apiBase)
+	c := doctorCheck{Name: "trust", Status: doctorPass, Message: report.String(), Details: map[string]any{"trust": report}}
+
+	now := d.cli.now()
+	switch leaf := d.cli.clientCertLeaf(); {
+	case !report.Verify:
+		c.Status = doctorWarn
+		c.Hint = "TLS verification is disabled (--insecure-skip-verify or DOCUSEAL_INSECURE_SKIP_VERIFY); trust the server's CA with --ca-cert instead"
+	case leaf != nil && now.After(leaf.NotAfter):
+		c.Status = doctorFail
+		c.Hint = fmt.Sprintf("the client certificate expired on %s; request a new one", leaf.NotAfter.Format("2006-01-02"))
+	case leaf != nil && leaf.NotAfter.Sub(now) < doctorCertExpiryWarning:
+		c.Status = doctorWarn
+		c.Hint = fmt.Sprintf("the client certificate expires on %s; request a new one", leaf.NotAfter.Format("2006-01-02"))
+	}
+	d.add(c)
+}
+
+// gatewayOptions returns the client options of the API path and headers, leaving
+// --api-path auto to the api check.
+func (d *doctorRun) gatewayOptions() []api.ClientOption {
+	opts := []api.ClientOption{api.WithHeaders(d.headers)}
+	if d.apiPath != "" && d.apiPath != apiPathAuto {
+		opts = append(opts, api.WithAPIPath(d.apiPath))
+	}
+	return opts
+}
+
+func (d *doctorRun) timeoutCtx(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, d.cli.timeout)
+}
+
+func (d *doctorRun) checkDNS(ctx context.Context) {
+	if d.skip("dns") {
+		return
+	}
+	host := d.base.Hostname()
+	c := doctorCheck{Name: "dns"}
+	if net.ParseIP(host) != nil {
+		c.Status, c.Message = doctorPass, host+" is an IP address"
+		d.add(c)
+		return
+	}
+	ctx, cancel := d.timeoutCtx(ctx)
+	defer cancel()
+	start := time.Now()
+	addrs, err := net.DefaultResolver.LookupHost(ctx, host)
+	if err != nil {
+		c.Status, c.Message = doctorFail, err.Error()
+		c.Hint = "check the host name in the URL and your DNS or VPN settings"
+		if d.proxy == nil {
+			d.blocked = "dns"
+		} else {
+			// The proxy resolves the name; a local failure is expected on locked-down networks.
+			c.Status, c.Hint = doctorWarn, "the proxy resolves names, so this only matters if it cannot either"
+		}
+		d.add(c)
+		return
+	}
+	c.Status = doctorPass
+	c.Message = fmt.Sprintf("%s resolves to %s (%s)", host, strings.Join(addrs, ", "), time.Since(start).Round(time.Millisecond))
+	c.Details = map[string]any{"addresses": addrs}
+	d.add(c)
+}
+
+// hostPort returns the address to connect to: the proxy when one is configured.
+func (d *doctorRun) hostPort() string {
+	u := d.base
+	if d.proxy != nil {
+		u = d.proxy
+	}
+	if port := u.Port(); port != "" {
+		return u.Host
+	}
+	if u.Scheme == "https" {
+		return net.JoinHostPort(u.Hostname(), "443")
+	}
+	return net.JoinHostPort(u.Hostname(), "80")
+}
+
+func (d *doctorRun) checkTCP(ctx context.Context) {
+	if d.skip("tcp") {
+		return
+	}
+	addr := d.hostPort()
+	c := doctorCheck{Name: "tcp"}
+	ctx, cancel := d.timeoutCtx(ctx)
+	defer cancel()
+	start := time.Now()
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+	if err != nil {
+		c.Status, c.Message = doctorFail, err.Error()
+		c.Hint = "check firewalls, VPN and proxy settings (HTTPS_PROXY, NO_PROXY)"
+		d.blocked = "tcp"
+		d.add(c)
+		return
+	}
+	conn.Close()
+	c.Status, c.Message = doctorPass, fmt.Sprintf("connected to %s (%s)", addr, time.Since(start).Round(time.Millisecond))
+	if d.proxy != nil {
+		c.Message = "proxy " + c.Message
+	}
+	d.add(c)
+}
+
+// checkTLS inspects the server certificate. The handshake itself skips verification so
+// the chain can be reported even when it is not trusted; verification follows separately.
+func (d *doctorRun) checkTLS(ctx context.Context) {
+	if d.base != nil && d.base.Scheme != "https" && d.blocked == "" {
+		d.add(doctorCheck{Name: "tls", Status: doctorSkip, Message: "skipped: the URL does not use https"})
+		return
+	}
+	if d.skip("tls") {
+		return
+	}
+	c := doctorCheck{Name: "tls"}
+	if d.proxy != nil {
+		c.Status, c.Message = doctorSkip, "skipped: connections go through a proxy; the api check covers TLS"
+		d.add(c)
+		return
+	}
+	host := d.base.Hostname()
+	ctx, cancel := d.timeoutCtx(ctx)
+	defer cancel()
+	cfg := &tls.Config{ServerName: host, MinVersion: d.cli.minVersion, InsecureSkipVerify: true} // #nosec G402 -- verified below
+	if d.cli.clientCert != nil {
+		cfg.Certificates = []tls.Certificate{*d.cli.clientCert}
+	}
+	dialer := &tls.Dialer{Config: cfg}
+	conn, err := dialer.DialContext(ctx, "tcp", d.hostPort())
+	if err != nil {
+		c.Status, c.Message = doctorFail, "handshake failed: "+err.Error()
+		c.Hint = fmt.Sprintf("check that the server speaks TLS %s or later on this port, accepts the client certificate if one is required, and that no proxy intercepts it", d.cli.tlsMinVersion)
+		d.blocked = "tls"
+		d.add(c)
+		return
+	}
+	state := conn.(*tls.Conn).ConnectionState()
+	conn.Close()
+
+	certs := state.PeerCertificates
+	leaf := certs[0]
+	chain := make([]string, 0, len(certs))
+	for _, cert := range certs {
+		chain = append(chain, cert.Subject.String())
+	}
+	c.Details = map[string]any{
+		"version":   tls.VersionName(state.Version),
+		"subject":   leaf.Subject.String(),
+		"issuer":    leaf.Issuer.String(),
+		"not_after": leaf.NotAfter.UTC(),
+		"chain":     chain,
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	now := d.cli.now()
+	_, verifyErr := leaf.Verify(x509.VerifyOptions{DNSName: host, Roots: d.cli.rootCAs, Intermediates: intermediates, CurrentTime: now})
+	left := leaf.NotAfter.Sub(now)
+	switch {
+	case verifyErr != nil && d.cli.insecureTLS:
+		c.Status, c.Message = doctorWarn, "untrusted certificate accepted because verification is disabled: "+verifyErr.Error()
+		c.Hint = "trust the issuing CA with --ca-cert instead of disabling verification"
+	case verifyErr != nil:
+		c.Status, c.Message = doctorFail, "certificate not trusted: "+verifyErr.Error()
+		c.Hint = "pass the issuing CA with --ca-cert (or DOCUSEAL_CA_CERT), or install it in the system trust store"
+		d.blocked = "tls"
+	case left < doctorCertExpiryWarning:
+		c.Status = doctorWarn
+		c.Message = fmt.Sprintf("%s, certificate expires %s (in %d days)", tls.VersionName(state.Version), leaf.NotAfter.Format("2006-01-02"), int(left.Hours()/24))
+		c.Hint = "renew the server certificate"
+	default:
+		c.Status = doctorPass
+		c.Message = fmt.Sprintf("%s, certificate for %s valid until %s", tls.VersionName(state.Version), leaf.Subject.CommonName, leaf.NotAfter.Format("2006-01-02"))
+	}
+	d.add(c)
+}
+
+// checkAPI requests /user without a key: a DocuSeal API answers 401, while a wrong
+// base path typically yields a 404 or an HTML page. The response's Date header feeds the
+// clock check.
+func (d *doctorRun) checkAPI(ctx context.Context) {
+	if d.skip("api") {
+		return
+	}
+	c := doctorCheck{Name: "api"}
+	// Same transport (TLS, proxy) and headers as the API client, but without following redirects.
+	apiClient := api.NewWithOptions(d.creds.URL, "", append(d.cli.transportOptions(), d.gatewayOptions()...)...)
+	if d.apiPath == apiPathAuto {
+		if _, err := apiClient.DetectAPIPath(ctx); err != nil {
+			c.Status, c.Message = doctorFail, err.Error()
+			c.Hint = "use the instance's root URL, or set --api-path to where the API is served"
+			d.blocked = "api"
+			d.add(c)
+			return
+		}
+	}
+	apiBase := apiClient.BaseURL
+	client := &http.Client{
+		Timeout:   d.cli.timeout,
+		Transport: apiClient.HTTP.Transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiBase+"/user", nil)
+	if err != nil {
+		c.Status, c.Message = doctorFail, err.Error()
+		d.blocked = "api"
+		d.add(c)
+		return
+	}
+	for name, values := range d.headers {
+		req.Header[name] = values
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		c.Status, c.Message = doctorFail, err.Error()
+		c.Hint = "the server could not be reached over HTTP; see the checks above"
+		d.blocked = "api"
+		d.add(c)
+		return
+	}
+	resp.Body.Close()
+	if date, err := http.ParseTime(resp.Header.Get("Date")); err == nil {
+		d.serverDate = date
+	}
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	c.Details = map[string]any{"url": apiBase + "/user", "status": resp.StatusCode, "content_type": mediaType}
+
+	switch {
+	case resp.StatusCode >= 300 && resp.StatusCode < 400:
+		c.Status = doctorWarn
+		c.Message = fmt.Sprintf("%s redirects to %s", apiBase, resp.Header.Get("Location"))
+		c.Hint = "set the URL to where the redirect points (for example https:// instead of http://)"
+	case resp.StatusCode == http.StatusNotFound || mediaType == "text/html":
+		c.Status = doctorFail
+		c.Message = fmt.Sprintf("no DocuSeal API at %s (HTTP %d, %s)", apiBase, resp.StatusCode, mediaType)
+		c.Hint = "use the instance's root URL (the CLI appends /api), or set --api-path to where the API is served (auto probes for it)"
+		d.blocked = "api"
+	case resp.StatusCode >= 500:
+		c.Status, c.Message = doctorFail, fmt.Sprintf("server error HTTP %d at %s", resp.StatusCode, apiBase)
+		c.Hint = "the server or a gateway in front of it is failing; try again later or check its logs"
+		d.blocked = "api"
+	default:
+		c.Status, c.Message = doctorPass, fmt.Sprintf("DocuSeal API answers at %s (HTTP %d without a key)", apiBase, resp.StatusCode)
+	}
+	d.add(c)
+}
+
+// checkAuth makes the same authenticated call commands make.
+func (d *doctorRun) checkAuth(ctx context.Context) {
+	if d.skip("auth") {
+		return
+	}
+	// credential_age reports the age; don't also print the usual warning.
+	d.cli.credentialAgeWarningOnce.Do(func() {})
+	c := doctorCheck{Name: "auth"}
+	client, err := d.cli.getClient()
+	if err == nil {
+		var user *api.User
+		if user, err = client.GetUser(ctx); err == nil {
+			c.Status, c.Message = doctorPass, fmt.Sprintf("authenticated as %s (user %d)", user.Email, user.ID)
+			d.add(c)
+			return
+		}
+	}
+	c.Status, c.Message = doctorFail, err.Error()
+	var authErr *api.AuthError
+	if errors.As(err, &authErr) {
+		c.Hint = "the API key is wrong or was revoked; create a new one in DocuSeal settings and run 'docuseal auth login'"
+	}
+	d.add(c)
+}
+
+func (d *doctorRun) checkClock() {
+	c := doctorCheck{Name: "clock"}
+	if d.serverDate.IsZero() {
+		if !d.skip("clock") {
+			c.Status, c.Message = doctorSkip, "skipped: no Date header from the server"
+			d.add(c)
+		}
+		return
+	}
+	skew := d.cli.now().Sub(d.serverDate)
+	c.Details = map[string]any{"server_date": d.serverDate.UTC(), "skew_seconds": int(skew.Seconds())}
+	if skew.Abs() > doctorClockSkewWarning {
+		direction := "ahead of"
+		if skew < 0 {
+			direction = "behind"
+		}
+		c.Status = doctorWarn
+		c.Message = fmt.Sprintf("local clock is %s %s the server", skew.Abs().Round(time.Second), direction)
+		c.Hint = "enable time synchronization (NTP); skew breaks certificate checks and relative dates"
+	} else {
+		c.Status, c.Message = doctorPass, fmt.Sprintf("within %s of the server", skew.Abs().Round(time.Second))
+	}
+	d.add(c)
+}
+
+func (d *doctorRun) checkCredentialAge() {
+	c := doctorCheck{Name: "credential_age"}
+	switch {
+	case d.credsErr != nil:
+		c.Status, c.Message = doctorSkip, "skipped: no credentials"
+	case d.source == "environment":
+		c.Status, c.Message = doctorPass, "not tracked for environment credentials"
+	case d.creds.CreatedAt.IsZero():
+		c.Status, c.Message = doctorPass, "unknown (saved before creation dates were recorded)"
+	default:
+		days := int(d.cli.now().Sub(d.creds.CreatedAt).Hours() / 24)
+		c.Details = map[string]any{"created_at": d.creds.CreatedAt.UTC(), "days": days}
+		c.Status, c.Message = doctorPass, fmt.Sprintf("%d days old (saved %s)", days, d.creds.CreatedAt.Format("2006-01-02"))
+		if config.CheckCredentialAge(d.creds) != "" {
+			c.Status = doctorWarn
+			c.Hint = "rotate the API key: create a new one in DocuSeal settings and run 'docuseal auth login'"
+		}
+	}
+	d.add(c)
+}
@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/docuseal/docuseal-cli/internal/config"
+)
+
+func runDoctorTest(t *testing.T, srv *httptest.Server, args ...string) (map[string]doctorCheck, error) {
+	t.Helper()
+	t.Setenv("KEYRING_BACKEND", "file")
+	t.Setenv("KEYRING_FILE_DIR", t.TempDir())
+	t.Setenv("DOCUSEAL_CONFIG_DIR", t.TempDir())
+	creds := func() (config.Credentials, error) {
+		return config.Credentials{URL: srv.URL, APIKey: "test"}, nil
+	}
+	var out bytes.Buffer
+	cli := New(Options{Stdout: &out, Stderr: io.Discard, Credentials: creds})
+	err := cli.Execute(context.Background(), append([]string{"doctor", "-o", "json"}, args...))
+
+	var report doctorReport
+	if jerr := json.Unmarshal(out.Bytes(), &report); jerr != nil {
+		t.Fatalf("doctor output %q: %v", out.String(), jerr)
+	}
+	checks := map[string]doctorCheck{}
+	for _, c := range report.Checks {
+		checks[c.Name] = c
+	}
+	if report.OK != (err == nil) {
+		t.Errorf("report ok = %v, error = %v", report.OK, err)
+	}
+	return checks, err
+}
+
+func docusealHandler(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/api/user" {
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusNotFound)
+		io.WriteString(w, "<html>not found</html>")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if r.Header.Get("X-Auth-Token") != "test" {
+		w.WriteHeader(http.StatusUnauthorized)
+		io.WriteString(w, `{"error":"Not authenticated"}`)
+		return
+	}
+	io.WriteString(w, `{"id":1,"email":"jane@example.com"}`)
+}
+
+func TestDoctor(t *testing.T) {
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(docusealHandler))
+	srv.Config.ErrorLog = log.New(io.Discard, "", 0) // the tls check hangs up after the handshake
+	srv.StartTLS()
+	defer srv.Close()
+
+	t.Run("untrusted certificate", func(t *testing.T) {
+		checks, err := runDoctorTest(t, srv)
+		if err == nil {
+			t.Fatal("doctor error = nil, want failure")
+		}
+		if c := checks["tls"]; c.Status != doctorFail || c.Hint == "" || c.Details["chain"] == nil {
+			t.Errorf("tls = %+v, want fail with chain and hint", c)
+		}
+		for _, name := range []string{"api", "auth"} {
+			if checks[name].Status != doctorSkip {
+				t.Errorf("%s = %+v, want skip", name, checks[name])
+			}
+		}
+	})
+
+	t.Run("verification disabled", func(t *testing.T) {
+		checks, err := runDoctorTest(t, srv, "--insecure-skip-verify")
+		if err != nil {
+			t.Fatalf("doctor error = %v", err)
+		}
+		want := map[string]string{"config": doctorPass, "env": doctorPass, "trust": doctorWarn, "dns": doctorPass, "tcp": doctorPass, "tls": doctorWarn, "api": doctorPass, "auth": doctorPass, "clock": doctorPass}
+		for name, status := range want {
+			if checks[name].Status != status {
+				t.Errorf("%s = %+v, want %s", name, checks[name], status)
+			}
+		}
+	})
+
+	t.Run("custom CA", func(t *testing.T) {
+		caFile := filepath.Join(t.TempDir(), "ca.pem")
+		pemData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+		if err := os.WriteFile(caFile, pemData, 0o600); err != nil {
+			t.Fatal(err)
+		}
+		checks, err := runDoctorTest(t, srv, "--ca-cert", caFile, "--tls-min-version", "1.3")
+		if err != nil {
+			t.Fatalf("doctor error = %v", err)
+		}
+		for _, name := range []string{"trust", "tls", "api", "auth"} {
+			if checks[name].Status != doctorPass {
+				t.Errorf("%s = %+v, want pass", name, checks[name])
+			}
+		}
+		if msg := checks["trust"].Message; !strings.Contains(msg, caFile) || !strings.Contains(msg, "TLS >= 1.3") {
+			t.Errorf("trust message = %q, want the CA file and minimum version", msg)
+		}
+	})
+}
+
+func TestDoctorWrongAPIPath(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.URL.Path = "/elsewhere" + r.URL.Path
+		docusealHandler(w, r)
+	}))
+	defer srv.Close()
+
+	checks, err := runDoctorTest(t, srv)
+	if err == nil {
+		t.Fatal("doctor error = nil, want failure")
+	}
+	if c := checks["api"]; c.Status != doctorFail || c.Hint == "" {
+		t.Errorf("api = %+v, want fail with hint", c)
+	}
+	if checks["tls"].Status != doctorSkip || checks["auth"].Status != doctorSkip {
+		t.Errorf("tls = %+v, auth = %+v, want skipped", checks["tls"], checks["auth"])
+	}
+}
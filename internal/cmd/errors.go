@@ -12,6 +12,7 @@ import (
 	"github.com/docuseal/docuseal-cli/internal/api"
 	"github.com/docuseal/docuseal-cli/internal/config"
 	"github.com/docuseal/docuseal-cli/internal/outfmt"
+	"github.com/docuseal/docuseal-cli/internal/policy"
 )
 
 // DetectOutputMode inspects CLI args (plus DOCUSEAL_OUTPUT) to determine output mode.
@@ -40,38 +41,58 @@ func DetectOutputMode(args []string) (outfmt.Mode, error) {
 // WriteError prints an error to w, using JSON when the caller requested JSON output.
 // Errors always go to stderr in this CLI so stdout remains machine-parseable for success paths.
 func WriteError(w io.Writer, args []string, err error) {
+	var pe *pluginExitError
+	if errors.As(err, &pe) {
+		return // the plugin reported its own error
+	}
+
 	mode, parseErr := DetectOutputMode(args)
 	if parseErr != nil {
 		// If output is invalid, fall back to plain text so the error is visible.
 		mode = outfmt.Text
 	}
 
-	exitCode := ExitCode(err)
-
 	if mode == outfmt.JSON || mode == outfmt.NDJSON {
-		payload := map[string]any{
-			"error":     err.Error(),
-			"type":      classifyError(err),
-			"exit_code": exitCode,
-		}
-
-		var rl *api.RateLimitError
-		if errors.As(err, &rl) {
-			payload["retry_after_seconds"] = rl.RetryAfter
-		}
-
 		enc := json.NewEncoder(w)
 		enc.SetEscapeHTML(false)
-		_ = enc.Encode(payload)
+		_ = enc.Encode(errorPayload(err))
 		return
 	}
 
 	_, _ = io.WriteString(w, "Error: "+err.Error()+"\n")
 }
 
+// errorPayload returns the machine-readable description of err used for JSON error output.
+func errorPayload(err error) map[string]any {
+	payload := map[string]any{
+		"error":     err.Error(),
+		"type":      classifyError(err),
+		"exit_code": ExitCode(err),
+	}
+
+	var rl *api.RateLimitError
+	if errors.As(err, &rl) {
+		payload["retry_after_seconds"] = rl.RetryAfter
+	}
+
+	var pe *policy.Error
+	if errors.As(err, &pe) {
+		payload["rule"] = pe.Rule
+		payload["reason"] = pe.Reason
+		if pe.Command != "" {
+			payload["command"] = pe.Command
+		}
+	}
+	return payload
+}
+
 // ExitCode returns a stable numeric exit code for known failure types.
 // Keep these values small and stable: agent runners frequently branch on them.
 func ExitCode(err error) int {
+	var pe *pluginExitError
+	if errors.As(err, &pe) {
+		return pe.code
+	}
 	switch classifyError(err) {
 	case "validation":
 		return 2
@@ -85,6 +106,8 @@ func ExitCode(err error) int {
 		return 6
 	case "timeout":
 		return 7
+	case "policy":
+		return 8
 	default:
 		return 1
 	}
@@ -92,6 +115,8 @@ func ExitCode(err error) int {
 
 func classifyError(err error) string {
 	switch {
+	case policy.IsPolicyError(err):
+		return "policy"
 	case errors.Is(err, config.ErrNotConfigured):
 		return "not_configured"
 	case api.IsAuthError(err):
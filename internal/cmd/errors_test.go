@@ -6,6 +6,7 @@ import (
 
 	"github.com/docuseal/docuseal-cli/internal/api"
 	"github.com/docuseal/docuseal-cli/internal/config"
+	"github.com/docuseal/docuseal-cli/internal/policy"
 )
 
 func TestExitCode(t *testing.T) {
@@ -20,6 +21,7 @@ func TestExitCode(t *testing.T) {
 		{"rate_limit", &api.RateLimitError{RetryAfter: 1}, 4},
 		{"circuit_breaker", &api.CircuitBreakerError{}, 6},
 		{"timeout", context.DeadlineExceeded, 7},
+		{"policy", &policy.Error{Rule: policy.RuleReadOnly}, 8},
 	}
 
 	for _, tt := range tests {
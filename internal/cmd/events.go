@@ -2,87 +2,104 @@ package cmd
 
 import (
 	"fmt"
-	"os"
+	"strconv"
 
 	"github.com/docuseal/docuseal-cli/internal/api"
 	"github.com/docuseal/docuseal-cli/internal/outfmt"
+	"github.com/docuseal/docuseal-cli/internal/ui"
 	"github.com/spf13/cobra"
 )
 
-var eventsCmd = &cobra.Command{
-	Use:     "events",
-	Aliases: []string{"event", "ev"},
-	Short:   "View events and webhooks",
-	Long:    `List form and submission events.`,
+// eventsState holds the events command state of a CLI.
+type eventsState struct {
+	eventsCmd          *cobra.Command
+	eventsListCmd      *cobra.Command
+	eventsType         string
+	eventsCategory     string
+	eventsSubmissionID int
+	eventsLimit        int
+}
+
+// eventColumns are the text-mode columns of 'events list'; hidden ones are shown with --columns.
+var eventColumns = []ui.Column{
+	{Name: "id", Header: "ID", Fixed: true},
+	{Name: "type", Header: "TYPE"},
+	{Name: "submission_id", Header: "SUBMISSION_ID", Fixed: true},
+	{Name: "submitter_id", Header: "SUBMITTER_ID", Fixed: true},
+	{Name: "created", Header: "CREATED", Fixed: true},
 }
 
-var eventsListCmd = &cobra.Command{
-	Use:   "list",
-	Short: "List events",
-	Long: `List events by type.
+func (cli *CLI) initEvents() {
+	cli.eventsCmd = &cobra.Command{
+		Use:     "events",
+		Aliases: []string{"event", "ev"},
+		Short:   "View events and webhooks",
+		Long:    `List form and submission events.`,
+	}
+
+	cli.eventsListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List events",
+		Long: `List events by type.
 
 Event types for forms: view, start, complete
 Event types for submissions: created, completed, archived`,
-	RunE: runEventsList,
-}
+		RunE: cli.runEventsList,
+	}
 
-var (
-	eventsType         string
-	eventsCategory     string
-	eventsSubmissionID int
-	eventsLimit        int
-)
+	cli.rootCmd.AddCommand(cli.eventsCmd)
+	cli.eventsCmd.AddCommand(cli.eventsListCmd)
 
-func init() {
-	rootCmd.AddCommand(eventsCmd)
-	eventsCmd.AddCommand(eventsListCmd)
+	cli.eventsListCmd.Flags().StringVar(&cli.eventsCategory, "category", "submission", "Event category: form, submission")
+	cli.eventsListCmd.Flags().StringVar(&cli.eventsType, "type", "completed", "Event type (e.g., view, start, complete, created, completed, archived)")
+	cli.eventsListCmd.Flags().IntVar(&cli.eventsSubmissionID, "submission-id", 0, "Filter by submission ID (for submission events)")
+	cli.eventsListCmd.Flags().IntVar(&cli.eventsLimit, "limit", 0, "Maximum number of events to return")
+	cli.addTableFlags(cli.eventsListCmd, eventColumns)
 
-	eventsListCmd.Flags().StringVar(&eventsCategory, "category", "submission", "Event category: form, submission")
-	eventsListCmd.Flags().StringVar(&eventsType, "type", "completed", "Event type (e.g., view, start, complete, created, completed, archived)")
-	eventsListCmd.Flags().IntVar(&eventsSubmissionID, "submission-id", 0, "Filter by submission ID (for submission events)")
-	eventsListCmd.Flags().IntVar(&eventsLimit, "limit", 0, "Maximum number of events to return")
+	mustRegisterFlagCompletion(cli.eventsListCmd, "submission-id", cli.idFlagCompletion("completion-submissions"))
 }
 
-func runEventsList(cmd *cobra.Command, args []string) error {
-	client, err := getClient()
+func (cli *CLI) runEventsList(cmd *cobra.Command, args []string) error {
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
-	limit := eventsLimit
+	limit := cli.eventsLimit
 	reqLimit := limit
-	if ((mode == outfmt.JSON && !bareJSON) || (mode == outfmt.NDJSON && withMeta)) && limit > 0 {
+	if ((mode == outfmt.JSON && !cli.bareJSON) || (mode == outfmt.NDJSON && cli.withMeta)) && limit > 0 {
 		reqLimit = limit + 1
 	}
 
 	var events []api.Event
 	var listErr error
 
-	if eventsCategory == "form" {
-		events, listErr = client.ListFormEvents(cmd.Context(), eventsType, reqLimit)
+	if cli.eventsCategory == "form" {
+		events, listErr = client.ListFormEvents(cmd.Context(), cli.eventsType, reqLimit)
 	} else {
-		events, listErr = client.ListSubmissionEvents(cmd.Context(), eventsType, eventsSubmissionID, reqLimit)
+		events, listErr = client.ListSubmissionEvents(cmd.Context(), cli.eventsType, cli.eventsSubmissionID, reqLimit)
 	}
 
 	if listErr != nil {
 		return fmt.Errorf("failed to list events: %w", listErr)
 	}
 
-	if (mode == outfmt.JSON && !bareJSON) || (mode == outfmt.NDJSON && withMeta) {
+	if (mode == outfmt.JSON && !cli.bareJSON) || (mode == outfmt.NDJSON && cli.withMeta) {
 		out := events
 		hasMore := false
 		if limit > 0 && len(out) > limit {
 			hasMore = true
 			out = out[:limit]
 		}
+		sortItems(cli.sortFlag, out)
 
-		if mode == outfmt.JSON && !bareJSON {
+		if mode == outfmt.JSON && !cli.bareJSON {
 			env := makeListEnvelope(out, len(out), limit, 0, 0, hasMore, 0, 0)
-			env["category"] = eventsCategory
-			env["type"] = eventsType
-			env["submission_id"] = eventsSubmissionID
-			outputResult(mode, env, func() {})
+			env["category"] = cli.eventsCategory
+			env["type"] = cli.eventsType
+			env["submission_id"] = cli.eventsSubmissionID
+			cli.outputResult(mode, env, func() {})
 			return nil
 		}
 
@@ -91,9 +108,9 @@ func runEventsList(cmd *cobra.Command, args []string) error {
 				"count":         len(out),
 				"limit":         limit,
 				"has_more":      hasMore,
-				"category":      eventsCategory,
-				"type":          eventsType,
-				"submission_id": eventsSubmissionID,
+				"category":      cli.eventsCategory,
+				"type":          cli.eventsType,
+				"submission_id": cli.eventsSubmissionID,
 			},
 		}
 		stream := make([]any, 0, len(out)+1)
@@ -101,45 +118,40 @@ func runEventsList(cmd *cobra.Command, args []string) error {
 			stream = append(stream, e)
 		}
 		stream = append(stream, meta)
-		outputResult(mode, stream, func() {})
+		cli.outputResult(mode, stream, func() {})
 		return nil
 	}
 
-	outputResult(mode, events, func() {
+	sortItems(cli.sortFlag, events)
+
+	cli.outputResult(mode, events, func() {
 		if len(events) == 0 {
-			fmt.Println("No events found")
+			fmt.Fprintln(cli.stdout, "No events found")
 			return
 		}
-		w := newTabWriter()
-		if _, err := fmt.Fprintln(w, "ID\tTYPE\tSUBMISSION_ID\tSUBMITTER_ID\tCREATED"); err != nil {
-			fmt.Fprintf(os.Stderr, "Error writing output: %v\n", err)
-		}
+		rows := make([][]string, 0, len(events))
 		for _, e := range events {
 			submissionID := "-"
 			if e.SubmissionID > 0 {
-				submissionID = fmt.Sprintf("%d", e.SubmissionID)
+				submissionID = strconv.Itoa(e.SubmissionID)
 			}
 			submitterID := "-"
 			if e.SubmitterID > 0 {
-				submitterID = fmt.Sprintf("%d", e.SubmitterID)
+				submitterID = strconv.Itoa(e.SubmitterID)
 			}
-			if _, err := fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n",
-				e.ID,
+			rows = append(rows, []string{
+				strconv.Itoa(e.ID),
 				e.EventType,
 				submissionID,
 				submitterID,
 				formatTime(e.CreatedAt),
-			); err != nil {
-				fmt.Fprintf(os.Stderr, "Error writing output: %v\n", err)
-			}
-		}
-		if err := w.Flush(); err != nil {
-			fmt.Fprintf(os.Stderr, "Error flushing output: %v\n", err)
+			})
 		}
+		cli.renderTable(eventColumns, rows)
 
 		// Pagination hint
-		if eventsLimit > 0 && len(events) == eventsLimit {
-			fmt.Fprintf(os.Stderr, "\n# More results may be available. Use --limit with higher value.\n")
+		if cli.eventsLimit > 0 && len(events) == cli.eventsLimit {
+			cli.getLogger().Info("More results may be available; use a higher --limit", "limit", cli.eventsLimit)
 		}
 	})
 
@@ -0,0 +1,227 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+	"github.com/docuseal/docuseal-cli/internal/config"
+)
+
+// apiPathAuto makes the CLI probe for the API path instead of assuming one.
+const apiPathAuto = "auto"
+
+// reservedHeaders are set by the client itself and cannot be given with --header.
+var reservedHeaders = map[string]bool{
+	"X-Auth-Token":    true,
+	"Content-Type":    true,
+	"Content-Length":  true,
+	"Accept":          true,
+	"Host":            true,
+	"Idempotency-Key": true,
+}
+
+// gatewayState holds where the API lives below the URL and the extra headers requests
+// carry, for deployments behind path-based proxies and authenticating gateways.
+type gatewayState struct {
+	apiPathFlag string
+	headerFlags []string
+
+	flagHeaders http.Header
+
+	// detectedAPIPaths caches --api-path auto results by URL.
+	detectedMu       sync.Mutex
+	detectedAPIPaths map[string]string
+}
+
+// initGatewayFlags registers --api-path and --header.
+func (cli *CLI) initGatewayFlags() {
+	flags := cli.rootCmd.PersistentFlags()
+	flags.StringVar(&cli.apiPathFlag, "api-path", os.Getenv("DOCUSEAL_API_PATH"), "API path below the URL: /api, / for the URL itself, or auto to probe /user (default: the profile's, else /api) (env: DOCUSEAL_API_PATH)")
+	flags.StringArrayVar(&cli.headerFlags, "header", nil, "Extra request header 'Name: value', e.g. for an access gateway (repeatable; overrides the profile's)")
+}
+
+// loadGateway validates --api-path and parses --header.
+func (cli *CLI) loadGateway() error {
+	if err := validateAPIPath(cli.apiPathFlag); err != nil {
+		return err
+	}
+	cli.flagHeaders = http.Header{}
+	for _, raw := range cli.headerFlags {
+		name, value, err := parseHeader(raw)
+		if err != nil {
+			return &api.ValidationError{Field: "header", Message: err.Error()}
+		}
+		cli.flagHeaders.Add(name, value)
+	}
+	return nil
+}
+
+func validateAPIPath(path string) error {
+	if path == "" || path == apiPathAuto || strings.HasPrefix(path, "/") {
+		return nil
+	}
+	return &api.ValidationError{Field: "api-path", Message: fmt.Sprintf("%q must start with / or be %q", path, apiPathAuto)}
+}
+
+// parseHeader splits "Name: value", refusing names that are not HTTP tokens or that
+// the client sets itself. The value is left out of errors since it is often a secret.
+func parseHeader(raw string) (string, string, error) {
+	name, value, ok := strings.Cut(raw, ":")
+	name = strings.TrimSpace(name)
+	if !ok || name == "" {
+		return "", "", fmt.Errorf("must be 'Name: value'")
+	}
+	for _, r := range name {
+		if r <= ' ' || r >= 0x7f || strings.ContainsRune(`"(),/:;<=>?@[\]{}`, r) {
+			return "", "", fmt.Errorf("invalid header name %q", name)
+		}
+	}
+	name = http.CanonicalHeaderKey(name)
+	if reservedHeaders[name] {
+		return "", "", fmt.Errorf("%s is set by the CLI and cannot be overridden", name)
+	}
+	value = strings.TrimSpace(value)
+	if strings.ContainsAny(value, "\r\n") {
+		return "", "", fmt.Errorf("value of %s must be on one line", name)
+	}
+	return name, value, nil
+}
+
+// gatewaySettings returns the API path and headers for the server at rawURL: the
+// profile stored for it, overridden by --api-path (or DOCUSEAL_API_PATH) and --header.
+// An empty path means the default; rawURL "" skips the profile.
+func (cli *CLI) gatewaySettings(rawURL string) (string, http.Header, error) {
+	var profile config.Profile
+	if rawURL != "" {
+		var err error
+		if profile, err = config.LoadProfile(profileName(rawURL)); err != nil {
+			return "", nil, err
+		}
+	}
+
+	path := profile.APIPath
+	if cli.apiPathFlag != "" {
+		path = cli.apiPathFlag
+	}
+	if err := validateAPIPath(path); err != nil {
+		return "", nil, fmt.Errorf("profile %s: %w", profileName(rawURL), err)
+	}
+
+	headers := http.Header{}
+	for name, value := range profile.Headers {
+		headers.Set(name, value)
+	}
+	for name, values := range cli.flagHeaders {
+		headers[name] = values
+	}
+	return path, headers, nil
+}
+
+// gatewayOptions returns the client options for the API path and headers of the server
+// at rawURL. For --api-path auto a path already detected is used; otherwise the caller
+// runs detectAPIPath.
+func (cli *CLI) gatewayOptions(rawURL string) ([]api.ClientOption, bool, error) {
+	path, headers, err := cli.gatewaySettings(rawURL)
+	if err != nil {
+		return nil, false, err
+	}
+	opts := []api.ClientOption{api.WithHeaders(headers)}
+	if path == apiPathAuto {
+		cli.detectedMu.Lock()
+		detected, ok := cli.detectedAPIPaths[rawURL]
+		cli.detectedMu.Unlock()
+		if !ok {
+			return opts, true, nil
+		}
+		path = detected
+		if path == "" {
+			path = "/"
+		}
+	}
+	if path != "" {
+		opts = append(opts, api.WithAPIPath(path))
+	}
+	return opts, false, nil
+}
+
+// detectAPIPath probes for the API path of client and remembers it for rawURL.
+func (cli *CLI) detectAPIPath(ctx context.Context, rawURL string, client *api.Client) error {
+	path, err := client.DetectAPIPath(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to detect the API path: %w", err)
+	}
+	cli.detectedMu.Lock()
+	if cli.detectedAPIPaths == nil {
+		cli.detectedAPIPaths = map[string]string{}
+	}
+	cli.detectedAPIPaths[rawURL] = path
+	cli.detectedMu.Unlock()
+	return nil
+}
+
+// newServerClient builds a client without the CLI's request hooks for the server at
+// rawURL, for checking credentials before they are stored.
+func (cli *CLI) newServerClient(ctx context.Context, rawURL, apiKey string) (*api.Client, error) {
+	gateway, detect, err := cli.gatewayOptions(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	client := cli.newClient(rawURL, apiKey, append(cli.transportOptions(), gateway...)...)
+	if detect {
+		if err := cli.detectAPIPath(ctx, rawURL, client); err != nil {
+			return nil, err
+		}
+	}
+	return client, nil
+}
+
+// gatewayKey describes --api-path and --header, for caching clients per setting.
+func (cli *CLI) gatewayKey() string {
+	return fmt.Sprint(cli.apiPathFlag, cli.headerFlags)
+}
+
+// headerNames lists the names of h, sorted, for reports that must not show values.
+func headerNames(h http.Header) []string {
+	names := make([]string, 0, len(h))
+	for name := range h {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// saveGatewayProfile stores --api-path and --header as the profile of rawURL, keeping
+// stored headers that --header does not override. A detected path is stored instead
+// of auto.
+func (cli *CLI) saveGatewayProfile(rawURL string, client *api.Client) error {
+	if cli.apiPathFlag == "" && len(cli.flagHeaders) == 0 {
+		return nil
+	}
+	name := profileName(rawURL)
+	profile, err := config.LoadProfile(name)
+	if err != nil {
+		return err
+	}
+	if cli.apiPathFlag != "" {
+		profile.APIPath = cli.apiPathFlag
+		if profile.APIPath == apiPathAuto {
+			profile.APIPath = client.APIPath()
+			if profile.APIPath == "" {
+				profile.APIPath = "/"
+			}
+		}
+	}
+	for header := range cli.flagHeaders {
+		if profile.Headers == nil {
+			profile.Headers = map[string]string{}
+		}
+		profile.Headers[header] = cli.flagHeaders.Get(header)
+	}
+	return config.SaveProfile(name, profile)
+}
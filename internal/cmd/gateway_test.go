@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+	"github.com/docuseal/docuseal-cli/internal/config"
+)
+
+// gatewayHandler serves the API at /docuseal, behind a gateway that wants a
+// CF-Access-Client-Id header.
+func gatewayHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("CF-Access-Client-Id") != "client-id" {
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusForbidden)
+		io.WriteString(w, "<html>access denied</html>")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	switch r.URL.Path {
+	case "/docuseal/user":
+		io.WriteString(w, `{"id":1,"email":"jane@example.com"}`)
+	case "/docuseal/templates":
+		io.WriteString(w, `{"data":[],"pagination":{}}`)
+	default:
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestGatewayAPIPathAndHeaders(t *testing.T) {
+	configDir := t.TempDir()
+	t.Setenv("DOCUSEAL_CONFIG_DIR", configDir)
+	t.Setenv("KEYRING_BACKEND", "file")
+	t.Setenv("KEYRING_FILE_DIR", t.TempDir())
+	t.Setenv("DOCUSEAL_URL", "")
+	t.Setenv("DOCUSEAL_API_KEY", "")
+	t.Setenv("DOCUSEAL_API_PATH", "")
+	srv := httptest.NewServer(http.HandlerFunc(gatewayHandler))
+	defer srv.Close()
+
+	run := func(args ...string) (string, error) {
+		var out bytes.Buffer
+		cli := New(Options{Stdout: &out, Stderr: io.Discard})
+		err := cli.Execute(context.Background(), args)
+		return out.String(), err
+	}
+
+	if _, err := run("auth", "login", "--url", srv.URL+"/docuseal", "--api-key", "test"); err == nil {
+		t.Fatal("login without the gateway header: error = nil, want failure")
+	}
+	if _, err := run("auth", "login", "--url", srv.URL+"/docuseal", "--api-key", "test",
+		"--api-path", "auto", "--header", "CF-Access-Client-Id: client-id"); err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	profile, err := config.LoadProfile(profileName(srv.URL))
+	if err != nil || profile.APIPath != "/" || profile.Headers["Cf-Access-Client-Id"] != "client-id" {
+		t.Fatalf("saved profile = %+v, %v", profile, err)
+	}
+	if data, err := os.ReadFile(filepath.Join(configDir, config.ProfilesFileName)); err != nil || strings.Contains(string(data), "client-id") {
+		t.Fatalf("profiles file = %s, %v; want no header values", data, err)
+	}
+
+	// Later commands use the profile.
+	out, err := run("auth", "whoami", "-o", "json")
+	if err != nil || !strings.Contains(out, "jane@example.com") {
+		t.Fatalf("whoami = %q, %v", out, err)
+	}
+
+	// Dry runs show the resolved URL but not header values.
+	out, err = run("templates", "archive", "5", "--dry-run", "--header", "X-Tenant: acme")
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	var preview struct {
+		URL     string            `json:"url"`
+		Headers map[string]string `json:"headers"`
+	}
+	if err := json.Unmarshal([]byte(out), &preview); err != nil {
+		t.Fatalf("dry run output %q: %v", out, err)
+	}
+	if preview.URL != srv.URL+"/docuseal/templates/5" || preview.Headers["Cf-Access-Client-Id"] != "[REDACTED]" || preview.Headers["X-Tenant"] != "[REDACTED]" {
+		t.Errorf("dry run = %+v", preview)
+	}
+
+	data, err := os.ReadFile(filepath.Join(configDir, config.ProfilesFileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"api_path": "/"`) {
+		t.Errorf("profiles file = %s", data)
+	}
+}
+
+func TestLoadGateway_Validation(t *testing.T) {
+	tests := []struct {
+		args      []string
+		wantField string
+	}{
+		{[]string{"--api-path", "api"}, "api-path"},
+		{[]string{"--header", "no colon"}, "header"},
+		{[]string{"--header", "Bad Name: x"}, "header"},
+		{[]string{"--header", "X-Auth-Token: spoofed"}, "header"},
+	}
+	for _, tt := range tests {
+		cli := New(Options{Stdout: io.Discard, Stderr: io.Discard})
+		err := cli.Execute(context.Background(), append([]string{"version"}, tt.args...))
+		var verr *api.ValidationError
+		if !errors.As(err, &verr) || verr.Field != tt.wantField {
+			t.Errorf("%v: error = %v, want validation error on %s", tt.args, err, tt.wantField)
+		}
+	}
+}
@@ -6,48 +6,71 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var helpJSON bool
-
-var helpCmd = &cobra.Command{
-	Use:                   "help [command]",
-	Short:                 "Help about any command",
-	Long:                  "Help provides help for any command in the application.",
-	DisableFlagsInUseLine: true,
-	Args:                  cobra.ArbitraryArgs,
-	RunE:                  runHelp,
+// helpState holds the help command state of a CLI.
+type helpState struct {
+	helpJSON bool
+	helpCmd  *cobra.Command
 }
 
-func init() {
-	helpCmd.Flags().BoolVar(&helpJSON, "json", false, "Output help as machine-readable JSON schema")
-	rootCmd.SetHelpCommand(helpCmd)
+func (cli *CLI) initHelp() {
+	cli.helpCmd = &cobra.Command{
+		Use:                   "help [command]",
+		Short:                 "Help about any command",
+		Long:                  "Help provides help for any command in the application.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ArbitraryArgs,
+		RunE:                  cli.runHelp,
+	}
+
+	cli.helpCmd.Flags().BoolVar(&cli.helpJSON, "json", false, "Output help as machine-readable JSON schema")
+	cli.rootCmd.SetHelpCommand(cli.helpCmd)
 }
 
-func runHelp(cmd *cobra.Command, args []string) error {
-	if helpJSON {
-		target := rootCmd
-		if len(args) > 0 {
-			found, _, err := rootCmd.Find(args)
-			if err != nil {
-				return err
-			}
-			target = found
+func (cli *CLI) runHelp(cmd *cobra.Command, args []string) error {
+	if cli.helpJSON {
+		mode := cli.getOutputMode()
+		spec, err := cli.helpSchema(args)
+		if err != nil {
+			return err
 		}
-
-		mode := getOutputMode()
-		spec := schemaCommandFromCommand(target)
-		outputResult(mode, spec, func() {
-			fmt.Println("Use --output json to get machine-readable help.")
+		cli.outputResult(mode, spec, func() {
+			fmt.Fprintln(cli.stdout, "Use --output json to get machine-readable help.")
 		})
 		return nil
 	}
 
-	// Default behavior: show help for the requested command.
+	// Default behavior: show help for the requested command. Plugins print their own.
+	if len(args) > 0 {
+		if path, name, _, _, ok := cli.findPlugin(args[:1]); ok {
+			return cli.runPlugin(cmd.Context(), path, name, nil, append([]string{"--help"}, args[1:]...))
+		}
+	}
 	if len(args) == 0 {
-		return rootCmd.Help()
+		return cli.rootCmd.Help()
 	}
-	c, _, err := rootCmd.Find(args)
+	c, _, err := cli.rootCmd.Find(args)
 	if err != nil {
 		return err
 	}
 	return c.Help()
 }
+
+// helpSchema describes the command named by args, or the root command with plugins
+// listed among its subcommands.
+func (cli *CLI) helpSchema(args []string) (schemaCommand, error) {
+	if len(args) == 0 {
+		spec := schemaCommandFromCommand(cli.rootCmd)
+		spec.Subcommands = append(spec.Subcommands, cli.pluginSchemaCommands()...)
+		return spec, nil
+	}
+	found, _, err := cli.rootCmd.Find(args)
+	if err != nil {
+		for _, p := range cli.discoverPlugins() {
+			if p.Name == args[0] && p.ShadowedBy == "" {
+				return cli.pluginSchemaCommand(p), nil
+			}
+		}
+		return schemaCommand{}, err
+	}
+	return schemaCommandFromCommand(found), nil
+}
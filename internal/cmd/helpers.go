@@ -1,9 +1,11 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
@@ -13,6 +15,8 @@ import (
 	"github.com/docuseal/docuseal-cli/internal/api"
 	"github.com/docuseal/docuseal-cli/internal/validation"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	"gopkg.in/yaml.v3"
 )
 
 // emailWithNameRe matches "Name <email>" format
@@ -98,7 +102,8 @@ func parseIntSlice(s string) ([]int, error) {
 	return result, nil
 }
 
-// parseIDArg accepts a numeric ID or a URL containing a numeric ID as the last path segment.
+// parseIDArg accepts a numeric ID, a DocuSeal URL such as /submissions/<id>, or a URL
+// containing a numeric ID as the last path segment.
 // This matches common agent "desire paths" like passing a copied browser URL.
 func parseIDArg(s string) (int, error) {
 	raw := strings.TrimSpace(s)
@@ -109,6 +114,15 @@ func parseIDArg(s string) (int, error) {
 		return id, nil
 	}
 
+	// DocuSeal URLs name their resource; signing and document links carry a slug, whose
+	// trailing digits must not be mistaken for an ID.
+	if ref, ok := parseResourceURL(raw); ok {
+		if ref.ID > 0 {
+			return ref.ID, nil
+		}
+		return 0, fmt.Errorf("invalid ID %q: link identifies a %s by slug, not ID", s, strings.TrimSuffix(ref.Kind, "s"))
+	}
+
 	// Try URL parsing first.
 	if u, err := url.Parse(raw); err == nil && u != nil && u.Host != "" && u.Path != "" {
 		parts := strings.Split(strings.Trim(u.Path, "/"), "/")
@@ -130,9 +144,40 @@ func parseIDArg(s string) (int, error) {
 	return 0, fmt.Errorf("invalid ID %q: expected a number or a URL ending in a number", s)
 }
 
+// parseJSONFlag decodes a flag value holding inline JSON, or "@path" to read the
+// value from a .json, .yaml or .yml file.
+func parseJSONFlag(value string, v any) error {
+	if !strings.HasPrefix(value, "@") {
+		return json.Unmarshal([]byte(value), v)
+	}
+
+	path := strings.TrimPrefix(value, "@")
+	data, err := os.ReadFile(path) // #nosec G304 -- path is provided by the user
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		var doc any
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return fmt.Errorf("invalid YAML in %s: %w", path, err)
+		}
+		// Round-trip through JSON so typed targets decode exactly as they would from JSON.
+		data, err = json.Marshal(doc)
+		if err != nil {
+			return fmt.Errorf("invalid YAML in %s: %w", path, err)
+		}
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("invalid JSON in %s: %w", path, err)
+	}
+	return nil
+}
+
 // newTabWriter creates a tabwriter for aligned output
-func newTabWriter() *tabwriter.Writer {
-	return tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+func (cli *CLI) newTabWriter() *tabwriter.Writer {
+	return tabwriter.NewWriter(cli.stdout, 0, 0, 2, ' ', 0)
 }
 
 // formatTime formats a time for display
@@ -160,6 +205,14 @@ func truncateString(s string, maxLen int) string {
 	return string(runes[:maxLen-3]) + "..."
 }
 
+// mustRegisterFlagCompletion registers a dynamic completion for a flag, panicking if the flag
+// does not exist. Like mustMarkFlagRequired, errors here are programming bugs.
+func mustRegisterFlagCompletion(cmd *cobra.Command, flagName string, fn func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective)) {
+	if err := cmd.RegisterFlagCompletionFunc(flagName, fn); err != nil {
+		panic(fmt.Sprintf("failed to register completion for flag %q: %v", flagName, err))
+	}
+}
+
 // mustMarkFlagRequired marks a flag as required, panicking if it fails
 // This should only be used during initialization where errors indicate a programming bug
 func mustMarkFlagRequired(cmd *cobra.Command, flagName string) {
@@ -167,3 +220,9 @@ func mustMarkFlagRequired(cmd *cobra.Command, flagName string) {
 		panic(fmt.Sprintf("failed to mark flag %q as required: %v", flagName, err))
 	}
 }
+
+// isTerminal reports whether v is a file attached to a terminal.
+func isTerminal(v any) bool {
+	f, ok := v.(*os.File)
+	return ok && term.IsTerminal(int(f.Fd()))
+}
@@ -1,8 +1,12 @@
 package cmd
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
 )
 
 func TestParseSubmitters(t *testing.T) {
@@ -307,9 +311,7 @@ func TestMaskSecret(t *testing.T) {
 }
 
 func TestIsDryRun(t *testing.T) {
-	// Save original value and restore after test
-	originalDryRun := dryRun
-	defer func() { dryRun = originalDryRun }()
+	cli := New(Options{})
 
 	tests := []struct {
 		name     string
@@ -330,8 +332,8 @@ func TestIsDryRun(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			dryRun = tt.dryRun
-			got := isDryRun()
+			cli.dryRun = tt.dryRun
+			got := cli.isDryRun()
 			if got != tt.expected {
 				t.Errorf("isDryRun() = %v, want %v", got, tt.expected)
 			}
@@ -340,9 +342,7 @@ func TestIsDryRun(t *testing.T) {
 }
 
 func TestDryRunPreview(t *testing.T) {
-	// Save original value and restore after test
-	originalDryRun := dryRun
-	defer func() { dryRun = originalDryRun }()
+	cli := New(Options{})
 
 	tests := []struct {
 		name     string
@@ -376,11 +376,45 @@ func TestDryRunPreview(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			dryRun = tt.dryRun
-			got := dryRunPreview(tt.format, tt.args...)
+			cli.dryRun = tt.dryRun
+			got := cli.dryRunPreview(tt.format, tt.args...)
 			if got != tt.expected {
 				t.Errorf("dryRunPreview() = %v, want %v", got, tt.expected)
 			}
 		})
 	}
 }
+
+func TestParseJSONFlag(t *testing.T) {
+	dir := t.TempDir()
+	yamlPath := filepath.Join(dir, "fields.yaml")
+	if err := os.WriteFile(yamlPath, []byte("- name: First Name\n  default_value: Jane\n  readonly: true\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	jsonPath := filepath.Join(dir, "values.json")
+	if err := os.WriteFile(jsonPath, []byte(`{"Company":"Acme"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var fields []api.FieldConfig
+	if err := parseJSONFlag("@"+yamlPath, &fields); err != nil {
+		t.Fatalf("parseJSONFlag(yaml) error = %v", err)
+	}
+	if len(fields) != 1 || fields[0].Name != "First Name" || fields[0].DefaultValue != "Jane" || !fields[0].ReadOnly {
+		t.Errorf("parseJSONFlag(yaml) = %+v", fields)
+	}
+
+	var values map[string]any
+	if err := parseJSONFlag("@"+jsonPath, &values); err != nil || values["Company"] != "Acme" {
+		t.Errorf("parseJSONFlag(json file) = %v, %v", values, err)
+	}
+
+	values = nil
+	if err := parseJSONFlag(`{"a":1}`, &values); err != nil || values["a"] != float64(1) {
+		t.Errorf("parseJSONFlag(inline) = %v, %v", values, err)
+	}
+
+	if err := parseJSONFlag("@"+filepath.Join(dir, "missing.yaml"), &values); err == nil {
+		t.Error("parseJSONFlag(missing file) error = nil, want error")
+	}
+}
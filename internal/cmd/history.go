@@ -0,0 +1,334 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+	"github.com/docuseal/docuseal-cli/internal/config"
+	"github.com/docuseal/docuseal-cli/internal/journal"
+	"github.com/docuseal/docuseal-cli/internal/outfmt"
+	"github.com/spf13/cobra"
+)
+
+// historyState holds the history command state of a CLI.
+type historyState struct {
+	historyCmd      *cobra.Command
+	historySince    string
+	historyResource string
+	historyCommand  string
+	historyLimit    int
+	journalRun      *journalRecorder
+}
+
+func (cli *CLI) initHistory() {
+	cli.historyCmd = &cobra.Command{
+		Use:   "history",
+		Short: "Show the local audit journal of mutating commands",
+		Long: `Show the local audit journal of commands that changed data.
+
+Every mutating command (create, update, archive, delete, ...) appends an entry to
+history.jsonl in the config directory (~/.config/docuseal, or DOCUSEAL_CONFIG_DIR):
+timestamp, profile (server host), command line with secrets redacted, affected
+resources, and the result or error class. Dry runs are not recorded.
+
+The journal rotates at 5 MiB and keeps 3 rotated files.
+Set DOCUSEAL_HISTORY=off to disable journaling.`,
+		Example: `  # Everything from the last day
+  docuseal history --since 24h
+
+  # Who touched submission 123?
+  docuseal history --resource submission:123 -o json
+
+  # Archive operations since a date
+  docuseal history --command "submissions archive" --since 2026-01-01`,
+		Args: cobra.NoArgs,
+		RunE: cli.runHistory,
+	}
+
+	cli.journalRun = &journalRecorder{}
+
+	cli.rootCmd.AddCommand(cli.historyCmd)
+
+	cli.historyCmd.Flags().StringVar(&cli.historySince, "since", "", "Only entries newer than a duration (e.g. 24h, 7d) or a date/RFC 3339 time")
+	cli.historyCmd.Flags().StringVar(&cli.historyResource, "resource", "", "Only entries affecting a resource (e.g. submission:123, template:5)")
+	cli.historyCmd.Flags().StringVar(&cli.historyCommand, "command", "", "Only entries for a command path (e.g. \"submissions archive\" or \"webhooks\")")
+	cli.historyCmd.Flags().IntVar(&cli.historyLimit, "limit", 0, "Show only the most recent N entries")
+}
+
+// resourceKinds maps API collections to the resource kinds recorded in the journal.
+var resourceKinds = map[string]string{
+	"templates":   "template",
+	"submissions": "submission",
+	"submitters":  "submitter",
+	"webhooks":    "webhook",
+	"attachments": "attachment",
+}
+
+// journalRecorder collects what the current command touched.
+type journalRecorder struct {
+	mu        sync.Mutex
+	active    bool
+	kind      string
+	profile   string
+	resources []string
+}
+
+// begin starts recording for cmd; only mutating commands are journaled, and dry runs are not.
+func (r *journalRecorder) begin(cmd *cobra.Command, dryRun bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.active = isMutating(cmd) && !dryRun
+	r.kind = ""
+	if fields := strings.Fields(commandPath(cmd)); len(fields) > 0 {
+		r.kind = resourceKinds[fields[0]]
+	}
+}
+
+// activate records the current command even though it is not marked mutating
+// (e.g. a batch containing mutating operations).
+func (r *journalRecorder) activate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.active = true
+}
+
+func (r *journalRecorder) reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.active, r.kind, r.profile, r.resources = false, "", "", nil
+}
+
+func (r *journalRecorder) setProfile(rawURL string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.profile = profileName(rawURL)
+}
+
+func (r *journalRecorder) add(resource string) {
+	for _, existing := range r.resources {
+		if existing == resource {
+			return
+		}
+	}
+	r.resources = append(r.resources, resource)
+}
+
+// requestHook records resources addressed by mutating requests (e.g. DELETE /submissions/5).
+func (r *journalRecorder) requestHook(_ context.Context, req *api.PreparedRequest) error {
+	if !api.IsMutatingMethod(req.Method) {
+		return nil
+	}
+	u, err := url.Parse(req.URL)
+	if err != nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	segs := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i := 0; i+1 < len(segs); i++ {
+		kind, ok := resourceKinds[segs[i]]
+		if !ok {
+			continue
+		}
+		if _, err := strconv.Atoi(segs[i+1]); err == nil {
+			r.add(kind + ":" + segs[i+1])
+		}
+	}
+	return nil
+}
+
+// recordResult records resources returned by a mutating command (e.g. created IDs).
+func (r *journalRecorder) recordResult(data any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.active || r.kind == "" {
+		return
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return
+	}
+	var generic any
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return
+	}
+
+	items := []any{generic}
+	if arr, ok := generic.([]any); ok {
+		items = arr
+	}
+	for _, item := range items {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		id, ok := obj["id"].(float64)
+		if !ok {
+			continue
+		}
+		// Submission creation returns submitters, which carry their submission ID.
+		if sid, ok := obj["submission_id"].(float64); ok {
+			r.add(fmt.Sprintf("submission:%d", int64(sid)))
+			r.add(fmt.Sprintf("submitter:%d", int64(id)))
+			continue
+		}
+		r.add(fmt.Sprintf("%s:%d", r.kind, int64(id)))
+	}
+}
+
+// record appends the finished command to the journal, stamped with now.
+func (r *journalRecorder) record(cmd *cobra.Command, args []string, runErr error, now time.Time) error {
+	r.mu.Lock()
+	active := r.active
+	entry := journal.Entry{
+		Time:      now.UTC(),
+		Profile:   r.profile,
+		Args:      journal.RedactArgs(args),
+		Resources: append([]string(nil), r.resources...),
+		Result:    journal.ResultOK,
+	}
+	r.mu.Unlock()
+
+	if !active || cmd == nil || journal.Disabled() {
+		return nil
+	}
+	entry.Command = commandPath(cmd)
+	if runErr != nil {
+		entry.Result = journal.ResultError
+		entry.ErrorType = classifyError(runErr)
+		entry.ExitCode = ExitCode(runErr)
+	}
+
+	dir, err := config.Dir()
+	if err != nil {
+		return err
+	}
+	return journal.New(dir).Append(entry)
+}
+
+// profileName identifies the configured server in journal entries.
+func profileName(rawURL string) string {
+	if u, err := url.Parse(rawURL); err == nil && u.Host != "" {
+		return u.Host
+	}
+	return rawURL
+}
+
+// parseSince accepts a duration (24h, 7d) or a date/RFC 3339 time.
+func parseSince(s string, now time.Time) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasSuffix(s, "d") {
+		if days, err := strconv.Atoi(strings.TrimSuffix(s, "d")); err == nil && days >= 0 {
+			return now.Add(-time.Duration(days) * 24 * time.Hour), nil
+		}
+	}
+	if d, err := time.ParseDuration(s); err == nil && d >= 0 {
+		return now.Add(-d), nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, &api.ValidationError{Field: "since", Message: fmt.Sprintf("invalid value %q: expected a duration (24h, 7d) or a date (2006-01-02)", s)}
+}
+
+func (cli *CLI) runHistory(cmd *cobra.Command, args []string) error {
+	mode := cli.getOutputMode()
+
+	filter := journal.Filter{
+		Resource: strings.TrimSpace(cli.historyResource),
+		Command:  strings.Join(strings.Fields(cli.historyCommand), " "),
+	}
+	if cli.historySince != "" {
+		since, err := parseSince(cli.historySince, cli.now())
+		if err != nil {
+			return err
+		}
+		filter.Since = since
+	}
+	if cli.historyLimit < 0 {
+		return &api.ValidationError{Field: "limit", Message: "must be >= 0"}
+	}
+
+	dir, err := config.Dir()
+	if err != nil {
+		return err
+	}
+	entries, err := journal.New(dir).Read(filter)
+	if err != nil {
+		return err
+	}
+
+	hasMore := false
+	if cli.historyLimit > 0 && len(entries) > cli.historyLimit {
+		hasMore = true
+		entries = entries[len(entries)-cli.historyLimit:]
+	}
+
+	if mode == outfmt.JSON && !cli.bareJSON {
+		cli.outputResult(mode, makeListEnvelope(entries, len(entries), cli.historyLimit, 0, 0, hasMore, 0, 0), func() {})
+		return nil
+	}
+	if mode == outfmt.NDJSON {
+		stream := make([]any, 0, len(entries)+1)
+		for _, e := range entries {
+			stream = append(stream, e)
+		}
+		if cli.withMeta {
+			stream = append(stream, map[string]any{"_meta": map[string]any{
+				"count":    len(entries),
+				"limit":    cli.historyLimit,
+				"has_more": hasMore,
+			}})
+		}
+		cli.outputResult(mode, stream, func() {})
+		return nil
+	}
+
+	cli.outputResult(mode, entries, func() {
+		if len(entries) == 0 {
+			fmt.Fprintln(cli.stdout, "No history found")
+			return
+		}
+		w := cli.newTabWriter()
+		if _, err := fmt.Fprintln(w, "TIME\tPROFILE\tCOMMAND\tRESOURCES\tRESULT"); err != nil {
+			fmt.Fprintf(cli.stderr, "Error writing output: %v\n", err)
+		}
+		for _, e := range entries {
+			result := e.Result
+			if e.ErrorType != "" {
+				result += " (" + e.ErrorType + ")"
+			}
+			resources := "-"
+			if len(e.Resources) > 0 {
+				resources = truncateString(strings.Join(e.Resources, ","), 40)
+			}
+			profile := e.Profile
+			if profile == "" {
+				profile = "-"
+			}
+			if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+				formatTime(e.Time.Local()),
+				profile,
+				e.Command,
+				resources,
+				result,
+			); err != nil {
+				fmt.Fprintf(cli.stderr, "Error writing output: %v\n", err)
+			}
+		}
+		if err := w.Flush(); err != nil {
+			fmt.Fprintf(cli.stderr, "Error flushing output: %v\n", err)
+		}
+	})
+	return nil
+}
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+)
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		in      string
+		want    time.Time
+		wantErr bool
+	}{
+		{"24h", now.Add(-24 * time.Hour), false},
+		{"7d", now.Add(-7 * 24 * time.Hour), false},
+		{"2026-03-01T00:00:00Z", time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), false},
+		{"yesterday", time.Time{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parseSince(tt.in, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseSince() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("parseSince() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJournalRecorder_Resources(t *testing.T) {
+	r := &journalRecorder{active: true, kind: "submission"}
+
+	_ = r.requestHook(context.Background(), &api.PreparedRequest{Method: "DELETE", URL: "https://example.com/api/submissions/5"})
+	_ = r.requestHook(context.Background(), &api.PreparedRequest{Method: "POST", URL: "https://example.com/api/templates/3/clone"})
+	_ = r.requestHook(context.Background(), &api.PreparedRequest{Method: "GET", URL: "https://example.com/api/submitters/9"})
+	r.recordResult([]map[string]any{{"id": 11, "submission_id": 99}})
+	r.recordResult(map[string]any{"id": 5})
+
+	want := []string{"submission:5", "template:3", "submission:99", "submitter:11"}
+	if !reflect.DeepEqual(r.resources, want) {
+		t.Errorf("resources = %v, want %v", r.resources, want)
+	}
+}
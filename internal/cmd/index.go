@@ -0,0 +1,270 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+	"github.com/docuseal/docuseal-cli/internal/cache"
+	"github.com/docuseal/docuseal-cli/internal/config"
+	"github.com/docuseal/docuseal-cli/internal/index"
+)
+
+const (
+	// indexRefreshAge is how long an index is trusted before checking for new items.
+	indexRefreshAge = time.Minute
+	// indexRebuildAge is how long before an index is rebuilt from scratch, picking up
+	// renames and archives made outside this CLI.
+	indexRebuildAge = 24 * time.Hour
+	// indexPageSize is the page size used while building an index.
+	indexPageSize = 100
+)
+
+// Index kinds and their cache keys.
+const (
+	indexTemplates   = "templates"
+	indexSubmissions = "submissions"
+)
+
+// indexState holds the --no-cache flag of a CLI.
+type indexState struct {
+	noCache bool
+}
+
+// indexMu serializes read-modify-write cycles on index files within this process
+// (batch and --stdin resolve identifiers concurrently).
+var indexMu sync.Mutex
+
+// indexSource knows how to list and fetch one kind of object for the index.
+type indexSource struct {
+	list func(ctx context.Context, client *api.Client, archived bool, limit, after, before int) ([]index.Entry, error)
+	get  func(ctx context.Context, client *api.Client, id int) (index.Entry, error)
+}
+
+var indexSources = map[string]indexSource{
+	indexTemplates: {
+		list: func(ctx context.Context, client *api.Client, archived bool, limit, after, before int) ([]index.Entry, error) {
+			items, err := client.ListTemplates(ctx, limit, "", archived, after, before)
+			if err != nil {
+				return nil, err
+			}
+			out := make([]index.Entry, 0, len(items))
+			for _, t := range items {
+				out = append(out, templateIndexEntry(t))
+			}
+			return out, nil
+		},
+		get: func(ctx context.Context, client *api.Client, id int) (index.Entry, error) {
+			t, err := client.GetTemplate(ctx, id)
+			if err != nil {
+				return index.Entry{}, err
+			}
+			return templateIndexEntry(*t), nil
+		},
+	},
+	indexSubmissions: {
+		list: func(ctx context.Context, client *api.Client, archived bool, limit, after, before int) ([]index.Entry, error) {
+			items, err := client.ListSubmissions(ctx, limit, 0, "", "", "", "", archived, after, before)
+			if err != nil {
+				return nil, err
+			}
+			out := make([]index.Entry, 0, len(items))
+			for _, s := range items {
+				out = append(out, submissionIndexEntry(s))
+			}
+			return out, nil
+		},
+		get: func(ctx context.Context, client *api.Client, id int) (index.Entry, error) {
+			s, err := client.GetSubmission(ctx, id)
+			if err != nil {
+				return index.Entry{}, err
+			}
+			return submissionIndexEntry(*s), nil
+		},
+	},
+}
+
+func templateIndexEntry(t api.Template) index.Entry {
+	return index.Entry{ID: t.ID, Slug: t.Slug, Name: t.Name, ExternalID: t.ExternalID, Archived: t.ArchivedAt != nil}
+}
+
+func submissionIndexEntry(s api.Submission) index.Entry {
+	return index.Entry{ID: s.ID, Slug: s.Slug, Name: s.Name, Archived: s.ArchivedAt != nil}
+}
+
+// profileCache returns the on-disk cache of the configured profile.
+func (cli *CLI) profileCache() (*cache.Cache, error) {
+	creds, err := cli.loadCredentials()
+	if err != nil {
+		return nil, err
+	}
+	dir, err := config.Dir()
+	if err != nil {
+		return nil, err
+	}
+	c := cache.New(dir, profileName(creds.URL))
+	c.Now = cli.now
+	return c, nil
+}
+
+func indexKey(kind string) string { return "index-" + kind }
+
+// updateIndex brings the index of kind up to date and returns it. An empty or old index is
+// rebuilt; otherwise only items after the highest known ID and IDs marked dirty are fetched.
+// force rebuilds regardless of age.
+func (cli *CLI) updateIndex(ctx context.Context, client *api.Client, kind string, force bool) (*index.Collection, error) {
+	indexMu.Lock()
+	defer indexMu.Unlock()
+
+	c, err := cli.profileCache()
+	if err != nil {
+		return nil, err
+	}
+	var col index.Collection
+	c.Load(indexKey(kind), &col)
+
+	now := cli.now()
+	switch {
+	case force || col.Empty() || now.Sub(col.BuiltAt) > indexRebuildAge:
+		if col, err = cli.rebuildIndex(ctx, client, kind); err != nil {
+			return nil, err
+		}
+	case len(col.Dirty) > 0 || now.Sub(col.RefreshedAt) > indexRefreshAge:
+		if err := cli.refreshIndex(ctx, client, kind, &col); err != nil {
+			return nil, err
+		}
+	default:
+		return &col, nil
+	}
+
+	if err := c.Save(indexKey(kind), &col); err != nil {
+		return nil, err
+	}
+	return &col, nil
+}
+
+// rebuildIndex lists every active and archived item of kind.
+func (cli *CLI) rebuildIndex(ctx context.Context, client *api.Client, kind string) (index.Collection, error) {
+	src := indexSources[kind]
+	var col index.Collection
+	for _, archived := range []bool{false, true} {
+		err := walkPages(0, 0, indexPageSize,
+			func(e index.Entry) int { return e.ID },
+			func(limit, after, before int) ([]index.Entry, error) {
+				return src.list(ctx, client, archived, limit, after, before)
+			},
+			func(e index.Entry) bool {
+				col.Upsert(e)
+				return true
+			},
+		)
+		if err != nil {
+			return index.Collection{}, fmt.Errorf("failed to build %s index: %w", kind, err)
+		}
+	}
+	col.BuiltAt = cli.now().UTC()
+	col.RefreshedAt = col.BuiltAt
+	return col, nil
+}
+
+// refreshIndex adds items created since the index was built (using the after cursor) and
+// refetches items marked dirty by mutating commands.
+func (cli *CLI) refreshIndex(ctx context.Context, client *api.Client, kind string, col *index.Collection) error {
+	src := indexSources[kind]
+	err := walkPages(col.MaxID, 0, indexPageSize,
+		func(e index.Entry) int { return e.ID },
+		func(limit, after, before int) ([]index.Entry, error) {
+			return src.list(ctx, client, false, limit, after, before)
+		},
+		func(e index.Entry) bool {
+			col.Upsert(e)
+			return true
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to refresh %s index: %w", kind, err)
+	}
+
+	for _, id := range col.Dirty {
+		e, err := src.get(ctx, client, id)
+		var apiErr *api.APIError
+		switch {
+		case errors.As(err, &apiErr) && apiErr.StatusCode == 404:
+			col.Remove(id)
+		case err != nil:
+			return fmt.Errorf("failed to refresh %s index: %w", kind, err)
+		default:
+			col.Upsert(e)
+		}
+	}
+	col.Dirty = nil
+	col.RefreshedAt = cli.now().UTC()
+	return nil
+}
+
+// lookupIndex resolves an identifier through the index of kind using lookup, which returns
+// the matches and the kind of match. When nothing matches and the index was not just
+// rebuilt, it is rebuilt once in case the item was renamed elsewhere.
+func (cli *CLI) lookupIndex(ctx context.Context, client *api.Client, kind string, lookup func(*index.Collection) ([]index.Entry, string)) ([]index.Entry, string, error) {
+	col, err := cli.updateIndex(ctx, client, kind, false)
+	if err != nil {
+		return nil, "", err
+	}
+	if matches, how := lookup(col); len(matches) > 0 {
+		return matches, how, nil
+	}
+	if cli.now().Sub(col.BuiltAt) < indexRefreshAge {
+		return nil, "", nil
+	}
+	if col, err = cli.updateIndex(ctx, client, kind, true); err != nil {
+		return nil, "", err
+	}
+	matches, how := lookup(col)
+	return matches, how, nil
+}
+
+// indexRequestHook marks templates and submissions addressed by mutating requests
+// (e.g. PUT /templates/5) as dirty, so the next lookup refetches them.
+func (cli *CLI) indexRequestHook(_ context.Context, req *api.PreparedRequest) error {
+	if !api.IsMutatingMethod(req.Method) {
+		return nil
+	}
+	u, err := url.Parse(req.URL)
+	if err != nil {
+		return nil
+	}
+	segs := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i := 0; i+1 < len(segs); i++ {
+		kind := segs[i]
+		if kind != indexTemplates && kind != indexSubmissions {
+			continue
+		}
+		if id, err := strconv.Atoi(segs[i+1]); err == nil {
+			cli.markIndexDirty(kind, id)
+		}
+	}
+	return nil
+}
+
+// markIndexDirty records id as changed in an existing index of kind.
+func (cli *CLI) markIndexDirty(kind string, id int) {
+	indexMu.Lock()
+	defer indexMu.Unlock()
+
+	c, err := cli.profileCache()
+	if err != nil {
+		return
+	}
+	var col index.Collection
+	if _, ok := c.Load(indexKey(kind), &col); !ok {
+		return
+	}
+	col.MarkDirty(id)
+	_ = c.Save(indexKey(kind), &col)
+}
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+	"github.com/docuseal/docuseal-cli/internal/index"
+)
+
+func TestIndexRequestHookMarksDirty(t *testing.T) {
+	cli := New(Options{})
+	dir := t.TempDir()
+	t.Setenv("DOCUSEAL_CONFIG_DIR", dir)
+	t.Setenv("DOCUSEAL_URL", "https://docuseal.example.com")
+	t.Setenv("DOCUSEAL_API_KEY", "test")
+
+	c, err := cli.profileCache()
+	if err != nil {
+		t.Fatalf("profileCache() error = %v", err)
+	}
+	col := index.Collection{BuiltAt: time.Now(), RefreshedAt: time.Now()}
+	col.Upsert(index.Entry{ID: 5, Name: "NDA"})
+	if err := c.Save(indexKey(indexTemplates), &col); err != nil {
+		t.Fatal(err)
+	}
+
+	requests := []*api.PreparedRequest{
+		{Method: "GET", URL: "https://docuseal.example.com/api/templates/6"},
+		{Method: "PUT", URL: "https://docuseal.example.com/api/templates/5"},
+		{Method: "DELETE", URL: "https://docuseal.example.com/api/templates/8"},
+		{Method: "DELETE", URL: "https://docuseal.example.com/api/submissions/9"},
+	}
+	for _, req := range requests {
+		if err := cli.indexRequestHook(context.Background(), req); err != nil {
+			t.Fatalf("indexRequestHook() error = %v", err)
+		}
+	}
+
+	var got index.Collection
+	if _, ok := c.Load(indexKey(indexTemplates), &got); !ok {
+		t.Fatal("template index missing after hook")
+	}
+	if !reflect.DeepEqual(got.Dirty, []int{5, 8}) {
+		t.Errorf("Dirty = %v, want [5 8]", got.Dirty)
+	}
+	// No submission index exists yet, so nothing is created for it.
+	var subs index.Collection
+	if _, ok := c.Load(indexKey(indexSubmissions), &subs); ok {
+		t.Error("hook should not create a submission index")
+	}
+}
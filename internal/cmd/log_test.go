@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+	"github.com/docuseal/docuseal-cli/internal/config"
+)
+
+func TestLogLevelAndFormat(t *testing.T) {
+	t.Setenv("DOCUSEAL_CONFIG_DIR", t.TempDir())
+	t.Setenv("DOCUSEAL_LOG_LEVEL", "")
+	t.Setenv("DOCUSEAL_LOG_FORMAT", "")
+	srv := httptest.NewServer(http.HandlerFunc(docusealHandler))
+	defer srv.Close()
+	// Old credentials make every command warn.
+	creds := func() (config.Credentials, error) {
+		return config.Credentials{URL: srv.URL, APIKey: "test", CreatedAt: time.Now().AddDate(0, 0, -120)}, nil
+	}
+	run := func(args ...string) (string, error) {
+		var stderr bytes.Buffer
+		cli := New(Options{Stdout: io.Discard, Stderr: &stderr, Credentials: creds})
+		err := cli.Execute(context.Background(), append([]string{"auth", "whoami"}, args...))
+		return stderr.String(), err
+	}
+
+	out, err := run()
+	if err != nil || !strings.HasPrefix(out, "Warning: credentials are old") || !strings.Contains(out, " days=120 ") {
+		t.Errorf("text log = %q, %v", out, err)
+	}
+
+	out, err = run("--log-format", "json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var rec struct {
+		Level string `json:"level"`
+		Msg   string `json:"msg"`
+		Days  int    `json:"days"`
+	}
+	if err := json.Unmarshal([]byte(out), &rec); err != nil || rec.Level != "WARN" || rec.Days != 120 {
+		t.Errorf("json log = %q (%v), want one WARN record", out, err)
+	}
+
+	for _, tt := range []struct {
+		args []string
+		want bool
+	}{
+		{[]string{"--quiet"}, false},
+		{[]string{"--log-level", "error"}, false},
+		{[]string{"--quiet", "--log-level", "warn"}, true},
+	} {
+		out, err := run(tt.args...)
+		if err != nil || (out != "") != tt.want {
+			t.Errorf("%v: log = %q, %v", tt.args, out, err)
+		}
+	}
+
+	for _, args := range [][]string{{"--log-level", "loud"}, {"--log-format", "xml"}} {
+		_, err := run(args...)
+		var verr *api.ValidationError
+		if !errors.As(err, &verr) || verr.Field != strings.TrimPrefix(args[0], "--") {
+			t.Errorf("%v: error = %v, want validation error", args, err)
+		}
+	}
+}
@@ -0,0 +1,406 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/docuseal/docuseal-cli/internal/mcp"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// mcpState holds the mcp command state of a CLI.
+type mcpState struct {
+	mcpCmd      *cobra.Command
+	mcpServeCmd *cobra.Command
+	mcpReadOnly bool
+}
+
+// mcpToolGroups are the top-level command groups exposed as MCP tools.
+var mcpToolGroups = []string{"templates", "submissions", "submitters", "webhooks", "events", "tools"}
+
+// mcpUseArgRe matches positional arguments in a command's Use line (e.g. "<id>").
+var mcpUseArgRe = regexp.MustCompile(`<([a-zA-Z0-9_-]+)>`)
+
+// mcpTool maps an MCP tool to the command that implements it.
+type mcpTool struct {
+	tool       mcp.Tool
+	cmd        *cobra.Command
+	positional []string
+	flags      map[string]*pflag.Flag // property name -> flag
+}
+
+func (cli *CLI) initMCP() {
+	cli.mcpCmd = &cobra.Command{
+		Use:   "mcp",
+		Short: "Model Context Protocol server",
+		Long:  `Expose DocuSeal operations to AI agents over the Model Context Protocol (MCP).`,
+	}
+
+	cli.mcpServeCmd = &cobra.Command{
+		Use:   "serve",
+		Short: "Serve MCP over stdio",
+		Long: `Serve DocuSeal operations as MCP tools over stdio.
+
+Every templates, submissions, submitters, webhooks, events, and tools command is
+exposed as a typed tool (e.g. "submissions_list"). Input schemas are derived from
+the command's flags, and results are the same JSON that '-o json' produces.
+
+Mutating tools carry readOnlyHint=false, and archive/delete tools carry
+destructiveHint=true. Use --read-only to expose only tools that do not change data.
+
+Global flags given to 'mcp serve' (e.g. --timeout, --retries) apply to every tool call.`,
+		Example: `  # Register with an MCP client
+  docuseal mcp serve
+
+  # Only expose read-only tools
+  docuseal mcp serve --read-only`,
+		Args: cobra.NoArgs,
+		RunE: cli.runMCPServe,
+	}
+
+	cli.rootCmd.AddCommand(cli.mcpCmd)
+	cli.mcpCmd.AddCommand(cli.mcpServeCmd)
+
+	cli.mcpServeCmd.Flags().BoolVar(&cli.mcpReadOnly, "read-only", false, "Only expose tools that do not change data")
+}
+
+func (cli *CLI) runMCPServe(cmd *cobra.Command, args []string) error {
+	tools := cli.buildMCPTools(cli.mcpReadOnly)
+	baseArgs := cli.changedPersistentFlagArgs()
+
+	byName := make(map[string]*mcpTool, len(tools))
+	list := make([]mcp.Tool, 0, len(tools))
+	for _, t := range tools {
+		byName[t.tool.Name] = t
+		list = append(list, t.tool)
+	}
+
+	server := &mcp.Server{
+		Name:    cli.rootCmd.Name(),
+		Version: Version,
+		Tools:   list,
+		Call: func(ctx context.Context, name string, input map[string]any) (*mcp.CallResult, error) {
+			t := byName[name]
+			cliArgs, err := t.cliArgs(input)
+			if err != nil {
+				return errorResult(err), nil
+			}
+			out, err := cli.runInProcess(ctx, append(append([]string{}, baseArgs...), cliArgs...))
+			if err != nil {
+				return errorResult(err), nil
+			}
+			return jsonResult(out), nil
+		},
+	}
+
+	cli.getLogger().Info("docuseal MCP server ready", "tools", len(list))
+	return server.Serve(cmd.Context(), cli.stdin, cli.stdout)
+}
+
+// buildMCPTools derives MCP tools from the exposed command groups.
+func (cli *CLI) buildMCPTools(readOnly bool) []*mcpTool {
+	var out []*mcpTool
+	for _, group := range mcpToolGroups {
+		groupCmd, _, err := cli.rootCmd.Find([]string{group})
+		if err != nil || groupCmd == cli.rootCmd {
+			continue
+		}
+		for _, c := range groupCmd.Commands() {
+			if !c.IsAvailableCommand() || !c.Runnable() {
+				continue
+			}
+			if readOnly && isMutating(c) {
+				continue
+			}
+			out = append(out, cli.newMCPTool(c))
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].tool.Name < out[j].tool.Name })
+	return out
+}
+
+func (cli *CLI) newMCPTool(c *cobra.Command) *mcpTool {
+	t := &mcpTool{cmd: c, flags: map[string]*pflag.Flag{}}
+
+	properties := map[string]any{}
+	required := []string{}
+
+	for _, m := range mcpUseArgRe.FindAllStringSubmatch(c.Use, -1) {
+		name := mcpPropertyName(m[1])
+		t.positional = append(t.positional, name)
+		properties[name] = map[string]any{
+			"type":        "string",
+			"description": "Numeric ID, URL, or other identifier accepted by the command",
+		}
+		required = append(required, name)
+	}
+
+	c.LocalFlags().VisitAll(func(f *pflag.Flag) {
+		// stdin carries the MCP stream, so --stdin (and its --concurrency) is not exposed.
+		if f.Hidden || f.Name == "help" || f.Name == "stdin" || f.Name == "concurrency" {
+			return
+		}
+		name := mcpPropertyName(f.Name)
+		t.flags[name] = f
+		properties[name] = flagJSONSchema(f)
+		if flagIsRequired(f) {
+			required = append(required, name)
+		}
+	})
+
+	properties["select"] = map[string]any{
+		"type":        "string",
+		"description": "Comma-separated JSON keys or dot paths to return",
+	}
+	if isMutating(c) {
+		properties["dry_run"] = map[string]any{
+			"type":        "boolean",
+			"description": "Return the request that would be sent instead of sending it",
+		}
+	}
+
+	name := strings.NewReplacer(" ", "_", "-", "_").Replace(strings.TrimPrefix(c.CommandPath(), cli.rootCmd.Name()+" "))
+	description := c.Short
+	if c.Long != "" {
+		description = c.Long
+	}
+
+	t.tool = mcp.Tool{
+		Name:        name,
+		Title:       c.Short,
+		Description: description,
+		InputSchema: map[string]any{
+			"type":                 "object",
+			"properties":           properties,
+			"required":             required,
+			"additionalProperties": false,
+		},
+		Annotations: &mcp.ToolAnnotations{
+			Title:           c.Short,
+			ReadOnlyHint:    !isMutating(c),
+			DestructiveHint: isDestructive(c),
+		},
+	}
+	return t
+}
+
+func mcpPropertyName(flagName string) string {
+	return strings.ReplaceAll(flagName, "-", "_")
+}
+
+// flagJSONSchema maps a pflag type to a JSON schema fragment.
+func flagJSONSchema(f *pflag.Flag) map[string]any {
+	schema := map[string]any{"description": f.Usage}
+	switch f.Value.Type() {
+	case "bool":
+		schema["type"] = "boolean"
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+		schema["type"] = "integer"
+	case "float32", "float64":
+		schema["type"] = "number"
+	case "stringArray", "stringSlice":
+		schema["type"] = "array"
+		schema["items"] = map[string]any{"type": "string"}
+	case "intSlice":
+		schema["type"] = "array"
+		schema["items"] = map[string]any{"type": "integer"}
+	default:
+		schema["type"] = "string"
+	}
+	if f.DefValue != "" && f.DefValue != "[]" && f.DefValue != "0" && f.DefValue != "false" {
+		schema["default"] = f.DefValue
+	}
+	return schema
+}
+
+// cliArgs converts tool input into command-line arguments.
+func (t *mcpTool) cliArgs(input map[string]any) ([]string, error) {
+	args := strings.Fields(strings.TrimPrefix(t.cmd.CommandPath(), t.cmd.Root().Name()+" "))
+
+	keys := make([]string, 0, len(input))
+	for k := range input {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		v := input[k]
+		if v == nil || isPositional(t.positional, k) {
+			continue
+		}
+		if k == "dry_run" {
+			if b, ok := v.(bool); ok && b && isMutating(t.cmd) {
+				args = append(args, "--dry-run")
+			}
+			continue
+		}
+
+		flagName := "select"
+		if k != "select" {
+			f, ok := t.flags[k]
+			if !ok {
+				return nil, fmt.Errorf("unknown argument %q", k)
+			}
+			flagName = f.Name
+		}
+
+		values := []any{v}
+		if arr, ok := v.([]any); ok {
+			values = arr
+		}
+		for _, el := range values {
+			s, err := mcpScalar(el)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", k, err)
+			}
+			args = append(args, "--"+flagName+"="+s)
+		}
+	}
+
+	// Positional arguments go after "--" so identifiers starting with "-" are not parsed as flags.
+	if len(t.positional) > 0 {
+		args = append(args, "--")
+	}
+	for _, name := range t.positional {
+		v, ok := input[name]
+		if !ok || v == nil {
+			return nil, fmt.Errorf("missing required argument %q", name)
+		}
+		s, err := mcpScalar(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", name, err)
+		}
+		args = append(args, s)
+	}
+	return args, nil
+}
+
+func isPositional(names []string, k string) bool {
+	for _, n := range names {
+		if n == k {
+			return true
+		}
+	}
+	return false
+}
+
+// mcpScalar formats a JSON scalar as a flag value.
+func mcpScalar(v any) (string, error) {
+	switch tv := v.(type) {
+	case string:
+		return tv, nil
+	case bool:
+		return fmt.Sprintf("%t", tv), nil
+	case float64:
+		if tv == math.Trunc(tv) && math.Abs(tv) < 1e15 {
+			return fmt.Sprintf("%d", int64(tv)), nil
+		}
+		return fmt.Sprintf("%v", tv), nil
+	case json.Number:
+		return tv.String(), nil
+	case map[string]any, []any:
+		// Allow structured values for JSON-string flags such as --values.
+		data, err := json.Marshal(tv)
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	default:
+		return "", fmt.Errorf("unsupported value %v", v)
+	}
+}
+
+// runInProcess executes args on a fresh command tree and returns what it wrote to stdout.
+// The tree shares this CLI's stderr, credentials, client factory, clock, policy and
+// tracer, so calls do not leak flag values into each other but policy caps still
+// accumulate and one trace file covers them all; output is forced to JSON. Stdin is
+// empty: it belongs to the terminal or pipe that started run or mcp serve.
+func (cli *CLI) runInProcess(ctx context.Context, args []string) (string, error) {
+	var buf bytes.Buffer
+	run := New(Options{
+		Stdout:      &buf,
+		Stderr:      cli.stderr,
+		Stdin:       strings.NewReader(""),
+		Credentials: cli.credentials,
+		NewClient:   cli.newClient,
+		Now:         cli.now,
+	})
+	run.output = "json"
+	cli.sharePolicy(run)
+	cli.shareTracer(run)
+
+	err := run.Execute(ctx, args)
+	return buf.String(), err
+}
+
+// resetFlags restores every flag in the command tree to its default value.
+func resetFlags(c *cobra.Command) {
+	reset := func(f *pflag.Flag) {
+		if sv, ok := f.Value.(pflag.SliceValue); ok {
+			_ = sv.Replace(nil)
+		} else {
+			_ = f.Value.Set(f.DefValue)
+		}
+		f.Changed = false
+	}
+	c.PersistentFlags().VisitAll(reset)
+	c.LocalFlags().VisitAll(reset)
+	for _, sub := range c.Commands() {
+		resetFlags(sub)
+	}
+}
+
+// resetContexts clears the context cobra keeps on each command after it runs, so the
+// next in-process run gets its own context instead of a previous (possibly canceled) one.
+func resetContexts(c *cobra.Command) {
+	c.SetContext(nil)
+	for _, sub := range c.Commands() {
+		resetContexts(sub)
+	}
+}
+
+// changedPersistentFlagArgs returns the global flags explicitly set on the current
+// invocation, so they can be replayed for in-process command runs. It checks Changed
+// rather than using Visit, which misses flags given after the subcommand.
+func (cli *CLI) changedPersistentFlagArgs() []string {
+	var out []string
+	cli.rootCmd.PersistentFlags().VisitAll(func(f *pflag.Flag) {
+		if !f.Changed {
+			return
+		}
+		switch f.Name {
+		case "output", "select", "bare", "meta", "format", "jq":
+			return
+		}
+		if sv, ok := f.Value.(pflag.SliceValue); ok {
+			for _, v := range sv.GetSlice() {
+				out = append(out, "--"+f.Name+"="+v)
+			}
+			return
+		}
+		out = append(out, "--"+f.Name+"="+f.Value.String())
+	})
+	return out
+}
+
+func errorResult(err error) *mcp.CallResult {
+	data, _ := json.Marshal(errorPayload(err))
+	return mcp.TextResult(string(data), true)
+}
+
+func jsonResult(out string) *mcp.CallResult {
+	text := strings.TrimSpace(out)
+	result := mcp.TextResult(text, false)
+	var structured map[string]any
+	if err := json.Unmarshal([]byte(text), &structured); err == nil {
+		result.StructuredContent = structured
+	}
+	return result
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func findMCPTool(t *testing.T, tools []*mcpTool, name string) *mcpTool {
+	t.Helper()
+	for _, tool := range tools {
+		if tool.tool.Name == name {
+			return tool
+		}
+	}
+	return nil
+}
+
+func TestBuildMCPTools(t *testing.T) {
+	cli := New(Options{})
+	tools := cli.buildMCPTools(false)
+
+	archive := findMCPTool(t, tools, "submissions_archive")
+	if archive == nil {
+		t.Fatalf("submissions_archive tool missing")
+	}
+	if archive.tool.Annotations.ReadOnlyHint || !archive.tool.Annotations.DestructiveHint {
+		t.Errorf("submissions_archive annotations = %+v, want destructive", archive.tool.Annotations)
+	}
+
+	list := findMCPTool(t, tools, "templates_list")
+	if list == nil || !list.tool.Annotations.ReadOnlyHint {
+		t.Fatalf("templates_list missing or not read-only")
+	}
+	props := list.tool.InputSchema["properties"].(map[string]any)
+	if props["limit"].(map[string]any)["type"] != "integer" {
+		t.Errorf("limit schema = %v, want integer", props["limit"])
+	}
+
+	readOnly := cli.buildMCPTools(true)
+	if findMCPTool(t, readOnly, "submissions_archive") != nil || findMCPTool(t, readOnly, "webhooks_create") != nil {
+		t.Errorf("--read-only exposed mutating tools")
+	}
+	if findMCPTool(t, readOnly, "submissions_list") == nil {
+		t.Errorf("--read-only dropped read tools")
+	}
+}
+
+func TestMCPToolCLIArgs(t *testing.T) {
+	cli := New(Options{})
+	tools := cli.buildMCPTools(false)
+
+	create := findMCPTool(t, tools, "webhooks_create")
+	got, err := create.cliArgs(map[string]any{
+		"url":     "https://example.com/hook",
+		"events":  []any{"submission.created", "form.viewed"},
+		"dry_run": true,
+	})
+	if err != nil {
+		t.Fatalf("cliArgs() error = %v", err)
+	}
+	want := []string{"webhooks", "create", "--dry-run", "--events=submission.created", "--events=form.viewed", "--url=https://example.com/hook"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("cliArgs() = %v, want %v", got, want)
+	}
+
+	get := findMCPTool(t, tools, "submissions_get")
+	got, err = get.cliArgs(map[string]any{"id": float64(42), "select": "id,status"})
+	if err != nil {
+		t.Fatalf("cliArgs() error = %v", err)
+	}
+	want = []string{"submissions", "get", "--select=id,status", "--", "42"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("cliArgs() = %v, want %v", got, want)
+	}
+
+	if _, err := get.cliArgs(map[string]any{}); err == nil {
+		t.Errorf("expected error for missing id")
+	}
+	if _, err := get.cliArgs(map[string]any{"id": "1", "bogus": 1}); err == nil {
+		t.Errorf("expected error for unknown argument")
+	}
+}
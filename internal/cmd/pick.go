@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+	"github.com/docuseal/docuseal-cli/internal/outfmt"
+	"github.com/docuseal/docuseal-cli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// pickNouns names the items of each completion cache key in the picker prompt.
+var pickNouns = map[string]string{
+	"completion-templates":   "template",
+	"completion-submissions": "submission",
+	"completion-submitters":  "submitter",
+	"completion-webhooks":    "webhook",
+}
+
+// addPicker lets cmd prompt for its <id> with a fuzzy finder over the items cached under
+// key when the argument is omitted on a terminal. Commands that accept --stdin let the
+// user mark several items, which are then processed like --stdin input. Without a
+// terminal, or with a machine-readable --output, a missing <id> is a validation error.
+func (cli *CLI) addPicker(cmd *cobra.Command, key string) {
+	validate := cmd.Args
+	cmd.Args = func(c *cobra.Command, args []string) error {
+		if len(args) == 0 && !cli.stdinIDs {
+			if cli.pickerAvailable() {
+				return nil
+			}
+			return &api.ValidationError{Field: "id", Message: "is required (pass an <id> argument" + stdinHint(c) + ")"}
+		}
+		if validate == nil {
+			return nil
+		}
+		return validate(c, args)
+	}
+
+	run := cmd.RunE
+	cmd.RunE = func(c *cobra.Command, args []string) error {
+		if len(args) > 0 || cli.stdinIDs {
+			return run(c, args)
+		}
+		multi := c.Flags().Lookup("stdin") != nil
+		values, err := cli.pickIdents(key, multi)
+		if err != nil {
+			return err
+		}
+		if len(values) == 1 {
+			return run(c, values)
+		}
+		cli.stdinIDs = true
+		c.SetIn(strings.NewReader(strings.Join(values, "\n")))
+		return run(c, nil)
+	}
+}
+
+func stdinHint(cmd *cobra.Command) string {
+	if cmd.Flags().Lookup("stdin") != nil {
+		return " or --stdin"
+	}
+	return ""
+}
+
+// pickerAvailable reports whether an omitted <id> may be asked for interactively. It runs
+// during argument validation, before the output mode is resolved.
+func (cli *CLI) pickerAvailable() bool {
+	mode, err := cli.detectOutputModeFromArgsAndEnv()
+	if err != nil || mode != outfmt.Text || cli.formatFlag != "" || cli.jqFlag != "" {
+		return false
+	}
+	return cli.getUI().IsInteractive()
+}
+
+// pickIdents shows the picker over the recent items cached under key.
+func (cli *CLI) pickIdents(key string, multi bool) ([]string, error) {
+	noun := pickNouns[key]
+	entries := cli.cachedCompletions(key)
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("no %ss to choose from (pass an <id> argument)", noun)
+	}
+
+	items := make([]ui.PickItem, 0, len(entries))
+	for _, e := range entries {
+		label := fmt.Sprintf("#%d  %s", e.ID, strings.Join(strings.Fields(e.Label), " "))
+		if e.Slug != "" {
+			label += "  " + e.Slug
+		}
+		items = append(items, ui.PickItem{Value: strconv.Itoa(e.ID), Label: label})
+	}
+
+	prompt := "Select a " + noun
+	if multi {
+		prompt = "Select " + noun + "s"
+	}
+	return cli.getUI().Pick(items, ui.PickOptions{Prompt: prompt, Multi: multi})
+}
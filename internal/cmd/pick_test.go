@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+func TestAddPickerWithoutTerminal(t *testing.T) {
+	cli := New(Options{})
+	var ran []string
+	newCmd := func(withStdin bool) *cobra.Command {
+		c := &cobra.Command{
+			Use:  "get <id>",
+			Args: cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				ran = args
+				return nil
+			},
+		}
+		if withStdin {
+			c.Flags().Bool("stdin", false, "")
+		}
+		cli.addPicker(c, "completion-templates")
+		return c
+	}
+
+	// Tests run without a terminal, so a missing <id> is a validation error.
+	for _, withStdin := range []bool{false, true} {
+		c := newCmd(withStdin)
+		err := c.Args(c, nil)
+		var ve *api.ValidationError
+		if !errors.As(err, &ve) || ve.Field != "id" {
+			t.Errorf("Args(no id, stdin=%v) = %v, want validation error on id", withStdin, err)
+		}
+	}
+
+	c := newCmd(false)
+	if err := c.Args(c, []string{"1", "2"}); err == nil {
+		t.Error("Args(two ids) = nil, want the wrapped validator's error")
+	}
+	if err := c.Args(c, []string{"7"}); err != nil {
+		t.Errorf("Args(one id) = %v", err)
+	}
+	if err := c.RunE(c, []string{"7"}); err != nil || len(ran) != 1 || ran[0] != "7" {
+		t.Errorf("RunE(7) ran with %v, %v", ran, err)
+	}
+}
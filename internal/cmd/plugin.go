@@ -0,0 +1,287 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+	"github.com/docuseal/docuseal-cli/internal/ui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// pluginPrefix is the executable name prefix of external commands: "docuseal hr" runs
+// "docuseal-hr" from PATH.
+const pluginPrefix = "docuseal-"
+
+// pluginNameRe matches names that may be dispatched to a plugin. It excludes cobra's
+// hidden commands (e.g. __complete) and anything that looks like a path.
+var pluginNameRe = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]*$`)
+
+var pluginColumns = []ui.Column{
+	{Name: "name", Header: "NAME", Fixed: true},
+	{Name: "path", Header: "PATH"},
+	{Name: "status", Header: "STATUS", Fixed: true},
+}
+
+// pluginInfo describes a docuseal-<name> executable found on PATH.
+type pluginInfo struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+	// ShadowedBy is set when the plugin never runs: "built-in command", or the path of
+	// a plugin with the same name earlier on PATH.
+	ShadowedBy string `json:"shadowed_by,omitempty"`
+}
+
+// pluginExitError reports a plugin that exited non-zero. The plugin has already
+// written its own error output, so WriteError prints nothing and ExitCode passes the
+// plugin's status through.
+type pluginExitError struct {
+	name string
+	code int
+}
+
+func (e *pluginExitError) Error() string {
+	return fmt.Sprintf("plugin %q exited with status %d", e.name, e.code)
+}
+
+// pluginState holds the plugin command state of a CLI.
+type pluginState struct {
+	pluginCmd     *cobra.Command
+	pluginListCmd *cobra.Command
+}
+
+func (cli *CLI) initPlugin() {
+	cli.pluginCmd = &cobra.Command{
+		Use:     "plugin",
+		Aliases: []string{"plugins"},
+		Short:   "Manage external command plugins",
+		Long: `Manage external command plugins.
+
+Any executable named docuseal-<name> on PATH becomes the command "docuseal <name>",
+unless a built-in command has that name. Global flags given before the name are passed
+through first, followed by the plugin's own arguments:
+
+  docuseal -o json hr-onboarding --start 2026-01-05
+  # runs: docuseal-hr-onboarding -o json --start 2026-01-05
+
+Plugins receive the resolved credentials and context in the environment:
+
+  DOCUSEAL_URL, DOCUSEAL_API_KEY   Active profile (when configured)
+  DOCUSEAL_BIN                     Path of the docuseal executable, for calling back
+  DOCUSEAL_PLUGIN_NAME             The plugin name
+
+Under an agent safety policy, plugins are treated as commands that change data.`,
+	}
+
+	cli.pluginListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List installed plugins",
+		Long:  `List docuseal-<name> executables on PATH, in PATH order. Plugins hidden by a built-in command or an earlier plugin are marked as shadowed.`,
+		Args:  cobra.NoArgs,
+		RunE:  cli.runPluginList,
+	}
+
+	cli.rootCmd.AddCommand(cli.pluginCmd)
+	cli.pluginCmd.AddCommand(cli.pluginListCmd)
+}
+
+func (cli *CLI) runPluginList(cmd *cobra.Command, args []string) error {
+	mode := cli.getOutputMode()
+	plugins := cli.discoverPlugins()
+
+	cli.outputResult(mode, plugins, func() {
+		if len(plugins) == 0 {
+			fmt.Fprintf(cli.stdout, "No plugins found (looked for %s* on PATH).\n", pluginPrefix)
+			return
+		}
+		rows := make([][]string, 0, len(plugins))
+		for _, p := range plugins {
+			status := "active"
+			if p.ShadowedBy != "" {
+				status = "shadowed by " + p.ShadowedBy
+			}
+			rows = append(rows, []string{p.Name, p.Path, status})
+		}
+		cli.renderTable(pluginColumns, rows)
+	})
+	return nil
+}
+
+// discoverPlugins lists docuseal-* executables on PATH, in PATH order.
+func (cli *CLI) discoverPlugins() []pluginInfo {
+	plugins := make([]pluginInfo, 0)
+	seen := map[string]string{}
+	for _, dir := range filepath.SplitList(os.Getenv("PATH")) {
+		if dir == "" {
+			dir = "."
+		}
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, e := range entries {
+			name, ok := pluginName(e)
+			if !ok {
+				continue
+			}
+			p := pluginInfo{Name: name, Path: filepath.Join(dir, e.Name())}
+			if cli.isBuiltinCommand(name) {
+				p.ShadowedBy = "built-in command"
+			} else if first, dup := seen[name]; dup {
+				p.ShadowedBy = first
+			} else {
+				seen[name] = p.Path
+			}
+			plugins = append(plugins, p)
+		}
+	}
+	return plugins
+}
+
+// pluginName returns the plugin name of a directory entry, if it is a plugin executable.
+func pluginName(e os.DirEntry) (string, bool) {
+	if !strings.HasPrefix(e.Name(), pluginPrefix) || e.IsDir() {
+		return "", false
+	}
+	name := strings.TrimPrefix(e.Name(), pluginPrefix)
+	if runtime.GOOS == "windows" {
+		ext := strings.ToLower(filepath.Ext(name))
+		if ext != ".exe" && ext != ".bat" && ext != ".cmd" {
+			return "", false
+		}
+		name = strings.TrimSuffix(name, filepath.Ext(name))
+	} else {
+		info, err := e.Info()
+		if err != nil || info.Mode()&0o111 == 0 {
+			return "", false
+		}
+	}
+	return name, pluginNameRe.MatchString(name)
+}
+
+// isBuiltinCommand reports whether name is a built-in command or alias.
+func (cli *CLI) isBuiltinCommand(name string) bool {
+	if name == cli.helpCmd.Name() {
+		return true
+	}
+	for _, c := range cli.rootCmd.Commands() {
+		if c.Name() == name || c.HasAlias(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// findPlugin reports whether args invoke a plugin: the first argument that is not a
+// global flag names no built-in command but a docuseal-<name> executable on PATH. It
+// returns the executable, the plugin name, the global flags given before the name and
+// the arguments after it.
+func (cli *CLI) findPlugin(args []string) (path, name string, globals, rest []string, ok bool) {
+	flags := cli.rootCmd.PersistentFlags()
+	for i := 0; i < len(args); i++ {
+		a := args[i]
+		if a == "--" {
+			return "", "", nil, nil, false
+		}
+		if strings.HasPrefix(a, "-") && a != "-" {
+			// Only global flags may precede a plugin name; cobra reports anything else.
+			var f *pflag.Flag
+			long := strings.HasPrefix(a, "--")
+			if long {
+				f = flags.Lookup(strings.SplitN(a[2:], "=", 2)[0])
+			} else {
+				f = flags.ShorthandLookup(a[1:2])
+			}
+			if f == nil {
+				return "", "", nil, nil, false
+			}
+			inline := strings.Contains(a, "=") || (!long && len(a) > 2)
+			if f.NoOptDefVal == "" && !inline {
+				i++ // the flag's value
+			}
+			continue
+		}
+		if !pluginNameRe.MatchString(a) || cli.isBuiltinCommand(a) {
+			return "", "", nil, nil, false
+		}
+		path, err := exec.LookPath(pluginPrefix + a)
+		if err != nil {
+			return "", "", nil, nil, false
+		}
+		return path, a, args[:i], args[i+1:], true
+	}
+	return "", "", nil, nil, false
+}
+
+// runPlugin runs a plugin with the CLI's streams, the resolved credentials in its
+// environment and the global flags validated.
+func (cli *CLI) runPlugin(ctx context.Context, path, name string, globals, rest []string) error {
+	if err := cli.rootCmd.PersistentFlags().Parse(globals); err != nil {
+		return &api.ValidationError{Field: "flags", Message: err.Error()}
+	}
+	p, err := cli.loadPolicy()
+	if err != nil {
+		return &api.ValidationError{Field: "policy", Message: err.Error()}
+	}
+	// Plugins cannot declare their side effects, and may ignore --dry-run.
+	if err := p.CheckCommand(name, true); err != nil {
+		return err
+	}
+
+	env := os.Environ()
+	if creds, err := cli.loadCredentials(); err == nil {
+		env = append(env, "DOCUSEAL_URL="+creds.URL, "DOCUSEAL_API_KEY="+creds.APIKey)
+	}
+	if self, err := os.Executable(); err == nil {
+		env = append(env, "DOCUSEAL_BIN="+self)
+	}
+	env = append(env, "DOCUSEAL_PLUGIN_NAME="+name)
+
+	c := exec.CommandContext(ctx, path, append(append([]string{}, globals...), rest...)...)
+	c.Env = env
+	c.Stdin, c.Stdout, c.Stderr = cli.stdin, cli.stdout, cli.stderr
+	err = c.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() > 0 {
+		return &pluginExitError{name: name, code: exitErr.ExitCode()}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to run plugin %q: %w", name, err)
+	}
+	return nil
+}
+
+// pluginSchemaCommands describes the active plugins for schema and help --json. Only
+// the global flags are known; plugins document their own arguments.
+func (cli *CLI) pluginSchemaCommands() []schemaCommand {
+	out := make([]schemaCommand, 0)
+	for _, p := range cli.discoverPlugins() {
+		if p.ShadowedBy != "" {
+			continue
+		}
+		out = append(out, cli.pluginSchemaCommand(p))
+	}
+	return out
+}
+
+func (cli *CLI) pluginSchemaCommand(p pluginInfo) schemaCommand {
+	return schemaCommand{
+		CommandPath: cli.rootCmd.Name() + " " + p.Name,
+		Use:         p.Name + " [args...]",
+		Aliases:     []string{},
+		Short:       fmt.Sprintf("Plugin (%s)", filepath.Base(p.Path)),
+		Plugin:      p.Path,
+		LocalFlags:  []schemaFlag{},
+		Persistent:  []schemaFlag{},
+		Inherited:   flagsToSchema(cli.rootCmd.PersistentFlags()),
+		Subcommands: []schemaCommand{},
+	}
+}
@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/docuseal/docuseal-cli/internal/config"
+)
+
+// writePlugin installs a shell-script plugin in dir.
+func writePlugin(t *testing.T, dir, name, script string) string {
+	t.Helper()
+	path := filepath.Join(dir, pluginPrefix+name)
+	if err := os.WriteFile(path, []byte("#!/bin/sh\n"+script+"\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func pluginTestCLI(t *testing.T, stdout *bytes.Buffer) *CLI {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins are shell scripts in tests")
+	}
+	t.Setenv("DOCUSEAL_CONFIG_DIR", t.TempDir())
+	t.Setenv("DOCUSEAL_HISTORY", "off")
+	t.Setenv("DOCUSEAL_POLICY", "")
+	creds := func() (config.Credentials, error) {
+		return config.Credentials{URL: "https://docuseal.example.com", APIKey: "secret"}, nil
+	}
+	return New(Options{Stdout: stdout, Stderr: stdout, Credentials: creds})
+}
+
+func TestPluginDispatch(t *testing.T) {
+	dir := t.TempDir()
+	writePlugin(t, dir, "hr", `echo "$*|$DOCUSEAL_URL|$DOCUSEAL_API_KEY|$DOCUSEAL_PLUGIN_NAME"; exit ${PLUGIN_EXIT:-0}`)
+	t.Setenv("PATH", dir)
+
+	var out bytes.Buffer
+	cli := pluginTestCLI(t, &out)
+	if err := cli.Execute(context.Background(), []string{"-o", "json", "--quiet", "hr", "onboard", "--start", "monday"}); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	want := "-o json --quiet onboard --start monday|https://docuseal.example.com|secret|hr\n"
+	if out.String() != want {
+		t.Errorf("plugin output = %q, want %q", out.String(), want)
+	}
+
+	t.Setenv("PLUGIN_EXIT", "9")
+	err := pluginTestCLI(t, &out).Execute(context.Background(), []string{"hr"})
+	if ExitCode(err) != 9 {
+		t.Errorf("ExitCode(%v) = %d, want 9", err, ExitCode(err))
+	}
+	var stderr bytes.Buffer
+	WriteError(&stderr, nil, err)
+	if stderr.Len() != 0 {
+		t.Errorf("WriteError printed %q for a plugin failure, want nothing", stderr.String())
+	}
+}
+
+func TestPluginDispatch_NotAPlugin(t *testing.T) {
+	dir := t.TempDir()
+	writePlugin(t, dir, "version", "echo plugin")
+	t.Setenv("PATH", dir)
+
+	var out bytes.Buffer
+	cli := pluginTestCLI(t, &out)
+	if err := cli.Execute(context.Background(), []string{"version"}); err != nil {
+		t.Fatalf("Execute(version) error = %v", err)
+	}
+	if strings.Contains(out.String(), "plugin") {
+		t.Errorf("built-in version was shadowed by a plugin: %q", out.String())
+	}
+
+	err := pluginTestCLI(t, &out).Execute(context.Background(), []string{"missing"})
+	if err == nil || !strings.Contains(err.Error(), "unknown command") {
+		t.Errorf("Execute(missing) error = %v, want unknown command", err)
+	}
+	// Flags that are not global cannot precede a plugin name.
+	if _, _, _, _, ok := cli.findPlugin([]string{"--limit", "5", "version"}); ok {
+		t.Error("findPlugin accepted a non-global flag before the name")
+	}
+}
+
+func TestPluginPolicyTreatsPluginsAsMutating(t *testing.T) {
+	dir := t.TempDir()
+	writePlugin(t, dir, "hr", "echo ran")
+	t.Setenv("PATH", dir)
+
+	var out bytes.Buffer
+	cli := pluginTestCLI(t, &out)
+	t.Setenv("DOCUSEAL_POLICY", `{"read_only": true}`)
+	err := cli.Execute(context.Background(), []string{"hr"})
+	if ExitCode(err) != 8 || strings.Contains(out.String(), "ran") {
+		t.Errorf("Execute(hr) under read-only policy: err = %v, output %q", err, out.String())
+	}
+}
+
+func TestPluginListAndSchema(t *testing.T) {
+	first, second := t.TempDir(), t.TempDir()
+	path := writePlugin(t, first, "hr", "true")
+	writePlugin(t, second, "hr", "true")
+	writePlugin(t, second, "templates", "true")
+	if err := os.WriteFile(filepath.Join(second, pluginPrefix+"notes"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", first+string(os.PathListSeparator)+second)
+
+	var out bytes.Buffer
+	cli := pluginTestCLI(t, &out)
+	if err := cli.Execute(context.Background(), []string{"plugin", "list", "-o", "json"}); err != nil {
+		t.Fatalf("plugin list error = %v", err)
+	}
+	var plugins []pluginInfo
+	if err := json.Unmarshal(out.Bytes(), &plugins); err != nil {
+		t.Fatalf("plugin list output %q: %v", out.String(), err)
+	}
+	if len(plugins) != 3 {
+		t.Fatalf("plugin list = %+v, want 3 executables", plugins)
+	}
+	shadowed := map[string]string{}
+	for _, p := range plugins {
+		shadowed[p.Path] = p.ShadowedBy
+	}
+	if shadowed[path] != "" || shadowed[filepath.Join(second, pluginPrefix+"hr")] != path ||
+		shadowed[filepath.Join(second, pluginPrefix+"templates")] != "built-in command" {
+		t.Errorf("shadowing = %v", shadowed)
+	}
+
+	var found []string
+	for _, c := range cli.buildSchema().Commands {
+		if c.Plugin != "" {
+			found = append(found, c.CommandPath)
+		}
+	}
+	if len(found) != 1 || found[0] != "docuseal hr" {
+		t.Errorf("schema plugins = %v, want [docuseal hr]", found)
+	}
+	spec, err := cli.helpSchema([]string{"hr"})
+	if err != nil || spec.Plugin != path {
+		t.Errorf("helpSchema(hr) = %+v, %v", spec, err)
+	}
+}
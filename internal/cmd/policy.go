@@ -0,0 +1,229 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+	"github.com/docuseal/docuseal-cli/internal/config"
+	"github.com/docuseal/docuseal-cli/internal/policy"
+	"github.com/spf13/cobra"
+)
+
+// policyState holds the policy command state of a CLI.
+type policyState struct {
+	policyCmd      *cobra.Command
+	policyShowCmd  *cobra.Command
+	approvals      []string
+	activePolicy   *policy.Policy
+	policyLoadErr  error
+	policyLoadOnce sync.Once
+}
+
+// policyExemptCommands always run so users and agents can discover the CLI and the policy itself.
+var policyExemptCommands = map[string]bool{
+	"help":                          true,
+	"version":                       true,
+	"schema":                        true,
+	"completion":                    true,
+	"doctor":                        true,
+	"policy":                        true,
+	cobra.ShellCompRequestCmd:       true,
+	cobra.ShellCompNoDescRequestCmd: true,
+}
+
+func (cli *CLI) initPolicy() {
+	cli.policyCmd = &cobra.Command{
+		Use:   "policy",
+		Short: "Inspect the agent safety policy",
+		Long: `Inspect the safety policy that restricts what this CLI may do.
+
+A policy is read from DOCUSEAL_POLICY (inline JSON or a file path), or from
+policy.json in the config directory (~/.config/docuseal, or DOCUSEAL_CONFIG_DIR).
+
+Policy keys:
+  read_only                    Block every command that changes data
+  allow                        Command paths that may run (e.g. "submissions list", "templates")
+  deny                         Command paths that may not run (wins over allow)
+  max_submissions_created      Cap on submissions created per run
+  require_send_email_approval  Require --approve send-email before emails are sent
+
+Blocked commands exit with code 8 and, with --output json, a JSON reason.
+Dry runs (--dry-run, --curl) never send requests and are always allowed.`,
+		Example: `  # Read-only agent
+  export DOCUSEAL_POLICY='{"read_only": true}'
+
+  # Allow listing and creating, but at most 5 submissions per run
+  export DOCUSEAL_POLICY='{"allow": ["templates list", "submissions"], "deny": ["submissions archive"], "max_submissions_created": 5}'
+
+  # Approve sending email for a single command
+  docuseal submissions create --template-id 1 --submitters a@example.com --send-email --approve send-email`,
+	}
+
+	cli.policyShowCmd = &cobra.Command{
+		Use:   "show",
+		Short: "Show the active policy",
+		Long:  `Show the active safety policy and where it was loaded from.`,
+		RunE:  cli.runPolicyShow,
+	}
+
+	cli.rootCmd.AddCommand(cli.policyCmd)
+	cli.policyCmd.AddCommand(cli.policyShowCmd)
+}
+
+// loadPolicy loads the active policy once per CLI so per-run caps accumulate across
+// commands executed in-process (mcp, batch, run, --stdin).
+func (cli *CLI) loadPolicy() (*policy.Policy, error) {
+	cli.policyLoadOnce.Do(func() {
+		dir, err := config.Dir()
+		if err != nil {
+			dir = ""
+		}
+		cli.activePolicy, cli.policyLoadErr = policy.Load(dir)
+	})
+	return cli.activePolicy, cli.policyLoadErr
+}
+
+// sharePolicy makes run use the policy loaded by cli, so caps count across both.
+func (cli *CLI) sharePolicy(run *CLI) {
+	p, err := cli.loadPolicy()
+	run.policyLoadOnce.Do(func() {
+		run.activePolicy, run.policyLoadErr = p, err
+	})
+}
+
+// commandPath returns the command path without the binary name, e.g. "submissions archive".
+func commandPath(cmd *cobra.Command) string {
+	return strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" ")
+}
+
+// enforcePolicy checks the active policy before a command runs.
+func (cli *CLI) enforcePolicy(cmd *cobra.Command) error {
+	p, err := cli.loadPolicy()
+	if err != nil {
+		return &api.ValidationError{Field: "policy", Message: err.Error()}
+	}
+	if p == nil || !cmd.HasParent() || cli.interceptsRequests() {
+		return nil
+	}
+
+	path := commandPath(cmd)
+	if policyExemptCommands[strings.Fields(path)[0]] {
+		return nil
+	}
+	if err := p.CheckCommand(path, isMutating(cmd)); err != nil {
+		return err
+	}
+
+	if f := cmd.Flags().Lookup("send-email"); f != nil && f.Value.String() == "true" {
+		if err := p.CheckSendEmail(path, cli.approvals); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// policyRequestHook enforces request-level rules (caps and email approval) so they also
+// apply to operations that do not map 1:1 to commands.
+func (cli *CLI) policyRequestHook(p *policy.Policy) api.RequestHook {
+	return func(_ context.Context, req *api.PreparedRequest) error {
+		if !api.IsMutatingMethod(req.Method) {
+			return nil
+		}
+
+		body := map[string]any{}
+		if req.Body != nil {
+			if data, err := json.Marshal(req.Body); err == nil {
+				_ = json.Unmarshal(data, &body)
+			}
+		}
+
+		created := submissionsCreatedBy(req, body)
+		sendEmail, ok := body["send_email"].(bool)
+		if !ok && created > 0 {
+			// The API emails signers of new submissions unless send_email is false.
+			sendEmail = true
+		}
+		if sendEmail {
+			if err := p.CheckSendEmail("", cli.approvals); err != nil {
+				return err
+			}
+		}
+
+		if created > 0 {
+			return p.ReserveSubmissions(created)
+		}
+		return nil
+	}
+}
+
+// submissionsCreatedBy returns how many submissions a request creates.
+func submissionsCreatedBy(req *api.PreparedRequest, body map[string]any) int {
+	if req.Method != "POST" {
+		return 0
+	}
+	u, err := url.Parse(req.URL)
+	if err != nil {
+		return 0
+	}
+	switch {
+	case strings.HasSuffix(u.Path, "/submissions/emails"):
+		emails, _ := body["emails"].(string)
+		n := 0
+		for _, e := range strings.Split(emails, ",") {
+			if strings.TrimSpace(e) != "" {
+				n++
+			}
+		}
+		return n
+	case strings.HasSuffix(u.Path, "/submissions"),
+		strings.HasSuffix(u.Path, "/submissions/init"),
+		strings.HasSuffix(u.Path, "/submissions/pdf"),
+		strings.HasSuffix(u.Path, "/submissions/docx"),
+		strings.HasSuffix(u.Path, "/submissions/html"):
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (cli *CLI) runPolicyShow(cmd *cobra.Command, args []string) error {
+	mode := cli.getOutputMode()
+	p, err := cli.loadPolicy()
+	if err != nil {
+		return err
+	}
+
+	if p == nil {
+		cli.outputResult(mode, map[string]any{"active": false}, func() {
+			fmt.Fprintln(cli.stdout, "No policy configured")
+		})
+		return nil
+	}
+
+	cli.outputResult(mode, map[string]any{
+		"active": true,
+		"source": p.Source,
+		"policy": p,
+	}, func() {
+		fmt.Fprintf(cli.stdout, "Source: %s\n", p.Source)
+		fmt.Fprintf(cli.stdout, "Read-only: %t\n", p.ReadOnly)
+		if len(p.Allow) > 0 {
+			fmt.Fprintf(cli.stdout, "Allow: %s\n", strings.Join(p.Allow, ", "))
+		}
+		if len(p.Deny) > 0 {
+			fmt.Fprintf(cli.stdout, "Deny: %s\n", strings.Join(p.Deny, ", "))
+		}
+		if p.MaxSubmissionsCreated > 0 {
+			fmt.Fprintf(cli.stdout, "Max submissions created per run: %d\n", p.MaxSubmissionsCreated)
+		}
+		if p.RequireSendEmailApproval {
+			fmt.Fprintf(cli.stdout, "Send email requires: --approve %s\n", policy.ApprovalSendEmail)
+		}
+	})
+	return nil
+}
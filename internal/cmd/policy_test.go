@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/docuseal/docuseal-cli/internal/config"
+	"github.com/docuseal/docuseal-cli/internal/policy"
+)
+
+func TestPolicySendEmailApproval(t *testing.T) {
+	t.Setenv("DOCUSEAL_CONFIG_DIR", t.TempDir())
+	t.Setenv(policy.EnvName, `{"require_send_email_approval": true}`)
+	created := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			created++
+		}
+		io.WriteString(w, `[{"id":11,"submission_id":7,"email":"a@example.com","status":"pending"}]`)
+	}))
+	defer srv.Close()
+	creds := func() (config.Credentials, error) {
+		return config.Credentials{URL: srv.URL, APIKey: "test"}, nil
+	}
+	run := func(args ...string) error {
+		cli := New(Options{Stdout: io.Discard, Stderr: io.Discard, Credentials: creds})
+		return cli.Execute(context.Background(), append([]string{"submissions", "create", "--template-id", "3", "--submitters", "a@example.com:Signer"}, args...))
+	}
+
+	// Without --send-email the request leaves send_email out, and the API then emails.
+	if err := run(); ExitCode(err) != 8 || created != 0 {
+		t.Fatalf("create without --send-email: error = %v (exit %d), %d requests; want policy exit 8 and none sent", err, ExitCode(err), created)
+	}
+	if err := run("--send-email"); ExitCode(err) != 8 || created != 0 {
+		t.Fatalf("create --send-email: error = %v (exit %d); want policy exit 8", err, ExitCode(err))
+	}
+	if err := run("--approve", "send-email"); err != nil || created != 1 {
+		t.Fatalf("approved create: error = %v, %d requests; want it sent", err, created)
+	}
+}
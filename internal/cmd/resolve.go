@@ -2,13 +2,112 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/docuseal/docuseal-cli/internal/api"
+	"github.com/docuseal/docuseal-cli/internal/index"
 )
 
-func resolveTemplateID(ctx context.Context, client *api.Client, ident string) (int, error) {
+// Identifier prefixes: ext:<external_id> matches the external ID of a template or
+// submitter, email:<address> the email of a submitter.
+const (
+	externalIDPrefix = "ext:"
+	emailPrefix      = "email:"
+)
+
+// Resource kinds named by DocuSeal URLs.
+const (
+	refTemplates   = "templates"
+	refSubmissions = "submissions"
+	refSubmitters  = "submitters"
+)
+
+// resolvePageSize is the page size used while searching submitters.
+const resolvePageSize = 100
+
+// resourceRef is a resource addressed by a DocuSeal URL: an ID under /templates,
+// /submissions or /submitters, or the slug of a signing link (/s/<slug>, a submitter)
+// or a shared document link (/d/<slug>, a template).
+type resourceRef struct {
+	Kind string
+	ID   int
+	Slug string
+}
+
+// parseResourceURL recognizes DocuSeal web and API URLs. It reports false for anything
+// else, including URLs that don't name a resource.
+func parseResourceURL(s string) (resourceRef, bool) {
+	u, err := url.Parse(strings.TrimSpace(s))
+	if err != nil || u.Host == "" {
+		return resourceRef{}, false
+	}
+	segs := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(segs) >= 2 && segs[1] != "" {
+		switch segs[0] {
+		case "s":
+			return resourceRef{Kind: refSubmitters, Slug: segs[1]}, true
+		case "d":
+			return resourceRef{Kind: refTemplates, Slug: segs[1]}, true
+		}
+	}
+	for i := 0; i+1 < len(segs); i++ {
+		switch segs[i] {
+		case refTemplates, refSubmissions, refSubmitters:
+			if id, err := strconv.Atoi(segs[i+1]); err == nil {
+				return resourceRef{Kind: segs[i], ID: id}, true
+			}
+		}
+	}
+	return resourceRef{}, false
+}
+
+// cutIdentPrefix splits a prefixed identifier such as "ext:ABC-123" into its prefix and
+// value. The prefix is matched case-insensitively; prefix is "" when there is none.
+func cutIdentPrefix(ident string) (prefix, value string) {
+	ident = strings.TrimSpace(ident)
+	for _, p := range []string{externalIDPrefix, emailPrefix} {
+		if len(ident) > len(p) && strings.EqualFold(ident[:len(p)], p) {
+			return p, strings.TrimSpace(ident[len(p):])
+		}
+	}
+	return "", ident
+}
+
+// wrongKindError reports a URL that addresses a different kind of resource.
+func wrongKindError(ident, want string, ref resourceRef) error {
+	return fmt.Errorf("%q is a %s link, not a %s", ident, strings.TrimSuffix(ref.Kind, "s"), want)
+}
+
+// ambiguousError reports an identifier that matched several objects, listing up to 10.
+func ambiguousError(noun, ident, kind string, lines []string) error {
+	var b strings.Builder
+	_, _ = fmt.Fprintf(&b, "%s identifier %q is ambiguous (%s matched multiple %ss):\n", noun, ident, kind, noun)
+	for i, line := range lines {
+		if i >= 10 {
+			b.WriteString("  ...\n")
+			break
+		}
+		_, _ = fmt.Fprintf(&b, "  - %s\n", line)
+	}
+	return fmt.Errorf("%s", strings.TrimRight(b.String(), "\n"))
+}
+
+func (cli *CLI) resolveTemplateID(ctx context.Context, client *api.Client, ident string) (int, error) {
+	if ref, ok := parseResourceURL(ident); ok {
+		return cli.resolveTemplateRef(ctx, client, ident, ref)
+	}
+	switch prefix, value := cutIdentPrefix(ident); prefix {
+	case externalIDPrefix:
+		return cli.resolveTemplateField(ctx, client, ident, index.MatchExternalID, value)
+	case emailPrefix:
+		return 0, fmt.Errorf("template identifier %q: email: matches submitters, not templates", ident)
+	}
+
 	// Fast path: numeric ID or URL containing ID.
 	if id, err := parseIDArg(ident); err == nil {
 		return id, nil
@@ -19,6 +118,93 @@ func resolveTemplateID(ctx context.Context, client *api.Client, ident string) (i
 		return 0, fmt.Errorf("empty template identifier")
 	}
 
+	if !cli.noCache {
+		matches, how, err := cli.lookupIndex(ctx, client, indexTemplates, func(col *index.Collection) ([]index.Entry, string) {
+			return col.Lookup(ident)
+		})
+		if err == nil {
+			if len(matches) == 0 {
+				return 0, fmt.Errorf("template %q not found (try numeric ID, URL, exact slug, exact name, or ext:<external_id>)", ident)
+			}
+			return pickIndexedTemplate(matches, ident, how)
+		}
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return 0, fmt.Errorf("failed to resolve template %q: %w", ident, err)
+		}
+		// The index is an optimization: fall back to scanning when it can't be used.
+	}
+	return scanTemplateID(ctx, client, ident)
+}
+
+// resolveTemplateRef resolves a template URL or shared document link. Submission URLs and
+// signing links are refused rather than mapped to the template they were created from, so
+// a command never acts on a template the user did not name.
+func (cli *CLI) resolveTemplateRef(ctx context.Context, client *api.Client, ident string, ref resourceRef) (int, error) {
+	switch {
+	case ref.Kind != refTemplates:
+		return 0, wrongKindError(ident, "template", ref)
+	case ref.ID > 0:
+		return ref.ID, nil
+	default:
+		return cli.resolveTemplateField(ctx, client, ident, index.MatchSlug, ref.Slug)
+	}
+}
+
+// resolveTemplateField resolves a template by exact slug or external ID (index.MatchSlug or
+// index.MatchExternalID), through the index when possible.
+func (cli *CLI) resolveTemplateField(ctx context.Context, client *api.Client, ident, field, value string) (int, error) {
+	if value == "" {
+		return 0, fmt.Errorf("empty template identifier")
+	}
+	notFound := fmt.Errorf("template %q not found (no template has %s %q)", ident, field, value)
+
+	if !cli.noCache {
+		matches, _, err := cli.lookupIndex(ctx, client, indexTemplates, func(col *index.Collection) ([]index.Entry, string) {
+			return col.Find(field, value), field
+		})
+		if err == nil {
+			if len(matches) == 0 {
+				return 0, notFound
+			}
+			return pickIndexedTemplate(matches, ident, field)
+		}
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return 0, fmt.Errorf("failed to resolve template %q: %w", ident, err)
+		}
+	}
+
+	match := func(t api.Template) bool { return strings.EqualFold(t.Slug, value) }
+	if field == index.MatchExternalID {
+		match = func(t api.Template) bool { return t.ExternalID == value }
+	}
+	for _, archived := range []bool{false, true} {
+		var matches []api.Template
+		err := walkPages(0, 0, resolvePageSize,
+			func(t api.Template) int { return t.ID },
+			func(limit, after, before int) ([]api.Template, error) {
+				return client.ListTemplates(ctx, limit, "", archived, after, before)
+			},
+			func(t api.Template) bool {
+				if match(t) {
+					matches = append(matches, t)
+				}
+				return true
+			},
+		)
+		if err != nil {
+			return 0, fmt.Errorf("failed to resolve template %q: %w", ident, err)
+		}
+		if len(matches) > 0 {
+			sort.Slice(matches, func(i, j int) bool { return matches[i].ID < matches[j].ID })
+			return pickResolvedTemplate(matches, ident, field)
+		}
+	}
+	return 0, notFound
+}
+
+// scanTemplateID resolves a template by listing every page (used with --no-cache).
+func scanTemplateID(ctx context.Context, client *api.Client, ident string) (int, error) {
+	needle := strings.ToLower(strings.TrimSpace(ident))
 	scan := func(includeArchived bool) (int, error) {
 		const pageLimit = 100
 		after := 0
@@ -94,26 +280,56 @@ func resolveTemplateID(ctx context.Context, client *api.Client, ident string) (i
 	return 0, fmt.Errorf("template %q not found (try numeric ID, URL, exact slug, or exact name)", ident)
 }
 
+func pickIndexedTemplate(matches []index.Entry, ident string, kind string) (int, error) {
+	templates := make([]api.Template, len(matches))
+	for i, m := range matches {
+		templates[i] = api.Template{ID: m.ID, Slug: m.Slug, Name: m.Name}
+	}
+	return pickResolvedTemplate(templates, ident, kind)
+}
+
 func pickResolvedTemplate(matches []api.Template, ident string, kind string) (int, error) {
 	if len(matches) == 1 {
 		return matches[0].ID, nil
 	}
 	if len(matches) > 1 {
-		var b strings.Builder
-		_, _ = fmt.Fprintf(&b, "template identifier %q is ambiguous (%s matched multiple templates):\n", ident, kind)
+		lines := make([]string, len(matches))
 		for i, m := range matches {
-			if i >= 10 {
-				b.WriteString("  ...\n")
-				break
-			}
-			_, _ = fmt.Fprintf(&b, "  - id=%d slug=%q name=%q\n", m.ID, m.Slug, m.Name)
+			lines[i] = fmt.Sprintf("id=%d slug=%q name=%q", m.ID, m.Slug, m.Name)
 		}
-		return 0, fmt.Errorf("%s", strings.TrimRight(b.String(), "\n"))
+		return 0, ambiguousError("template", ident, kind, lines)
 	}
 	return 0, nil
 }
 
-func resolveSubmissionID(ctx context.Context, client *api.Client, ident string) (int, error) {
+func (cli *CLI) resolveSubmissionID(ctx context.Context, client *api.Client, ident string) (int, error) {
+	if ref, ok := parseResourceURL(ident); ok {
+		switch ref.Kind {
+		case refSubmissions:
+			return ref.ID, nil
+		case refSubmitters:
+			// A signing link belongs to exactly one submission.
+			submitter, err := resolveSubmitterRef(ctx, client, ident, ref)
+			if err != nil {
+				return 0, err
+			}
+			return submitter.SubmissionID, nil
+		default:
+			return 0, wrongKindError(ident, "submission", ref)
+		}
+	}
+	if prefix, value := cutIdentPrefix(ident); prefix != "" {
+		field := submitterField(prefix)
+		submitters, err := findSubmitters(ctx, client, field, value)
+		if err != nil {
+			return 0, fmt.Errorf("failed to resolve submission %q: %w", ident, err)
+		}
+		if len(submitters) == 0 {
+			return 0, fmt.Errorf("submission %q not found (no submitter has %s %q)", ident, field, value)
+		}
+		return pickSubmissionOfSubmitters(submitters, ident, field)
+	}
+
 	if id, err := parseIDArg(ident); err == nil {
 		return id, nil
 	}
@@ -142,5 +358,189 @@ func resolveSubmissionID(ctx context.Context, client *api.Client, ident string)
 	if len(items) > 1 {
 		return 0, fmt.Errorf("submission slug %q matched multiple submissions; use numeric ID", ident)
 	}
-	return 0, fmt.Errorf("submission %q not found (try numeric ID, URL, or exact slug)", ident)
+
+	// Not a slug: try submission names through the local index.
+	if !cli.noCache {
+		matches, _, err := cli.lookupIndex(ctx, client, indexSubmissions, func(col *index.Collection) ([]index.Entry, string) {
+			return col.Lookup(slug)
+		})
+		if err == nil && len(matches) == 1 {
+			return matches[0].ID, nil
+		}
+		if err == nil && len(matches) > 1 {
+			ids := make([]string, 0, len(matches))
+			for _, m := range matches {
+				ids = append(ids, strconv.Itoa(m.ID))
+			}
+			return 0, fmt.Errorf("submission %q matched multiple submissions (ids %s); use numeric ID", ident, strings.Join(ids, ", "))
+		}
+	}
+	return 0, fmt.Errorf("submission %q not found (try numeric ID, URL, exact slug, name, ext:<external_id>, or email:<address>)", ident)
+}
+
+// pickSubmissionOfSubmitters returns the submission shared by submitters, or reports the
+// distinct submissions they belong to.
+func pickSubmissionOfSubmitters(submitters []api.Submitter, ident, kind string) (int, error) {
+	seen := map[int]bool{}
+	var lines []string
+	for _, s := range submitters {
+		if seen[s.SubmissionID] {
+			continue
+		}
+		seen[s.SubmissionID] = true
+		lines = append(lines, fmt.Sprintf("id=%d submitter_id=%d email=%q role=%q status=%q", s.SubmissionID, s.ID, s.Email, s.Role, s.Status))
+	}
+	if len(lines) == 1 {
+		return submitters[0].SubmissionID, nil
+	}
+	return 0, ambiguousError("submission", ident, kind, lines)
+}
+
+// resolveSubmitterID resolves a submitter identifier: a numeric ID, a URL (signing links
+// resolve by slug; a submission URL resolves when the submission has one submitter), a
+// slug, ext:<external_id> or email:<address>.
+func resolveSubmitterID(ctx context.Context, client *api.Client, ident string) (int, error) {
+	if ref, ok := parseResourceURL(ident); ok {
+		if ref.Kind == refSubmitters && ref.ID > 0 {
+			return ref.ID, nil
+		}
+		submitter, err := resolveSubmitterRef(ctx, client, ident, ref)
+		if err != nil {
+			return 0, err
+		}
+		return submitter.ID, nil
+	}
+	if prefix, value := cutIdentPrefix(ident); prefix != "" {
+		field := submitterField(prefix)
+		submitter, err := findSubmitter(ctx, client, ident, field, value)
+		if err != nil {
+			return 0, err
+		}
+		return submitter.ID, nil
+	}
+
+	if id, err := parseIDArg(ident); err == nil {
+		return id, nil
+	}
+
+	slug := strings.TrimSpace(ident)
+	if slug == "" {
+		return 0, fmt.Errorf("empty submitter identifier")
+	}
+	submitters, err := findSubmitters(ctx, client, "slug", slug)
+	if err != nil {
+		return 0, fmt.Errorf("failed to resolve submitter %q: %w", ident, err)
+	}
+	if len(submitters) == 0 {
+		return 0, fmt.Errorf("submitter %q not found (try numeric ID, URL, slug, ext:<external_id>, or email:<address>)", ident)
+	}
+	return pickResolvedSubmitter(submitters, ident, "slug")
+}
+
+// resolveSubmitterRef resolves a signing link, submitter URL or submission URL to a submitter.
+func resolveSubmitterRef(ctx context.Context, client *api.Client, ident string, ref resourceRef) (*api.Submitter, error) {
+	switch {
+	case ref.Kind == refSubmitters && ref.ID > 0:
+		submitter, err := client.GetSubmitter(ctx, ref.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get submitter %d: %w", ref.ID, err)
+		}
+		return submitter, nil
+	case ref.Kind == refSubmitters:
+		return findSubmitter(ctx, client, ident, "slug", ref.Slug)
+	case ref.Kind == refSubmissions:
+		submitters, err := client.ListSubmitters(ctx, resolvePageSize, ref.ID, "", "", "", 0, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve submitter %q: %w", ident, err)
+		}
+		if len(submitters) == 0 {
+			return nil, fmt.Errorf("submission %d has no submitters", ref.ID)
+		}
+		if _, err := pickResolvedSubmitter(submitters, ident, "submission"); err != nil {
+			return nil, err
+		}
+		return &submitters[0], nil
+	default:
+		return nil, wrongKindError(ident, "submitter", ref)
+	}
+}
+
+// submitterField maps an identifier prefix to the submitter field it matches.
+func submitterField(prefix string) string {
+	if prefix == emailPrefix {
+		return "email"
+	}
+	return "external_id"
+}
+
+// findSubmitter returns the single submitter whose field equals value.
+func findSubmitter(ctx context.Context, client *api.Client, ident, field, value string) (*api.Submitter, error) {
+	submitters, err := findSubmitters(ctx, client, field, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve submitter %q: %w", ident, err)
+	}
+	if len(submitters) == 0 {
+		return nil, fmt.Errorf("submitter %q not found (no submitter has %s %q)", ident, field, value)
+	}
+	if _, err := pickResolvedSubmitter(submitters, ident, field); err != nil {
+		return nil, err
+	}
+	return &submitters[0], nil
+}
+
+// findSubmitters lists submitters whose slug, external_id or email (case-insensitive) equals
+// value. The API filter narrows the listing; matches are checked again client-side because
+// the email filter is a substring search.
+func findSubmitters(ctx context.Context, client *api.Client, field, value string) ([]api.Submitter, error) {
+	if value == "" {
+		return nil, fmt.Errorf("empty %s", field)
+	}
+	var query, slug, externalID string
+	var match func(api.Submitter) bool
+	switch field {
+	case "slug":
+		slug = value
+		match = func(s api.Submitter) bool { return s.Slug == value }
+	case "external_id":
+		externalID = value
+		match = func(s api.Submitter) bool { return s.ExternalID == value }
+	case "email":
+		query = value
+		match = func(s api.Submitter) bool { return strings.EqualFold(s.Email, value) }
+	default:
+		return nil, fmt.Errorf("unknown submitter field %q", field)
+	}
+
+	var matches []api.Submitter
+	err := walkPages(0, 0, resolvePageSize,
+		func(s api.Submitter) int { return s.ID },
+		func(limit, after, before int) ([]api.Submitter, error) {
+			return client.ListSubmitters(ctx, limit, 0, query, slug, externalID, after, before)
+		},
+		func(s api.Submitter) bool {
+			if match(s) {
+				matches = append(matches, s)
+			}
+			return true
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].ID < matches[j].ID })
+	return matches, nil
+}
+
+func pickResolvedSubmitter(matches []api.Submitter, ident string, kind string) (int, error) {
+	if len(matches) == 1 {
+		return matches[0].ID, nil
+	}
+	if len(matches) > 1 {
+		lines := make([]string, len(matches))
+		for i, m := range matches {
+			lines[i] = fmt.Sprintf("id=%d submission_id=%d email=%q role=%q status=%q", m.ID, m.SubmissionID, m.Email, m.Role, m.Status)
+		}
+		return 0, ambiguousError("submitter", ident, kind, lines)
+	}
+	return 0, nil
 }
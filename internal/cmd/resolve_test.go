@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+)
+
+func TestParseResourceURL(t *testing.T) {
+	tests := []struct {
+		in     string
+		want   resourceRef
+		wantOK bool
+	}{
+		{"https://docuseal.com/s/pAMimKcyrLjqVt", resourceRef{Kind: refSubmitters, Slug: "pAMimKcyrLjqVt"}, true},
+		{"https://docuseal.com/s/abc123/completed", resourceRef{Kind: refSubmitters, Slug: "abc123"}, true},
+		{"https://sign.example.com/d/xYz789", resourceRef{Kind: refTemplates, Slug: "xYz789"}, true},
+		{"https://docuseal.com/submissions/42", resourceRef{Kind: refSubmissions, ID: 42}, true},
+		{"https://docuseal.com/templates/7/edit", resourceRef{Kind: refTemplates, ID: 7}, true},
+		{"https://api.docuseal.com/api/submitters/9", resourceRef{Kind: refSubmitters, ID: 9}, true},
+		{"https://docuseal.com/settings/profile", resourceRef{}, false},
+		{"https://docuseal.com/s/", resourceRef{}, false},
+		{"/s/abc", resourceRef{}, false},
+		{"abc123", resourceRef{}, false},
+		{"ext:ABC-123", resourceRef{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, ok := parseResourceURL(tt.in)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("parseResourceURL(%q) = %+v, %v; want %+v, %v", tt.in, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestCutIdentPrefix(t *testing.T) {
+	tests := []struct {
+		in, prefix, value string
+	}{
+		{"ext:ABC-123", externalIDPrefix, "ABC-123"},
+		{"EXT: ABC-123", externalIDPrefix, "ABC-123"},
+		{"email:alice@corp.com", emailPrefix, "alice@corp.com"},
+		{"ext:", "", "ext:"},
+		{"Offer Letter", "", "Offer Letter"},
+		{"text:foo", "", "text:foo"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			prefix, value := cutIdentPrefix(tt.in)
+			if prefix != tt.prefix || value != tt.value {
+				t.Errorf("cutIdentPrefix(%q) = %q, %q; want %q, %q", tt.in, prefix, value, tt.prefix, tt.value)
+			}
+		})
+	}
+}
+
+func TestParseIDArg(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    int
+		wantErr bool
+	}{
+		{"123", 123, false},
+		{"https://docuseal.com/submissions/42", 42, false},
+		{"https://docuseal.com/templates/7/edit", 7, false},
+		{"https://example.com/things/15", 15, false},
+		{"tpl-99", 99, false},
+		// The digits at the end of a signing link slug are not an ID.
+		{"https://docuseal.com/s/abc123", 0, true},
+		{"https://docuseal.com/d/xyz9", 0, true},
+		{"nope", 0, true},
+		{"", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parseIDArg(tt.in)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("parseIDArg(%q) = %d, %v; want %d, err=%v", tt.in, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPickResolvedSubmitter(t *testing.T) {
+	one := []api.Submitter{{ID: 5, SubmissionID: 50, Email: "alice@corp.com"}}
+	if id, err := pickResolvedSubmitter(one, "email:alice@corp.com", "email"); err != nil || id != 5 {
+		t.Errorf("pickResolvedSubmitter(one) = %d, %v; want 5", id, err)
+	}
+	if id, err := pickResolvedSubmitter(nil, "email:x@y.z", "email"); err != nil || id != 0 {
+		t.Errorf("pickResolvedSubmitter(nil) = %d, %v; want 0, nil", id, err)
+	}
+
+	many := []api.Submitter{
+		{ID: 5, SubmissionID: 50, Email: "alice@corp.com", Role: "First Party", Status: "completed"},
+		{ID: 8, SubmissionID: 80, Email: "alice@corp.com", Role: "Signer", Status: "pending"},
+	}
+	_, err := pickResolvedSubmitter(many, "email:alice@corp.com", "email")
+	if err == nil {
+		t.Fatal("pickResolvedSubmitter(many) succeeded, want ambiguity error")
+	}
+	want := `submitter identifier "email:alice@corp.com" is ambiguous (email matched multiple submitters):
+  - id=5 submission_id=50 email="alice@corp.com" role="First Party" status="completed"
+  - id=8 submission_id=80 email="alice@corp.com" role="Signer" status="pending"`
+	if err.Error() != want {
+		t.Errorf("error =\n%s\nwant\n%s", err, want)
+	}
+}
+
+func TestPickSubmissionOfSubmitters(t *testing.T) {
+	same := []api.Submitter{
+		{ID: 5, SubmissionID: 50, Email: "alice@corp.com"},
+		{ID: 6, SubmissionID: 50, Email: "alice@corp.com"},
+	}
+	if id, err := pickSubmissionOfSubmitters(same, "email:alice@corp.com", "email"); err != nil || id != 50 {
+		t.Errorf("pickSubmissionOfSubmitters(same) = %d, %v; want 50", id, err)
+	}
+
+	var many []api.Submitter
+	for i := 1; i <= 12; i++ {
+		many = append(many, api.Submitter{ID: i, SubmissionID: 100 + i, Email: "alice@corp.com"})
+	}
+	_, err := pickSubmissionOfSubmitters(many, "email:alice@corp.com", "email")
+	if err == nil {
+		t.Fatal("pickSubmissionOfSubmitters(many) succeeded, want ambiguity error")
+	}
+	msg := err.Error()
+	if !strings.HasPrefix(msg, `submission identifier "email:alice@corp.com" is ambiguous (email matched multiple submissions):`) {
+		t.Errorf("unexpected header: %s", msg)
+	}
+	if !strings.Contains(msg, "  - id=101 submitter_id=1 ") || !strings.HasSuffix(msg, "  ...") {
+		t.Errorf("unexpected listing: %s", msg)
+	}
+	if strings.Contains(msg, "id=111 ") {
+		t.Errorf("listing not capped at 10: %s", msg)
+	}
+}
+
+func TestResolveTemplateID_RefusesOtherLinks(t *testing.T) {
+	cli := New(Options{})
+	// Nothing may be fetched: the server does not exist.
+	client := api.New("http://127.0.0.1:1", "k")
+	for ident, want := range map[string]string{
+		"https://docuseal.com/submissions/5":    "is a submission link, not a template",
+		"https://docuseal.com/s/pAMimKcyrLjqVt": "is a submitter link, not a template",
+		"https://docuseal.com/submitters/9":     "is a submitter link, not a template",
+	} {
+		if _, err := cli.resolveTemplateID(t.Context(), client, ident); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("resolveTemplateID(%q) error = %v, want %q", ident, err, want)
+		}
+	}
+	if id, err := cli.resolveTemplateID(t.Context(), client, "https://docuseal.com/templates/7/edit"); err != nil || id != 7 {
+		t.Errorf("resolveTemplateID(template URL) = %d, %v; want 7", id, err)
+	}
+}
@@ -2,43 +2,102 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
+	"strings"
 	"sync"
+	"text/template"
 	"time"
 
 	"github.com/docuseal/docuseal-cli/internal/api"
 	"github.com/docuseal/docuseal-cli/internal/config"
 	"github.com/docuseal/docuseal-cli/internal/outfmt"
 	"github.com/docuseal/docuseal-cli/internal/ui"
+	"github.com/itchyny/gojq"
 	"github.com/spf13/cobra"
 )
 
-var (
+// rootState holds the root command and the global flags of a CLI.
+type rootState struct {
+	rootCmd *cobra.Command
+
 	output       string
 	color        string
 	dryRun       bool
+	curlOutput   bool
 	compactJSON  bool
 	quiet        bool
 	selectFields string
 	bareJSON     bool
 	withMeta     bool
+	noHeader     bool
+	formatFlag   string
+	jqFlag       string
 	timeout      time.Duration
 	retries      int
 	retryDelay   time.Duration
 	insecureTLS  bool
 	uiInstance   *ui.UI
-)
 
-var credentialAgeWarningOnce sync.Once
-var resolvedOutputMode = outfmt.Text
+	// Compiled --format / --jq expressions (nil when unset).
+	formatTemplate *template.Template
+	jqCode         *gojq.Code
+
+	credentialAgeWarningOnce sync.Once
+	resolvedOutputMode       outfmt.Mode
+
+	// runningCmd is the command being executed, set before any flag is validated.
+	runningCmd *cobra.Command
+
+	// outputErr is the first failure to write a result; Execute returns it so a --jq or
+	// --format error at run time exits non-zero.
+	outputErr error
+}
+
+// Execute runs the root command
+func (cli *CLI) Execute(ctx context.Context, args []string) (err error) {
+	if path, name, globals, rest, ok := cli.findPlugin(args); ok {
+		return cli.runPlugin(ctx, path, name, globals, rest)
+	}
+
+	cli.rootCmd.SetArgs(args)
+	cli.journalRun.reset()
+	cli.outputErr = nil
+	if cli.tracer == nil {
+		// This command starts tracing (if asked to); in-process commands reuse it.
+		defer func() {
+			if terr := cli.finishTrace(); terr != nil && err == nil {
+				err = terr
+			}
+		}()
+	}
+	cmd, err := cli.rootCmd.ExecuteContextC(ctx)
+	if err == nil {
+		err = cli.outputErr
+	}
 
-// rootCmd represents the base command
-var rootCmd = &cobra.Command{
-	Use:   "docuseal",
-	Short: "DocuSeal CLI - Manage document signing workflows",
-	Long: `DocuSeal CLI is a command-line tool for interacting with the DocuSeal API.
+	// Dry-run interception surfaces as an error from the client; it is a successful preview.
+	var dr *api.DryRunError
+	if errors.As(err, &dr) {
+		return cli.writeDryRun(cli.stdout, dr.Request)
+	}
+
+	// Journal failures only warn.
+	if jerr := cli.journalRun.record(cmd, args, err, cli.now()); jerr != nil {
+		cli.getLogger().Warn("failed to write history", "error", jerr.Error())
+	}
+	return err
+}
+
+func (cli *CLI) initRoot() {
+	// rootCmd represents the base command
+	cli.rootCmd = &cobra.Command{
+		Use:   "docuseal",
+		Short: "DocuSeal CLI - Manage document signing workflows",
+		Long: `DocuSeal CLI is a command-line tool for interacting with the DocuSeal API.
 
 It provides commands for managing templates, submissions, and submitters
 for document signing workflows. Designed for automation and AI agent use.
@@ -51,71 +110,91 @@ Examples:
   docuseal auth login --url https://docuseal.example.com --api-key YOUR_KEY
   docuseal templates list
   docuseal submissions create --template-id 123 --submitters "john@example.com:Signer"`,
-	SilenceUsage:  true,
-	SilenceErrors: true,
-	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-		// Validate/resolve output mode once so downstream code can rely on it.
-		mode, err := detectOutputModeFromArgsAndEnv()
-		if err != nil {
-			return err
-		}
-		resolvedOutputMode = mode
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			cli.runningCmd = cmd
 
-		switch color {
-		case "auto", "always", "never":
-			// ok
-		default:
-			return fmt.Errorf("invalid --color %q (use 'auto', 'always', or 'never')", color)
-		}
+			// Validate/resolve output mode once so downstream code can rely on it.
+			mode, err := cli.detectOutputModeFromArgsAndEnv()
+			if err != nil {
+				return err
+			}
+			cli.resolvedOutputMode = mode
 
-		if timeout <= 0 {
-			return fmt.Errorf("invalid --timeout %q (must be > 0)", timeout.String())
-		}
-		if retries < 0 {
-			return fmt.Errorf("invalid --retries %d (must be >= 0)", retries)
-		}
-		if retryDelay <= 0 {
-			return fmt.Errorf("invalid --retry-base-delay %q (must be > 0)", retryDelay.String())
-		}
-		if insecureTLS && !quiet {
-			fmt.Fprintln(os.Stderr, "WARNING: TLS certificate verification disabled (--insecure-skip-verify).")
-		}
+			if err := cli.parseOutputExpressions(); err != nil {
+				return err
+			}
 
-		return nil
-	},
-}
+			switch cli.color {
+			case "auto", "always", "never":
+				// ok
+			default:
+				return fmt.Errorf("invalid --color %q (use 'auto', 'always', or 'never')", cli.color)
+			}
 
-// Execute runs the root command
-func Execute(ctx context.Context, args []string) error {
-	rootCmd.SetArgs(args)
-	return rootCmd.ExecuteContext(ctx)
-}
-
-func init() {
-	timeout = defaultTimeoutFromEnv()
-	retries = defaultRetriesFromEnv()
-	retryDelay = defaultRetryDelayFromEnv()
-	insecureTLS = defaultInsecureTLSFromEnv()
-
-	rootCmd.PersistentFlags().StringVarP(&output, "output", "o", "", "Output format: text, json, ndjson (env: DOCUSEAL_OUTPUT)")
-	rootCmd.PersistentFlags().StringVar(&color, "color", getEnvOrDefault("DOCUSEAL_COLOR", "auto"), "Color output: auto, always, never (env: DOCUSEAL_COLOR)")
-	rootCmd.PersistentFlags().BoolVar(&compactJSON, "compact-json", false, "Use compact JSON (no indentation) for --output json/ndjson")
-	rootCmd.PersistentFlags().StringVar(&selectFields, "select", "", "Select JSON fields to output (comma-separated keys or dot paths; applies to --output json/ndjson)")
-	rootCmd.PersistentFlags().BoolVar(&bareJSON, "bare", false, "Output bare JSON (no envelope/metadata) for list commands")
-	rootCmd.PersistentFlags().BoolVar(&withMeta, "meta", false, "Include a final metadata line in NDJSON outputs for list commands")
-	rootCmd.PersistentFlags().DurationVar(&timeout, "timeout", timeout, "HTTP request timeout (env: DOCUSEAL_TIMEOUT)")
-	rootCmd.PersistentFlags().IntVar(&retries, "retries", retries, "Max retries for rate-limited requests (HTTP 429) (env: DOCUSEAL_RETRIES)")
-	rootCmd.PersistentFlags().DurationVar(&retryDelay, "retry-base-delay", retryDelay, "Base delay for exponential backoff when rate limited (env: DOCUSEAL_RETRY_BASE_DELAY)")
-	rootCmd.PersistentFlags().BoolVar(&insecureTLS, "insecure-skip-verify", insecureTLS, "Skip TLS certificate verification (env: DOCUSEAL_INSECURE_SKIP_VERIFY)")
-	rootCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "Preview destructive operations without executing them")
+			if cli.timeout <= 0 {
+				return fmt.Errorf("invalid --timeout %q (must be > 0)", cli.timeout.String())
+			}
+			if cli.retries < 0 {
+				return fmt.Errorf("invalid --retries %d (must be >= 0)", cli.retries)
+			}
+			if cli.retryDelay <= 0 {
+				return fmt.Errorf("invalid --retry-base-delay %q (must be > 0)", cli.retryDelay.String())
+			}
+			if err := cli.loadLogger(); err != nil {
+				return err
+			}
+			if cli.insecureTLS {
+				cli.getLogger().Warn("TLS certificate verification disabled (--insecure-skip-verify)")
+			}
+			if err := cli.loadTrust(); err != nil {
+				return err
+			}
+			if err := cli.loadGateway(); err != nil {
+				return err
+			}
+			cli.startTrace()
+
+			cli.journalRun.begin(cmd, cli.isDryRun())
+			return cli.enforcePolicy(cmd)
+		},
+	}
+
+	cli.timeout = defaultTimeoutFromEnv()
+	cli.retries = defaultRetriesFromEnv()
+	cli.retryDelay = defaultRetryDelayFromEnv()
+	cli.insecureTLS = defaultInsecureTLSFromEnv()
+
+	cli.rootCmd.PersistentFlags().StringVarP(&cli.output, "output", "o", "", "Output format: text, json, ndjson, csv, tsv, yaml (env: DOCUSEAL_OUTPUT)")
+	cli.rootCmd.PersistentFlags().StringVar(&cli.color, "color", getEnvOrDefault("DOCUSEAL_COLOR", "auto"), "Color output: auto, always, never (env: DOCUSEAL_COLOR)")
+	cli.rootCmd.PersistentFlags().BoolVar(&cli.compactJSON, "compact-json", false, "Use compact JSON (no indentation) for --output json/ndjson")
+	cli.rootCmd.PersistentFlags().StringVar(&cli.selectFields, "select", "", "Select fields to output (comma-separated keys or dot paths; for csv/tsv also sets column order)")
+	cli.rootCmd.PersistentFlags().BoolVar(&cli.bareJSON, "bare", false, "Output bare JSON (no envelope/metadata) for list commands")
+	cli.rootCmd.PersistentFlags().BoolVar(&cli.withMeta, "meta", false, "Include a final metadata line in NDJSON outputs for list commands")
+	cli.rootCmd.PersistentFlags().BoolVar(&cli.noHeader, "no-header", false, "Omit the header row for --output csv/tsv")
+	cli.rootCmd.PersistentFlags().StringVar(&cli.formatFlag, "format", "", "Format each result with a Go template (e.g. '{{.ID}}\\t{{.Status}}'; helpers: ago, join, upper, lower, json)")
+	cli.rootCmd.PersistentFlags().StringVar(&cli.jqFlag, "jq", "", "Filter and transform results with a jq expression (e.g. '.[] | .email')")
+	cli.rootCmd.PersistentFlags().DurationVar(&cli.timeout, "timeout", cli.timeout, "HTTP request timeout (env: DOCUSEAL_TIMEOUT)")
+	cli.rootCmd.PersistentFlags().IntVar(&cli.retries, "retries", cli.retries, "Max retries for rate-limited requests (HTTP 429) (env: DOCUSEAL_RETRIES)")
+	cli.rootCmd.PersistentFlags().DurationVar(&cli.retryDelay, "retry-base-delay", cli.retryDelay, "Base delay for exponential backoff when rate limited (env: DOCUSEAL_RETRY_BASE_DELAY)")
+	cli.rootCmd.PersistentFlags().BoolVar(&cli.insecureTLS, "insecure-skip-verify", cli.insecureTLS, "Skip TLS certificate verification (env: DOCUSEAL_INSECURE_SKIP_VERIFY)")
+	cli.initTrustFlags()
+	cli.initGatewayFlags()
+	cli.initDebugFlags()
+	cli.initLogFlags()
+	cli.rootCmd.PersistentFlags().BoolVar(&cli.dryRun, "dry-run", false, "Print mutating requests as JSON instead of sending them")
+	cli.rootCmd.PersistentFlags().BoolVar(&cli.curlOutput, "curl", false, "Print mutating requests as curl commands instead of sending them (implies --dry-run)")
+	cli.rootCmd.PersistentFlags().StringSliceVar(&cli.approvals, "approve", nil, "Approve actions the active policy gates (e.g. send-email)")
 	// No shorthand: "-q" is commonly used by subcommands (e.g. "--query -q").
-	rootCmd.PersistentFlags().BoolVar(&quiet, "quiet", false, "Suppress non-essential warnings and progress output")
+	cli.rootCmd.PersistentFlags().BoolVar(&cli.quiet, "quiet", false, "Suppress non-essential warnings and progress output; same as --log-level error")
+	cli.rootCmd.PersistentFlags().BoolVar(&cli.noCache, "no-cache", false, "Bypass the local identifier and completion caches")
 }
 
-func detectOutputModeFromArgsAndEnv() (outfmt.Mode, error) {
+func (cli *CLI) detectOutputModeFromArgsAndEnv() (outfmt.Mode, error) {
 	// Flag takes precedence over env var.
-	if output != "" {
-		return outfmt.Parse(output)
+	if cli.output != "" {
+		return outfmt.Parse(cli.output)
 	}
 	if envOutput := os.Getenv("DOCUSEAL_OUTPUT"); envOutput != "" {
 		return outfmt.Parse(envOutput)
@@ -124,74 +203,161 @@ func detectOutputModeFromArgsAndEnv() (outfmt.Mode, error) {
 }
 
 // getOutputMode returns the resolved output mode (validated during PersistentPreRunE).
-func getOutputMode() outfmt.Mode { return resolvedOutputMode }
+func (cli *CLI) getOutputMode() outfmt.Mode { return cli.resolvedOutputMode }
 
 // getUI returns the UI instance, creating it if needed
-func getUI() *ui.UI {
-	if uiInstance == nil {
-		uiInstance = ui.New(ui.ColorMode(color))
+func (cli *CLI) getUI() *ui.UI {
+	if cli.uiInstance == nil {
+		cli.uiInstance = ui.NewWithStreams(ui.ColorMode(cli.color), cli.stdin, cli.stdout, cli.stderr)
 	}
-	return uiInstance
+	return cli.uiInstance
 }
 
 // getClient creates an API client from config
-func getClient() (*api.Client, error) {
-	creds, err := config.Load()
+func (cli *CLI) getClient() (*api.Client, error) {
+	creds, err := cli.loadCredentials()
 	if err != nil {
 		return nil, fmt.Errorf("not authenticated (run 'docuseal auth login' or set DOCUSEAL_API_KEY and DOCUSEAL_URL environment variables): %w", err)
 	}
 
 	// Warn about old credentials (only once per session)
-	credentialAgeWarningOnce.Do(func() {
-		if quiet {
-			return
-		}
-		if warning := config.CheckCredentialAge(creds); warning != "" {
-			fmt.Fprintln(os.Stderr, warning)
+	cli.credentialAgeWarningOnce.Do(func() {
+		if config.CheckCredentialAge(creds) != "" {
+			days := int(cli.now().Sub(creds.CreatedAt).Hours() / 24)
+			cli.getLogger().Warn("credentials are old; consider rotating your API key",
+				"days", days, "created_at", creds.CreatedAt.Format("2006-01-02"))
 		}
 	})
 
-	opts := []api.ClientOption{
-		api.WithTimeout(timeout),
-		api.WithRetries(retries),
-		api.WithRetryBaseDelay(retryDelay),
+	gateway, detect, err := cli.gatewayOptions(creds.URL)
+	if err != nil {
+		return nil, err
 	}
-	if insecureTLS {
-		opts = append(opts, api.WithInsecureSkipVerify())
+	opts := append(cli.transportOptions(), gateway...)
+	if cli.interceptsRequests() {
+		opts = append(opts, api.WithDryRun())
+	}
+	if p, _ := cli.loadPolicy(); p != nil {
+		opts = append(opts, api.WithRequestHook(cli.policyRequestHook(p)))
+	}
+	opts = append(opts, api.WithRequestHook(cli.journalRun.requestHook))
+	opts = append(opts, api.WithRequestHook(cli.indexRequestHook))
+	cli.journalRun.setProfile(creds.URL)
+	var client *api.Client
+	if cli.shell != nil {
+		key := fmt.Sprint(creds.URL, cli.timeout, cli.retries, cli.retryDelay, cli.trustKey(), cli.gatewayKey(), fmt.Sprintf("%p", cli.tracer), cli.interceptsRequests())
+		client = cli.shell.clientFor(key, func() *api.Client { return cli.newClient(creds.URL, creds.APIKey, opts...) })
+	} else {
+		client = cli.newClient(creds.URL, creds.APIKey, opts...)
+	}
+	if detect {
+		if err := cli.detectAPIPath(cli.runContext(), creds.URL, client); err != nil {
+			return nil, err
+		}
 	}
-	return api.NewWithOptions(creds.URL, creds.APIKey, opts...), nil
+	return client, nil
 }
 
-// outputResult outputs the result based on mode
-func outputResult(mode outfmt.Mode, data any, textFn func()) {
-	if (mode == outfmt.JSON || mode == outfmt.NDJSON) && selectFields != "" {
-		if projected, err := outfmt.ApplySelect(data, selectFields); err == nil {
+// runContext returns the context of the running command, for work that has no cmd at hand.
+func (cli *CLI) runContext() context.Context {
+	if ctx := cli.rootCmd.Context(); ctx != nil {
+		return ctx
+	}
+	return context.Background()
+}
+
+// outputResult outputs the result based on mode. A failure to write it is recorded with
+// failOutput, so the command still exits non-zero.
+func (cli *CLI) outputResult(mode outfmt.Mode, data any, textFn func()) {
+	cli.journalRun.recordResult(data)
+	if cli.shell != nil {
+		cli.shell.setLast(data)
+	}
+
+	// --format and --jq replace the output mode entirely.
+	if cli.formatTemplate != nil {
+		if err := outfmt.WriteTemplate(cli.stdout, cli.formatTemplate, data); err != nil {
+			cli.failOutput(err)
+		}
+		return
+	}
+	if cli.jqCode != nil {
+		if err := outfmt.WriteJQ(context.Background(), cli.stdout, cli.jqCode, data); err != nil {
+			cli.failOutput(err)
+		}
+		return
+	}
+
+	if (mode == outfmt.JSON || mode == outfmt.NDJSON || mode == outfmt.YAML) && cli.selectFields != "" {
+		if projected, err := outfmt.ApplySelect(data, cli.selectFields); err == nil {
 			data = projected
 		} else {
-			getUI().Error("Error applying --select: %v", err)
+			cli.failOutput(fmt.Errorf("failed to apply --select: %w", err))
 		}
 	}
 
 	switch mode {
 	case outfmt.JSON:
 		var err error
-		if compactJSON {
-			err = outfmt.WriteJSONCompact(os.Stdout, data)
+		if cli.compactJSON {
+			err = outfmt.WriteJSONCompact(cli.stdout, data)
 		} else {
-			err = outfmt.WriteJSON(os.Stdout, data)
+			err = outfmt.WriteJSON(cli.stdout, data)
 		}
 		if err != nil {
-			getUI().Error("Error encoding JSON: %v", err)
+			cli.failOutput(fmt.Errorf("failed to encode JSON: %w", err))
 		}
 	case outfmt.NDJSON:
-		if err := outfmt.WriteNDJSON(os.Stdout, data); err != nil {
-			getUI().Error("Error encoding NDJSON: %v", err)
+		if err := outfmt.WriteNDJSON(cli.stdout, data); err != nil {
+			cli.failOutput(fmt.Errorf("failed to encode NDJSON: %w", err))
+		}
+	case outfmt.YAML:
+		if err := outfmt.WriteYAML(cli.stdout, data); err != nil {
+			cli.failOutput(fmt.Errorf("failed to encode YAML: %w", err))
+		}
+	case outfmt.CSV, outfmt.TSV:
+		opts := outfmt.TabularOptions{Comma: ',', Select: cli.selectFields, NoHeader: cli.noHeader}
+		if mode == outfmt.TSV {
+			opts.Comma = '\t'
+		}
+		if err := outfmt.WriteCSV(cli.stdout, data, opts); err != nil {
+			cli.failOutput(fmt.Errorf("failed to encode %s: %w", strings.ToUpper(mode.String()), err))
 		}
 	default:
 		textFn()
 	}
 }
 
+// failOutput records a failure to write a result, keeping the first.
+func (cli *CLI) failOutput(err error) {
+	if cli.outputErr == nil {
+		cli.outputErr = err
+	}
+}
+
+// parseOutputExpressions compiles --format and --jq so mistakes fail before any request is sent.
+func (cli *CLI) parseOutputExpressions() error {
+	cli.formatTemplate, cli.jqCode = nil, nil
+	if cli.formatFlag != "" && cli.jqFlag != "" {
+		return &api.ValidationError{Field: "format", Message: "--format and --jq cannot be combined"}
+	}
+	if cli.formatFlag != "" {
+		tmpl, err := outfmt.ParseTemplate(cli.formatFlag, cli.now)
+		if err != nil {
+			return &api.ValidationError{Field: "format", Message: err.Error()}
+		}
+		cli.formatTemplate = tmpl
+	}
+	if cli.jqFlag != "" {
+		code, err := outfmt.ParseJQ(cli.jqFlag)
+		if err != nil {
+			return &api.ValidationError{Field: "jq", Message: err.Error()}
+		}
+		cli.jqCode = code
+	}
+	return nil
+}
+
 // getEnvOrDefault returns the environment variable value or a default
 func getEnvOrDefault(key, defaultVal string) string {
 	if val := os.Getenv(key); val != "" {
@@ -235,17 +401,46 @@ func defaultInsecureTLSFromEnv() bool {
 	return false
 }
 
-// isDryRun returns whether dry-run mode is enabled
-func isDryRun() bool {
-	return dryRun
+// isDryRun returns whether dry-run mode is enabled (--dry-run or --curl)
+func (cli *CLI) isDryRun() bool {
+	return cli.dryRun || cli.curlOutput
 }
 
-// dryRunPreview outputs a dry-run preview message and returns true if in dry-run mode
-// If in dry-run mode, the caller should return early without executing the operation
-func dryRunPreview(format string, args ...any) bool {
-	if !dryRun {
+// interceptsRequests returns whether mutating requests are printed instead of sent.
+// Under --dry-run that holds for commands that can change state, and for batch, which
+// previews its operations; read-only commands that POST, such as tools verify-signature,
+// run normally.
+func (cli *CLI) interceptsRequests() bool {
+	if !cli.isDryRun() {
 		return false
 	}
-	getUI().Warning("[DRY RUN] Would "+format, args...)
+	cmd := cli.runningCmd
+	return cmd == nil || isMutating(cmd) || cmd == cli.batchCmd
+}
+
+// dryRunPreview outputs a one-line dry-run notice to stderr and returns true if in dry-run mode
+func (cli *CLI) dryRunPreview(format string, args ...any) bool {
+	if !cli.isDryRun() {
+		return false
+	}
+	cli.getLogger().Info(fmt.Sprintf("[DRY RUN] Would "+format, args...))
 	return true
 }
+
+// writeDryRun prints a request that was built but not sent.
+// The request is printed as JSON by default, or as a curl command with --curl.
+func (cli *CLI) writeDryRun(w io.Writer, req *api.PreparedRequest) error {
+	cli.dryRunPreview("send %s %s", req.Method, req.URL)
+
+	if cli.curlOutput {
+		_, err := fmt.Fprintln(w, req.Curl())
+		return err
+	}
+
+	out := req.Redacted()
+	out["dry_run"] = true
+	if cli.compactJSON {
+		return outfmt.WriteJSONCompact(w, out)
+	}
+	return outfmt.WriteJSON(w, out)
+}
@@ -0,0 +1,246 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+	"github.com/docuseal/docuseal-cli/internal/config"
+	"github.com/docuseal/docuseal-cli/internal/outfmt"
+	"github.com/docuseal/docuseal-cli/internal/ui"
+	"github.com/docuseal/docuseal-cli/internal/workflow"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+// workflowBlockedCommands cannot be workflow steps: they read stdin or start
+// long-lived sessions.
+var workflowBlockedCommands = map[string]bool{
+	"batch": true,
+	"run":   true,
+	"shell": true,
+	"mcp":   true,
+}
+
+var runStepColumns = []ui.Column{
+	{Name: "step", Header: "STEP", Fixed: true},
+	{Name: "status", Header: "STATUS", Fixed: true, Status: true},
+	{Name: "attempts", Header: "ATTEMPTS", Fixed: true},
+	{Name: "duration", Header: "DURATION", Fixed: true},
+	{Name: "error", Header: "ERROR"},
+}
+
+// runState holds the run command state of a CLI.
+type runState struct {
+	runCmd    *cobra.Command
+	runInputs []string
+	runResume string
+	runReport string
+}
+
+func (cli *CLI) initRun() {
+	cli.runCmd = &cobra.Command{
+		Use:   "run <workflow.yaml>",
+		Short: "Run a YAML workflow of CLI steps",
+		Long: `Run a declarative workflow whose steps are docuseal command lines.
+
+  name: nda
+  inputs:
+    signer: jane@example.com
+  steps:
+    - id: create
+      run: templates create-html --name NDA --html '<p>I agree</p>'
+    - id: send
+      run: submissions create --template-id ${{ steps.create.outputs.id }} --submitters "${{ inputs.signer }}:Signer"
+      outputs:
+        submission_id: .[0].submission_id
+      retry: {attempts: 3, delay: 5s}
+    - id: signed
+      wait:
+        run: submissions get ${{ steps.send.outputs.submission_id }}
+        until: .status == "completed"
+        interval: 30s
+        timeout: 72h
+    - id: documents
+      if: ${{ steps.signed.status == "succeeded" }}
+      run: submissions documents ${{ steps.send.outputs.submission_id }}
+
+Each step runs its command in-process with JSON output and the global flags given to
+run. ${{ expr }} expands to the value of a jq expression; steps.<id>.outputs is the
+command's JSON result (or the values named under outputs:, where "." is the result),
+steps.<id>.status is succeeded, failed or skipped, and inputs.<name> comes from
+inputs: or --input. Step options:
+
+  if:                 skip the step unless the expression is true
+  retry:              attempts (total) and delay (doubled after each failure)
+  timeout:            limit each attempt of a run step
+  wait:               poll run every interval until until is true, failing after timeout
+                      (defaults: 10s, 30m)
+  continue_on_error:  record a failure and go on
+
+The run's state is saved after every step under the config directory (runs/). An
+interrupted or failed run continues with --resume <run-id>: finished steps keep their
+outputs, and an interrupted wait keeps its deadline. The final report (the same JSON) is
+printed with --output json and written to --report.`,
+		Example: `  # Run a workflow with an input
+  docuseal run nda.yaml --input signer=jane@example.com
+
+  # Resume an interrupted run and save the report
+  docuseal run nda.yaml --resume 20260105T093000-1a2b3c4d --report report.json`,
+		Args: cobra.ExactArgs(1),
+		RunE: cli.runRun,
+	}
+
+	cli.rootCmd.AddCommand(cli.runCmd)
+
+	cli.runCmd.Flags().StringArrayVar(&cli.runInputs, "input", nil, "Set a workflow input (name=value, repeatable)")
+	cli.runCmd.Flags().StringVar(&cli.runResume, "resume", "", "Continue a previous run by its run ID")
+	cli.runCmd.Flags().StringVar(&cli.runReport, "report", "", "Also write the JSON run report to this file")
+}
+
+func (cli *CLI) runRun(cmd *cobra.Command, args []string) error {
+	file := args[0]
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("failed to read workflow: %w", err)
+	}
+	wf, err := workflow.Parse(data)
+	if err != nil {
+		return &api.ValidationError{Field: "workflow", Message: err.Error()}
+	}
+	mode := cli.getOutputMode()
+
+	dir, err := config.Dir()
+	if err != nil {
+		return err
+	}
+	store := workflow.NewStore(dir)
+
+	var st *workflow.State
+	if cli.runResume != "" {
+		if len(cli.runInputs) > 0 {
+			return &api.ValidationError{Field: "input", Message: "a resumed run keeps its original inputs"}
+		}
+		if st, err = store.Load(cli.runResume); err != nil {
+			return &api.ValidationError{Field: "resume", Message: err.Error()}
+		}
+		if err := st.Resume(wf); err != nil {
+			return &api.ValidationError{Field: "resume", Message: err.Error()}
+		}
+	} else {
+		inputs, err := workflowInputs(wf, cli.runInputs)
+		if err != nil {
+			return err
+		}
+		runID := cli.now().UTC().Format("20060102T150405") + "-" + uuid.NewString()[:8]
+		st = workflow.NewState(runID, file, wf, inputs, cli.now())
+	}
+
+	base := cli.changedPersistentFlagArgs()
+	runner := &workflow.Runner{
+		Exec: func(ctx context.Context, args []string) (any, error) {
+			if len(args) == 0 || workflowBlockedCommands[args[0]] {
+				return nil, &api.ValidationError{Field: "run", Message: fmt.Sprintf("%q cannot be a workflow step", strings.Join(args, " "))}
+			}
+			out, err := cli.runInProcess(ctx, append(slices.Clone(base), args...))
+			if err != nil {
+				return nil, err
+			}
+			return decodeStepResult(out), nil
+		},
+		Now:      cli.now,
+		StepDone: cli.logStepDone,
+	}
+	// Dry runs send nothing, so there is nothing to resume.
+	if !cli.isDryRun() {
+		runner.Save = store.Save
+	}
+
+	runErr := runner.Run(cmd.Context(), wf, st)
+
+	if cli.runReport != "" {
+		if err := writeRunReport(cli.runReport, st); err != nil {
+			cli.getLogger().Warn("failed to write the run report", "error", err.Error())
+		}
+	}
+	cli.outputResult(mode, st, func() {
+		rows := make([][]string, 0, len(st.Steps))
+		for _, s := range st.Steps {
+			rows = append(rows, []string{s.ID, s.Status, fmt.Sprint(s.Attempts), formatStepDuration(s), truncateString(s.Error, 80)})
+		}
+		cli.renderTable(runStepColumns, rows)
+		fmt.Fprintf(cli.stdout, "\nRun %s %s\n", st.RunID, st.Status)
+	})
+	if runErr != nil && runner.Save != nil {
+		cli.getLogger().Info(fmt.Sprintf("Resume with: docuseal run %s --resume %s", file, st.RunID), "run_id", st.RunID)
+	}
+	return runErr
+}
+
+// workflowInputs applies --input name=value overrides to the declared defaults.
+func workflowInputs(wf *workflow.Workflow, flags []string) (map[string]string, error) {
+	inputs := map[string]string{}
+	for k, v := range wf.Inputs {
+		inputs[k] = v
+	}
+	for _, kv := range flags {
+		name, value, ok := strings.Cut(kv, "=")
+		if !ok {
+			return nil, &api.ValidationError{Field: "input", Message: fmt.Sprintf("%q must be name=value", kv)}
+		}
+		if _, declared := wf.Inputs[name]; !declared {
+			return nil, &api.ValidationError{Field: "input", Message: fmt.Sprintf("workflow has no input %q", name)}
+		}
+		inputs[name] = value
+	}
+	return inputs, nil
+}
+
+// decodeStepResult parses a step's JSON output; anything else is kept as text.
+func decodeStepResult(out string) any {
+	out = strings.TrimSpace(out)
+	if out == "" {
+		return nil
+	}
+	var v any
+	if err := json.Unmarshal([]byte(out), &v); err != nil {
+		return out
+	}
+	return v
+}
+
+// logStepDone reports step progress on stderr, keeping stdout for the report.
+func (cli *CLI) logStepDone(s workflow.StepState) {
+	switch s.Status {
+	case workflow.StatusSucceeded:
+		cli.getLogger().Info("step succeeded", "step", s.ID, "duration", formatStepDuration(s))
+	case workflow.StatusFailed:
+		cli.getLogger().Warn("step failed", "step", s.ID, "error", s.Error)
+	default:
+		cli.getLogger().Info("step "+s.Status, "step", s.ID)
+	}
+}
+
+func formatStepDuration(s workflow.StepState) string {
+	if s.Status == workflow.StatusSkipped || s.Status == workflow.StatusPending {
+		return "-"
+	}
+	return (time.Duration(s.DurationMS) * time.Millisecond).String()
+}
+
+func writeRunReport(path string, st *workflow.State) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to write run report: %w", err)
+	}
+	defer f.Close()
+	if err := outfmt.WriteJSON(f, st); err != nil {
+		return fmt.Errorf("failed to write run report: %w", err)
+	}
+	return nil
+}
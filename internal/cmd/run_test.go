@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/docuseal/docuseal-cli/internal/config"
+	"github.com/docuseal/docuseal-cli/internal/policy"
+	"github.com/docuseal/docuseal-cli/internal/workflow"
+)
+
+const testWorkflow = `
+name: send
+steps:
+  - id: send
+    run: submissions create --template-id 3 --submitters a@example.com:Signer --send-email=false
+    outputs:
+      id: .[0].submission_id
+  - id: signed
+    wait:
+      run: submissions get ${{ steps.send.outputs.id }}
+      until: .status == "completed"
+      interval: 1ms
+  - id: again
+    run: submissions create --template-id 3 --submitters b@example.com:Signer --send-email=false
+`
+
+func runWorkflowTest(t *testing.T, args ...string) (*workflow.State, error) {
+	t.Helper()
+	dir := t.TempDir()
+	t.Setenv("DOCUSEAL_CONFIG_DIR", dir)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/submissions":
+			io.WriteString(w, `[{"id":11,"submission_id":7,"email":"a@example.com","status":"pending"}]`)
+		case r.URL.Path == "/api/submissions/7":
+			io.WriteString(w, `{"id":7,"status":"completed","submitters":[]}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	file := filepath.Join(dir, "wf.yaml")
+	if err := os.WriteFile(file, []byte(testWorkflow), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	creds := func() (config.Credentials, error) {
+		return config.Credentials{URL: srv.URL, APIKey: "test"}, nil
+	}
+	var out bytes.Buffer
+	cli := New(Options{Stdout: &out, Stderr: io.Discard, Credentials: creds})
+	err := cli.Execute(context.Background(), append([]string{"run", file, "-o", "json"}, args...))
+
+	var st workflow.State
+	if jerr := json.Unmarshal(out.Bytes(), &st); jerr != nil {
+		t.Fatalf("run output %q: %v", out.String(), jerr)
+	}
+	return &st, err
+}
+
+func TestRunWorkflow(t *testing.T) {
+	t.Setenv(policy.EnvName, "")
+	st, err := runWorkflowTest(t)
+	if err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if st.Status != workflow.StatusSucceeded || len(st.Steps) != 3 {
+		t.Fatalf("report = %+v", st)
+	}
+	if got := st.Steps[1].Command; len(got) != 3 || got[2] != "7" {
+		t.Errorf("wait command = %q, want submissions get 7", got)
+	}
+
+	saved, err := workflow.NewStore(os.Getenv("DOCUSEAL_CONFIG_DIR")).Load(st.RunID)
+	if err != nil || saved.Status != workflow.StatusSucceeded {
+		t.Errorf("saved state = %+v, %v", saved, err)
+	}
+}
+
+func TestRunWorkflowSharesPolicyAcrossSteps(t *testing.T) {
+	t.Setenv(policy.EnvName, `{"max_submissions_created": 1}`)
+	st, err := runWorkflowTest(t)
+	if ExitCode(err) != 8 {
+		t.Fatalf("run error = %v (exit %d), want policy exit 8", err, ExitCode(err))
+	}
+	if st.Steps[0].Status != workflow.StatusSucceeded || st.Steps[2].Status != workflow.StatusFailed {
+		t.Errorf("step statuses = %s, %s, want the second create to be denied", st.Steps[0].Status, st.Steps[2].Status)
+	}
+}
+
+func TestRunWorkflowStepsDoNotReadStdin(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("DOCUSEAL_CONFIG_DIR", dir)
+	t.Setenv(policy.EnvName, "")
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `{"id":7,"status":"completed","submitters":[]}`)
+	}))
+	defer srv.Close()
+	creds := func() (config.Credentials, error) {
+		return config.Credentials{URL: srv.URL, APIKey: "test"}, nil
+	}
+
+	wf := "name: stdin\nsteps:\n  - id: ids\n    run: submissions get --stdin\n  - id: batch\n    run: batch\n"
+	file := filepath.Join(dir, "wf.yaml")
+	if err := os.WriteFile(file, []byte(wf), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	stdin := bytes.NewBufferString("7\n")
+	var out bytes.Buffer
+	cli := New(Options{Stdout: &out, Stderr: io.Discard, Stdin: stdin, Credentials: creds})
+	err := cli.Execute(context.Background(), []string{"run", file, "-o", "json"})
+
+	var st workflow.State
+	if jerr := json.Unmarshal(out.Bytes(), &st); jerr != nil {
+		t.Fatalf("run output %q: %v", out.String(), jerr)
+	}
+	if err == nil || len(st.Steps) != 2 || st.Steps[1].Status != workflow.StatusFailed {
+		t.Errorf("run = %+v, %v; want the batch step refused", st.Steps, err)
+	}
+	if stdin.Len() == 0 {
+		t.Error("a workflow step consumed the stdin of docuseal run")
+	}
+}
@@ -27,6 +27,9 @@ type schemaCommand struct {
 	Long        string          `json:"long,omitempty"`
 	Example     string          `json:"example,omitempty"`
 	Hidden      bool            `json:"hidden,omitempty"`
+	Mutating    bool            `json:"mutating,omitempty"`
+	Destructive bool            `json:"destructive,omitempty"`
+	Plugin      string          `json:"plugin,omitempty"` // executable path of an external command
 	LocalFlags  []schemaFlag    `json:"local_flags"`
 	Persistent  []schemaFlag    `json:"persistent_flags"`
 	Inherited   []schemaFlag    `json:"inherited_flags"`
@@ -40,39 +43,78 @@ type cliSchema struct {
 	Commands    []schemaCommand   `json:"commands"`
 }
 
-var schemaCmd = &cobra.Command{
-	Use:     "schema",
-	Aliases: []string{"spec"},
-	Short:   "Print machine-readable CLI schema",
-	Long: `Print a machine-readable schema of commands and flags.
+// Command annotations describing side effects. Tool routers (schema, mcp) and
+// safety features key off these instead of hard-coding command paths.
+const (
+	annotationMutating    = "docuseal:mutating"
+	annotationDestructive = "docuseal:destructive"
+)
 
-This is intended for tool routers and agents to discover the CLI surface area.`,
-	RunE: runSchema,
+// markMutating annotates commands that change server state.
+func markMutating(cmds ...*cobra.Command) {
+	for _, c := range cmds {
+		if c.Annotations == nil {
+			c.Annotations = map[string]string{}
+		}
+		c.Annotations[annotationMutating] = "true"
+	}
+}
+
+// markDestructive annotates commands that archive, delete, or replace data.
+// Destructive commands are also mutating.
+func markDestructive(cmds ...*cobra.Command) {
+	markMutating(cmds...)
+	for _, c := range cmds {
+		c.Annotations[annotationDestructive] = "true"
+	}
+}
+
+func isMutating(c *cobra.Command) bool {
+	return c != nil && c.Annotations[annotationMutating] == "true"
 }
 
-func init() {
-	rootCmd.AddCommand(schemaCmd)
+func isDestructive(c *cobra.Command) bool {
+	return c != nil && c.Annotations[annotationDestructive] == "true"
+}
+
+// schemaState holds the schema command state of a CLI.
+type schemaState struct {
+	schemaCmd *cobra.Command
+}
+
+func (cli *CLI) initSchema() {
+	cli.schemaCmd = &cobra.Command{
+		Use:     "schema",
+		Aliases: []string{"spec"},
+		Short:   "Print machine-readable CLI schema",
+		Long: `Print a machine-readable schema of commands and flags.
+
+This is intended for tool routers and agents to discover the CLI surface area.`,
+		RunE: cli.runSchema,
+	}
+
+	cli.rootCmd.AddCommand(cli.schemaCmd)
 }
 
-func runSchema(cmd *cobra.Command, args []string) error {
-	mode := getOutputMode()
-	s := buildSchema()
-	outputResult(mode, s, func() {
-		fmt.Println("Use --output json to get machine-readable CLI schema.")
+func (cli *CLI) runSchema(cmd *cobra.Command, args []string) error {
+	mode := cli.getOutputMode()
+	s := cli.buildSchema()
+	cli.outputResult(mode, s, func() {
+		fmt.Fprintln(cli.stdout, "Use --output json to get machine-readable CLI schema.")
 	})
 	return nil
 }
 
-func buildSchema() cliSchema {
+func (cli *CLI) buildSchema() cliSchema {
 	return cliSchema{
-		Name: rootCmd.Name(),
+		Name: cli.rootCmd.Name(),
 		VersionInfo: map[string]string{
 			"version":    Version,
 			"commit":     Commit,
 			"build_date": BuildDate,
 		},
-		GlobalFlags: flagsToSchema(rootCmd.PersistentFlags()),
-		Commands:    commandsToSchema(rootCmd),
+		GlobalFlags: flagsToSchema(cli.rootCmd.PersistentFlags()),
+		Commands:    append(commandsToSchema(cli.rootCmd), cli.pluginSchemaCommands()...),
 	}
 }
 
@@ -101,6 +143,8 @@ func schemaCommandFromCommand(c *cobra.Command) schemaCommand {
 		Long:        c.Long,
 		Example:     c.Example,
 		Hidden:      c.Hidden,
+		Mutating:    isMutating(c),
+		Destructive: isDestructive(c),
 		LocalFlags:  flagsToSchema(c.LocalFlags()),
 		Persistent:  flagsToSchema(c.PersistentFlags()),
 		Inherited:   flagsToSchema(c.InheritedFlags()),
@@ -3,7 +3,8 @@ package cmd
 import "testing"
 
 func TestBuildSchemaContainsCommands(t *testing.T) {
-	s := buildSchema()
+	cli := New(Options{})
+	s := cli.buildSchema()
 	if s.Name != "docuseal" {
 		t.Fatalf("schema name = %q, want %q", s.Name, "docuseal")
 	}
@@ -0,0 +1,749 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+	"github.com/docuseal/docuseal-cli/internal/config"
+	"github.com/docuseal/docuseal-cli/internal/journal"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+const (
+	shellPrompt = "docuseal> "
+	// shellHistoryFile is kept in the config directory.
+	shellHistoryFile = "shell_history"
+	// shellHistoryMax bounds the history kept in memory and in the history file.
+	shellHistoryMax = 1000
+)
+
+// shellState holds the shell command state of a CLI.
+type shellState struct {
+	shellCmd *cobra.Command
+	// shell is the active session while `docuseal shell` runs, or nil.
+	shell *shellSession
+}
+
+func (cli *CLI) initShell() {
+	cli.shellCmd = &cobra.Command{
+		Use:   "shell",
+		Short: "Start an interactive session",
+		Long: `Start an interactive session that runs docuseal commands without the "docuseal" prefix.
+
+The session loads credentials once and reuses one API client and the identifier cache
+for every command, so the keyring (and any password prompt) is only used at startup.
+History is saved to ~/.config/docuseal/shell_history (lines with a secret flag such as
+--api-key, or with --header, are not saved). Tab completes commands, flags and IDs.
+
+Variables refer to earlier results:
+  $last            the previous command's result (lists are unwrapped to their items)
+  $last.id         a field of it; $last.0.id indexes into a list
+  $name            a variable set with "set name value"
+
+Built-in commands: help, set <name> <value...>, unset <name>, vars, exit (or Ctrl-D).
+
+Global flags given to 'shell' (e.g. --timeout, -o json) apply to every command. When
+stdin is not a terminal, commands are read one per line, and the shell exits non-zero
+if any of them failed.`,
+		Example: `  docuseal shell
+  docuseal> submissions create --template-id 12 --submitters alice@example.com:Signer
+  docuseal> submissions get $last.0.submission_id
+  docuseal> set tpl $last.template.id
+  docuseal> templates get $tpl`,
+		Args: cobra.NoArgs,
+		RunE: cli.runShell,
+	}
+
+	cli.rootCmd.AddCommand(cli.shellCmd)
+}
+
+// shellSession holds state shared by the commands of one shell session.
+type shellSession struct {
+	creds     *config.Credentials
+	client    *api.Client
+	clientKey string
+	vars      map[string]any
+}
+
+// loadCredentials returns the configured credentials, reusing those loaded by the shell
+// session when one is active.
+func (cli *CLI) loadCredentials() (config.Credentials, error) {
+	if cli.shell != nil {
+		return cli.shell.credentials(cli.credentials)
+	}
+	return cli.credentials()
+}
+
+func (s *shellSession) credentials(load func() (config.Credentials, error)) (config.Credentials, error) {
+	if s.creds == nil {
+		creds, err := load()
+		if err != nil {
+			return creds, err
+		}
+		s.creds = &creds
+	}
+	return *s.creds, nil
+}
+
+// clientFor returns the session client, rebuilding it only when key, which describes the
+// flags that shape it (timeouts, retries, TLS, dry run), differs from the previous command's.
+func (s *shellSession) clientFor(key string, build func() *api.Client) *api.Client {
+	if s.client == nil || s.clientKey != key {
+		s.client = build()
+		s.clientKey = key
+	}
+	return s.client
+}
+
+// forget drops cached credentials so they are reloaded, e.g. after `auth login`.
+func (s *shellSession) forget() {
+	s.creds = nil
+	s.client = nil
+}
+
+// setLast records a command result as $last.
+func (s *shellSession) setLast(data any) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return
+	}
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return
+	}
+	if m, ok := v.(map[string]any); ok {
+		if results, ok := m["results"].([]any); ok {
+			v = results
+		}
+	}
+	s.vars["last"] = v
+}
+
+// lookup resolves a variable reference such as "last.id" or "last.0.email" to a word.
+func (s *shellSession) lookup(ref string) (string, error) {
+	v, err := s.value(ref)
+	if err != nil {
+		return "", err
+	}
+	return shellValueString(v)
+}
+
+// value resolves a variable reference to its JSON value.
+func (s *shellSession) value(ref string) (any, error) {
+	parts := strings.Split(ref, ".")
+	v, ok := s.vars[parts[0]]
+	if !ok {
+		return nil, fmt.Errorf("undefined variable $%s", parts[0])
+	}
+	for i, p := range parts[1:] {
+		at := "$" + strings.Join(parts[:i+1], ".")
+		switch tv := v.(type) {
+		case map[string]any:
+			if v, ok = tv[p]; !ok {
+				return nil, fmt.Errorf("%s has no field %q", at, p)
+			}
+		case []any:
+			n, err := strconv.Atoi(p)
+			if err != nil {
+				return nil, fmt.Errorf("%s is a list; use %s.0.%s", at, at, p)
+			}
+			if n < 0 || n >= len(tv) {
+				return nil, fmt.Errorf("%s has %d items; index %d is out of range", at, len(tv), n)
+			}
+			v = tv[n]
+		default:
+			return nil, fmt.Errorf("%s is not an object", at)
+		}
+	}
+	return v, nil
+}
+
+// isContainer reports whether word is a variable reference to an object or list, which
+// is completed further with ".field" rather than ended.
+func (s *shellSession) isContainer(word string) bool {
+	if !strings.HasPrefix(word, "$") {
+		return false
+	}
+	v, err := s.value(strings.TrimPrefix(word, "$"))
+	if err != nil {
+		return false
+	}
+	switch v.(type) {
+	case map[string]any, []any:
+		return true
+	}
+	return false
+}
+
+// varCompletions completes a partial variable reference such as "$la" or "$last.sub".
+func (s *shellSession) varCompletions(partial string) []string {
+	ref := strings.TrimPrefix(strings.TrimPrefix(partial, "$"), "{")
+	var names []string
+	parent, leaf, nested := "", ref, false
+	if i := strings.LastIndex(ref, "."); i >= 0 {
+		parent, leaf, nested = ref[:i], ref[i+1:], true
+	}
+	if !nested {
+		for name := range s.vars {
+			names = append(names, name)
+		}
+	} else {
+		v, err := s.value(parent)
+		if err != nil {
+			return nil
+		}
+		switch tv := v.(type) {
+		case map[string]any:
+			for k := range tv {
+				names = append(names, parent+"."+k)
+			}
+		case []any:
+			for i := range tv {
+				names = append(names, parent+"."+strconv.Itoa(i))
+			}
+		}
+		leaf = parent + "." + leaf
+	}
+
+	var out []string
+	for _, name := range names {
+		if strings.HasPrefix(name, leaf) {
+			out = append(out, partial[:len(partial)-len(ref)]+name)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+func shellValueString(v any) (string, error) {
+	switch tv := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return tv, nil
+	case float64:
+		return strconv.FormatFloat(tv, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(tv), nil
+	default:
+		raw, err := json.Marshal(tv)
+		return string(raw), err
+	}
+}
+
+func (cli *CLI) runShell(cmd *cobra.Command, args []string) error {
+	if cli.shell != nil {
+		return fmt.Errorf("already in a shell session")
+	}
+	cli.shell = &shellSession{vars: map[string]any{}}
+	defer func() { cli.shell = nil }()
+
+	if _, err := cli.shell.credentials(cli.credentials); err != nil {
+		cli.getLogger().Warn("not authenticated: run 'auth login' or set DOCUSEAL_API_KEY and DOCUSEAL_URL")
+	}
+
+	base := cli.changedPersistentFlagArgs()
+	if cli.output != "" {
+		base = append(base, "--output="+cli.output)
+	}
+
+	in := cmd.InOrStdin()
+	if f, ok := in.(*os.File); ok && isTerminal(f) && isTerminal(cli.stdout) {
+		return cli.runShellTerminal(cmd.Context(), f, base)
+	}
+	return cli.runShellScript(cmd.Context(), in, base)
+}
+
+// runShellScript runs commands read from r, one per line.
+func (cli *CLI) runShellScript(ctx context.Context, r io.Reader, base []string) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
+	failed, total := 0, 0
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		total++
+		quit, err := cli.runShellLine(ctx, line, base)
+		if err != nil {
+			failed++
+		}
+		if quit {
+			break
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read commands: %w", err)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d commands failed", failed, total)
+	}
+	return nil
+}
+
+// runShellTerminal runs the interactive read-eval-print loop on a terminal.
+func (cli *CLI) runShellTerminal(ctx context.Context, in *os.File, base []string) error {
+	fd := int(in.Fd())
+	t := term.NewTerminal(struct {
+		io.Reader
+		io.Writer
+	}{ctrlCReader{in}, cli.stdout}, shellPrompt)
+	t.History = loadShellHistory()
+	t.AutoCompleteCallback = func(line string, pos int, key rune) (string, int, bool) {
+		if key != '\t' {
+			return "", 0, false
+		}
+		return cli.shellComplete(ctx, t, line, pos)
+	}
+
+	fmt.Fprintln(cli.stdout, `DocuSeal shell. Type "help" for help, "exit" or Ctrl-D to quit.`)
+	for {
+		if w, h, err := term.GetSize(fd); err == nil && w > 0 {
+			_ = t.SetSize(w, h)
+		}
+		state, err := term.MakeRaw(fd)
+		if err != nil {
+			return fmt.Errorf("failed to open terminal: %w", err)
+		}
+		line, err := t.ReadLine()
+		_ = term.Restore(fd, state)
+		if err == io.EOF {
+			fmt.Fprintln(cli.stdout)
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read command: %w", err)
+		}
+		if quit, _ := cli.runShellLine(ctx, line, base); quit {
+			return nil
+		}
+	}
+}
+
+// ctrlCReader turns Ctrl-C at the prompt into Ctrl-U (clear line); the line editor
+// would otherwise treat it as end of input and end the session.
+type ctrlCReader struct{ r io.Reader }
+
+func (c ctrlCReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	for i := 0; i < n; i++ {
+		if p[i] == 0x03 {
+			p[i] = 0x15
+		}
+	}
+	return n, err
+}
+
+// runShellLine runs one line of input: a built-in or a docuseal command. Errors are
+// reported on stderr and returned so scripted sessions can count them.
+func (cli *CLI) runShellLine(ctx context.Context, line string, base []string) (quit bool, err error) {
+	words, err := splitShellLine(line, cli.shell.lookup)
+	if err == nil && len(words) > 0 {
+		if words[0] == "docuseal" {
+			words = words[1:]
+		}
+		if len(words) == 0 {
+			return false, nil
+		}
+		quit, err = cli.runShellBuiltin(words)
+		if errors.Is(err, errNotBuiltin) {
+			err = cli.runShellCommand(ctx, words, base)
+		}
+	}
+	if err != nil {
+		WriteError(cli.stderr, append(base, words...), err)
+	}
+	return quit, err
+}
+
+var errNotBuiltin = errors.New("not a built-in")
+
+// shellBuiltins are the commands handled by the shell itself.
+var shellBuiltins = []string{"exit", "help", "quit", "set", "unset", "vars"}
+
+func (cli *CLI) runShellBuiltin(words []string) (bool, error) {
+	switch words[0] {
+	case "exit", "quit":
+		return true, nil
+	case "help":
+		if len(words) > 1 {
+			return false, errNotBuiltin
+		}
+		fmt.Fprintln(cli.stdout, `Run any docuseal command without the "docuseal" prefix, e.g. "templates list".
+Use "help <command>" for command help.
+
+Built-ins:
+  set <name> <value...>  set $name
+  unset <name>           remove $name
+  vars                   list variables
+  exit, quit, Ctrl-D     leave the shell
+
+$last is the previous command's result: $last.id, $last.0.id, $last.submitters.0.email.`)
+		return false, nil
+	case "set":
+		if len(words) < 3 {
+			return false, &api.ValidationError{Field: "set", Message: "usage: set <name> <value...>"}
+		}
+		if !isShellVarName(words[1]) || words[1] == "last" {
+			return false, &api.ValidationError{Field: "set", Message: fmt.Sprintf("invalid variable name %q", words[1])}
+		}
+		cli.shell.vars[words[1]] = strings.Join(words[2:], " ")
+		return false, nil
+	case "unset":
+		if len(words) != 2 {
+			return false, &api.ValidationError{Field: "unset", Message: "usage: unset <name>"}
+		}
+		delete(cli.shell.vars, words[1])
+		return false, nil
+	case "vars":
+		names := make([]string, 0, len(cli.shell.vars))
+		for name := range cli.shell.vars {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			value, _ := shellValueString(cli.shell.vars[name])
+			fmt.Fprintf(cli.stdout, "$%s = %s\n", name, truncateString(value, 100))
+		}
+		return false, nil
+	}
+	return false, errNotBuiltin
+}
+
+// runShellCommand executes a docuseal command in-process with fresh flag values.
+func (cli *CLI) runShellCommand(ctx context.Context, words, base []string) error {
+	if words[0] == "shell" {
+		return fmt.Errorf("already in a shell session")
+	}
+
+	// Interrupting a command returns to the prompt instead of ending the session.
+	ctx, stop := signal.NotifyContext(context.WithoutCancel(ctx), os.Interrupt)
+	defer stop()
+
+	resetFlags(cli.rootCmd)
+	resetContexts(cli.rootCmd)
+	args := append(append([]string{}, base...), words...)
+	err := cli.Execute(ctx, args)
+	if words[0] == "auth" {
+		cli.shell.forget()
+	}
+	return err
+}
+
+// splitShellLine splits line into words like a POSIX shell: whitespace separates words,
+// quotes group them, and a backslash escapes the next character outside single quotes.
+// $name, $name.field.0 and ${name.field} are replaced by lookup outside single quotes.
+func splitShellLine(line string, lookup func(ref string) (string, error)) ([]string, error) {
+	var words []string
+	var cur strings.Builder
+	inWord := false
+	var quote rune
+
+	runes := []rune(line)
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		switch {
+		case quote == '\'':
+			if c == '\'' {
+				quote = 0
+			} else {
+				cur.WriteRune(c)
+			}
+		case c == '\\' && i+1 < len(runes):
+			i++
+			cur.WriteRune(runes[i])
+			inWord = true
+		case c == '$' && i+1 < len(runes) && (runes[i+1] == '{' || isShellVarStart(runes[i+1])):
+			ref, n, err := scanShellVar(runes[i+1:])
+			if err != nil {
+				return nil, err
+			}
+			value, err := lookup(ref)
+			if err != nil {
+				return nil, err
+			}
+			cur.WriteString(value)
+			inWord = true
+			i += n
+		case quote == '"':
+			if c == '"' {
+				quote = 0
+			} else {
+				cur.WriteRune(c)
+			}
+		case c == '\'' || c == '"':
+			quote = c
+			inWord = true
+		case c == ' ' || c == '\t':
+			if inWord {
+				words = append(words, cur.String())
+				cur.Reset()
+				inWord = false
+			}
+		default:
+			cur.WriteRune(c)
+			inWord = true
+		}
+	}
+	if quote != 0 {
+		return nil, &api.ValidationError{Field: "input", Message: fmt.Sprintf("unterminated %c quote", quote)}
+	}
+	if inWord {
+		words = append(words, cur.String())
+	}
+	return words, nil
+}
+
+// scanShellVar reads a variable reference after "$" and returns it with the number of
+// runes consumed.
+func scanShellVar(r []rune) (string, int, error) {
+	if r[0] == '{' {
+		for j := 1; j < len(r); j++ {
+			if r[j] == '}' {
+				return string(r[1:j]), j + 1, nil
+			}
+		}
+		return "", 0, &api.ValidationError{Field: "input", Message: "unterminated ${"}
+	}
+	j := 0
+	for j < len(r) && (isShellVarStart(r[j]) || r[j] >= '0' && r[j] <= '9' ||
+		r[j] == '.' && j+1 < len(r) && (isShellVarStart(r[j+1]) || r[j+1] >= '0' && r[j+1] <= '9')) {
+		j++
+	}
+	return string(r[:j]), j, nil
+}
+
+func isShellVarStart(c rune) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func isShellVarName(s string) bool {
+	for i, c := range s {
+		if !isShellVarStart(c) && (i == 0 || c < '0' || c > '9') {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// shellComplete completes the word before pos using the command tree's shell completion.
+// A unique candidate is inserted; several are listed above the prompt after inserting
+// their common prefix.
+func (cli *CLI) shellComplete(ctx context.Context, t *term.Terminal, line string, pos int) (string, int, bool) {
+	prefix, suffix := line[:pos], line[pos:]
+	start := strings.LastIndexAny(prefix, " \t") + 1
+	words, partial := strings.Fields(prefix[:start]), prefix[start:]
+
+	var cands []string
+	switch {
+	case strings.HasPrefix(partial, "$"):
+		cands = cli.shell.varCompletions(partial)
+	default:
+		if len(words) > 0 && words[0] == "docuseal" {
+			words = words[1:]
+		}
+		if len(words) == 0 {
+			for _, b := range shellBuiltins {
+				if strings.HasPrefix(b, partial) {
+					cands = append(cands, b)
+				}
+			}
+		}
+		cands = append(cands, cli.cobraCompletions(ctx, words, partial)...)
+	}
+
+	switch len(cands) {
+	case 0:
+		return line, pos, true
+	case 1:
+		value := strings.SplitN(cands[0], "\t", 2)[0]
+		if strings.ContainsAny(value, " \t") {
+			value = "'" + value + "'"
+		}
+		newPrefix := prefix[:start] + value
+		if !cli.shell.isContainer(value) {
+			newPrefix += " "
+		}
+		return newPrefix + suffix, len(newPrefix), true
+	}
+
+	values := make([]string, len(cands))
+	for i, c := range cands {
+		values[i] = strings.SplitN(c, "\t", 2)[0]
+	}
+	if common := commonPrefix(values); len(common) > len(partial) {
+		newPrefix := prefix[:start] + common
+		return newPrefix + suffix, len(newPrefix), true
+	}
+	var b strings.Builder
+	for i, c := range cands {
+		if i == 50 {
+			fmt.Fprintf(&b, "... %d more\n", len(cands)-i)
+			break
+		}
+		value, desc, _ := strings.Cut(c, "\t")
+		if desc != "" {
+			fmt.Fprintf(&b, "%-24s %s\n", value, desc)
+		} else {
+			fmt.Fprintln(&b, value)
+		}
+	}
+	_, _ = t.Write([]byte(b.String()))
+	return line, pos, true
+}
+
+// cobraCompletions asks the command tree for completions of partial after words, the way
+// shell completion scripts do, and returns "value\tdescription" candidates.
+func (cli *CLI) cobraCompletions(ctx context.Context, words []string, partial string) []string {
+	var buf bytes.Buffer
+	resetFlags(cli.rootCmd)
+	resetContexts(cli.rootCmd)
+	cli.rootCmd.SetOut(&buf)
+	cli.rootCmd.SetErr(io.Discard)
+	defer func() {
+		cli.rootCmd.SetOut(cli.stdout)
+		cli.rootCmd.SetErr(cli.stderr)
+	}()
+
+	args := append(append([]string{cobra.ShellCompRequestCmd}, words...), partial)
+	cli.rootCmd.SetArgs(args)
+	if err := cli.rootCmd.ExecuteContext(ctx); err != nil {
+		return nil
+	}
+
+	var out []string
+	for _, line := range strings.Split(buf.String(), "\n") {
+		if strings.HasPrefix(line, ":") {
+			break
+		}
+		if line != "" && !strings.HasPrefix(line, "_activeHelp_") {
+			out = append(out, line)
+		}
+	}
+	return out
+}
+
+func commonPrefix(values []string) string {
+	common := values[0]
+	for _, v := range values[1:] {
+		for !strings.HasPrefix(v, common) {
+			common = common[:len(common)-1]
+		}
+	}
+	return common
+}
+
+// shellHistory is the line editor's history, persisted to the config directory.
+type shellHistory struct {
+	path      string
+	entries   []string // oldest first
+	fileLines int      // lines in the file at path
+}
+
+func loadShellHistory() *shellHistory {
+	h := &shellHistory{}
+	dir, err := config.Dir()
+	if err != nil {
+		return h
+	}
+	h.path = filepath.Join(dir, shellHistoryFile)
+	if data, err := os.ReadFile(h.path); err == nil {
+		for _, line := range strings.Split(string(data), "\n") {
+			if line != "" {
+				h.entries = append(h.entries, line)
+			}
+		}
+	}
+	h.fileLines = len(h.entries)
+	if len(h.entries) > shellHistoryMax {
+		h.entries = h.entries[len(h.entries)-shellHistoryMax:]
+		h.save()
+	}
+	return h
+}
+
+// save replaces the history file with the entries in memory.
+func (h *shellHistory) save() {
+	tmp, err := os.CreateTemp(filepath.Dir(h.path), shellHistoryFile+".*")
+	if err != nil {
+		return
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	for _, entry := range h.entries {
+		_, _ = fmt.Fprintln(tmp, entry)
+	}
+	if err := tmp.Close(); err != nil {
+		return
+	}
+	if err := os.Rename(tmp.Name(), h.path); err == nil {
+		h.fileLines = len(h.entries)
+	}
+}
+
+// Add records entry, skipping repeats and lines that carry credentials.
+func (h *shellHistory) Add(entry string) {
+	entry = strings.TrimSpace(entry)
+	if entry == "" || historyHasSecrets(entry) {
+		return
+	}
+	if n := len(h.entries); n > 0 && h.entries[n-1] == entry {
+		return
+	}
+	h.entries = append(h.entries, entry)
+	if len(h.entries) > shellHistoryMax {
+		h.entries = h.entries[1:]
+	}
+	if h.path == "" {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(h.path), 0o700); err != nil {
+		return
+	}
+	// Appending is cheap; the file is cut back to the entries in memory once it holds
+	// twice as many lines.
+	if h.fileLines >= 2*shellHistoryMax {
+		h.save()
+		return
+	}
+	f, err := os.OpenFile(h.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return
+	}
+	defer func() { _ = f.Close() }()
+	if _, err := fmt.Fprintln(f, entry); err == nil {
+		h.fileLines++
+	}
+}
+
+// Len returns the number of entries.
+func (h *shellHistory) Len() int { return len(h.entries) }
+
+// At returns the idx-th most recent entry.
+func (h *shellHistory) At(idx int) string { return h.entries[len(h.entries)-1-idx] }
+
+// historyHasSecrets reports whether line passes a flag the journal would redact.
+// Variables are left unexpanded; a line that does not parse is checked word by word.
+func historyHasSecrets(line string) bool {
+	words, err := splitShellLine(line, func(ref string) (string, error) { return "$" + ref, nil })
+	if err != nil {
+		words = strings.Fields(line)
+		for i, w := range words {
+			words[i] = strings.TrimLeft(w, `"'\`)
+		}
+	}
+	return journal.HasSecrets(words)
+}
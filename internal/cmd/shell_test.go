@@ -0,0 +1,184 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func newTestShell(cli *CLI) *shellSession {
+	s := &shellSession{vars: map[string]any{}}
+	cli.shell = s
+	return s
+}
+
+func TestSplitShellLine(t *testing.T) {
+	s := newTestShell(New(Options{}))
+	s.setLast(map[string]any{
+		"results": []any{
+			map[string]any{"id": 12, "email": "a@b.c", "name": "Jane Doe"},
+		},
+	})
+	s.vars["tpl"] = "t29"
+
+	tests := []struct {
+		line    string
+		want    []string
+		wantErr string
+	}{
+		{line: "templates list --limit 5", want: []string{"templates", "list", "--limit", "5"}},
+		{line: `  templates   get "Offer Letter" `, want: []string{"templates", "get", "Offer Letter"}},
+		{line: `x 'a "b"' "c 'd'" e\ f ''`, want: []string{"x", `a "b"`, "c 'd'", "e f", ""}},
+		{line: "submissions get $last.0.id", want: []string{"submissions", "get", "12"}},
+		{line: `x "$last.0.name" ${tpl}.pdf $tpl.`, want: []string{"x", "Jane Doe", "t29.pdf", "t29."}},
+		{line: `x '$tpl' \$tpl $ 5$`, want: []string{"x", "$tpl", "$tpl", "$", "5$"}},
+		{line: "x $last.id", wantErr: "$last is a list; use $last.0.id"},
+		{line: "x $last.3.id", wantErr: "$last has 1 items; index 3 is out of range"},
+		{line: "x $nope", wantErr: "undefined variable $nope"},
+		{line: `x "open`, wantErr: "unterminated \" quote"},
+		{line: "x ${tpl", wantErr: "unterminated ${"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			got, err := splitShellLine(tt.line, s.lookup)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("splitShellLine() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("splitShellLine() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitShellLine() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShellVarCompletions(t *testing.T) {
+	s := newTestShell(New(Options{}))
+	s.setLast(map[string]any{"id": 3, "slug": "t3", "submitters": []any{map[string]any{"email": "a@b.c"}}})
+	s.vars["lang"] = "en"
+
+	tests := []struct {
+		partial string
+		want    []string
+	}{
+		{"$la", []string{"$lang", "$last"}},
+		{"$last.s", []string{"$last.slug", "$last.submitters"}},
+		{"$last.submitters.", []string{"$last.submitters.0"}},
+		{"$last.submitters.0.", []string{"$last.submitters.0.email"}},
+		{"$nope.", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.partial, func(t *testing.T) {
+			if got := s.varCompletions(tt.partial); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("varCompletions(%q) = %q, want %q", tt.partial, got, tt.want)
+			}
+		})
+	}
+	if !s.isContainer("$last.submitters") || s.isContainer("$last.slug") {
+		t.Error("isContainer() misclassified $last fields")
+	}
+}
+
+func TestShellHistory(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("DOCUSEAL_CONFIG_DIR", dir)
+
+	h := loadShellHistory()
+	for _, line := range []string{"templates list", "templates list", "", "auth login --api-key secret", "templates list --header 'CF-Access-Client-Secret: gw-secret'",
+		"templates list --header=X-Tenant:secret", `x "--token=secret`, "submissions get 5"} {
+		h.Add(line)
+	}
+	if h.Len() != 2 || h.At(0) != "submissions get 5" || h.At(1) != "templates list" {
+		t.Errorf("history = %q, want [templates list, submissions get 5]", h.entries)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, shellHistoryFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "secret") {
+		t.Errorf("history file contains a credential: %q", data)
+	}
+	if reloaded := loadShellHistory(); !reflect.DeepEqual(reloaded.entries, h.entries) {
+		t.Errorf("reloaded history = %q, want %q", reloaded.entries, h.entries)
+	}
+}
+
+func TestShellHistoryTrimsFile(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("DOCUSEAL_CONFIG_DIR", dir)
+	path := filepath.Join(dir, shellHistoryFile)
+	countLines := func() int {
+		t.Helper()
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return strings.Count(string(data), "\n")
+	}
+
+	var b strings.Builder
+	for i := range 3 * shellHistoryMax {
+		fmt.Fprintf(&b, "templates get %d\n", i)
+	}
+	if err := os.WriteFile(path, []byte(b.String()), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// Loading keeps the newest entries and cuts the file back to them.
+	h := loadShellHistory()
+	if h.Len() != shellHistoryMax || h.At(0) != fmt.Sprintf("templates get %d", 3*shellHistoryMax-1) {
+		t.Fatalf("loaded %d entries, newest %q", h.Len(), h.At(0))
+	}
+	if n := countLines(); n != shellHistoryMax {
+		t.Errorf("history file has %d lines after loading, want %d", n, shellHistoryMax)
+	}
+
+	// Adding never lets the file grow past twice the limit.
+	for i := range 2 * shellHistoryMax {
+		h.Add(fmt.Sprintf("submissions get %d", i))
+	}
+	if n := countLines(); n > 2*shellHistoryMax {
+		t.Errorf("history file has %d lines, want at most %d", n, 2*shellHistoryMax)
+	}
+	if reloaded := loadShellHistory(); !reflect.DeepEqual(reloaded.entries, h.entries) {
+		t.Errorf("reloaded history differs from the one in memory")
+	}
+}
+
+func TestRunShellScriptBuiltins(t *testing.T) {
+	var buf bytes.Buffer
+	cli := New(Options{Stdout: &buf})
+	s := newTestShell(cli)
+
+	script := "# comment\nset name Jane Doe\nset greeting \"hi $name\"\nvars\nunset name\nset last 1\nexit\nvars\n"
+	err := cli.runShellScript(context.Background(), strings.NewReader(script), nil)
+	if err == nil || err.Error() != "1 of 6 commands failed" {
+		t.Errorf("runShellScript() error = %v, want 1 of 6 commands failed", err)
+	}
+	if want := "$greeting = hi Jane Doe\n$name = Jane Doe\n"; buf.String() != want {
+		t.Errorf("output = %q, want %q", buf.String(), want)
+	}
+	if _, ok := s.vars["name"]; ok {
+		t.Error("unset did not remove $name")
+	}
+}
+
+func TestCommonPrefix(t *testing.T) {
+	if got := commonPrefix([]string{"submissions", "submitters", "subm"}); got != "subm" {
+		t.Errorf("commonPrefix() = %q, want subm", got)
+	}
+	if got := commonPrefix([]string{"a", "b"}); got != "" {
+		t.Errorf("commonPrefix() = %q, want empty", got)
+	}
+}
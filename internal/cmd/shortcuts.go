@@ -2,42 +2,50 @@ package cmd
 
 import "github.com/spf13/cobra"
 
-// Top-level desire-path shortcuts so agents don't have to remember namespaces.
-var loginShortcutCmd = &cobra.Command{
-	Use:     "login",
-	Aliases: []string{"signin"},
-	Short:   "Authenticate (alias for 'docuseal auth login')",
-	RunE:    runAuthLogin,
+// shortcutsState holds the shortcuts command state of a CLI.
+type shortcutsState struct {
+	loginShortcutCmd  *cobra.Command
+	logoutShortcutCmd *cobra.Command
+	whoamiShortcutCmd *cobra.Command
+	statusShortcutCmd *cobra.Command
 }
 
-var logoutShortcutCmd = &cobra.Command{
-	Use:   "logout",
-	Short: "Remove stored credentials (alias for 'docuseal auth logout')",
-	RunE:  runAuthLogout,
-}
+func (cli *CLI) initShortcuts() {
+	// Top-level desire-path shortcuts so agents don't have to remember namespaces.
+	cli.loginShortcutCmd = &cobra.Command{
+		Use:     "login",
+		Aliases: []string{"signin"},
+		Short:   "Authenticate (alias for 'docuseal auth login')",
+		RunE:    cli.runAuthLogin,
+	}
 
-var whoamiShortcutCmd = &cobra.Command{
-	Use:     "whoami",
-	Aliases: []string{"me"},
-	Short:   "Show current user info (alias for 'docuseal auth whoami')",
-	RunE:    runAuthWhoami,
-}
+	cli.logoutShortcutCmd = &cobra.Command{
+		Use:   "logout",
+		Short: "Remove stored credentials (alias for 'docuseal auth logout')",
+		RunE:  cli.runAuthLogout,
+	}
 
-var statusShortcutCmd = &cobra.Command{
-	Use:     "status",
-	Aliases: []string{"health"},
-	Short:   "Show auth/connectivity status (alias for 'docuseal auth status')",
-	RunE:    runAuthStatus,
-}
+	cli.whoamiShortcutCmd = &cobra.Command{
+		Use:     "whoami",
+		Aliases: []string{"me"},
+		Short:   "Show current user info (alias for 'docuseal auth whoami')",
+		RunE:    cli.runAuthWhoami,
+	}
+
+	cli.statusShortcutCmd = &cobra.Command{
+		Use:     "status",
+		Aliases: []string{"health"},
+		Short:   "Show auth/connectivity status (alias for 'docuseal auth status')",
+		RunE:    cli.runAuthStatus,
+	}
 
-func init() {
 	// Keep these in addition to 'auth ...' so both paths work.
-	rootCmd.AddCommand(loginShortcutCmd)
-	rootCmd.AddCommand(logoutShortcutCmd)
-	rootCmd.AddCommand(whoamiShortcutCmd)
-	rootCmd.AddCommand(statusShortcutCmd)
+	cli.rootCmd.AddCommand(cli.loginShortcutCmd)
+	cli.rootCmd.AddCommand(cli.logoutShortcutCmd)
+	cli.rootCmd.AddCommand(cli.whoamiShortcutCmd)
+	cli.rootCmd.AddCommand(cli.statusShortcutCmd)
 
 	// Mirror CLI-login flags.
-	loginShortcutCmd.Flags().StringVar(&authURL, "url", "", "DocuSeal instance URL (skips browser)")
-	loginShortcutCmd.Flags().StringVar(&authAPIKey, "api-key", "", "API key (skips browser)")
+	cli.loginShortcutCmd.Flags().StringVar(&cli.authURL, "url", "", "DocuSeal instance URL (skips browser)")
+	cli.loginShortcutCmd.Flags().StringVar(&cli.authAPIKey, "api-key", "", "API key (skips browser)")
 }
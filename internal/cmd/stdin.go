@@ -0,0 +1,205 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+	"github.com/docuseal/docuseal-cli/internal/outfmt"
+	"github.com/spf13/cobra"
+)
+
+// stdinState holds the --stdin flags of a CLI.
+type stdinState struct {
+	stdinIDs         bool
+	stdinConcurrency int
+}
+
+// ID keys looked up in JSON input, most specific first. Submitter objects carry both
+// "id" and "submission_id", so submission commands prefer "submission_id".
+var (
+	templateIDKeys   = []string{"template_id", "id"}
+	submissionIDKeys = []string{"submission_id", "id"}
+	submitterIDKeys  = []string{"submitter_id", "id"}
+)
+
+// stdinFunc processes one identifier and returns its result and a one-line text summary.
+type stdinFunc func(ctx context.Context, ident string) (any, string, error)
+
+// addStdinFlags lets a command that takes an <id> read identifiers from stdin instead.
+func (cli *CLI) addStdinFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&cli.stdinIDs, "stdin", false, "Read IDs, slugs, or JSON objects (one per line) from stdin instead of <id>")
+	cmd.Flags().IntVar(&cli.stdinConcurrency, "concurrency", 4, "Maximum items processed at once with --stdin")
+	cmd.Args = cli.idOrStdinArgs
+}
+
+// idOrStdinArgs requires exactly one <id> argument unless --stdin is set.
+func (cli *CLI) idOrStdinArgs(cmd *cobra.Command, args []string) error {
+	if cli.stdinIDs {
+		if len(args) > 0 {
+			return fmt.Errorf("--stdin cannot be combined with an <id> argument")
+		}
+		return nil
+	}
+	return cobra.ExactArgs(1)(cmd, args)
+}
+
+// readStdinIdents reads identifiers from r, one per line. Lines may be bare IDs,
+// slugs or URLs, JSON objects (the first of keys present is used, then "slug"),
+// or JSON list envelopes with a "results" array. NDJSON "_meta" lines are skipped.
+func readStdinIdents(r io.Reader, keys []string) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16<<20)
+
+	var out []string
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if !strings.HasPrefix(line, "{") {
+			out = append(out, line)
+			continue
+		}
+
+		var obj map[string]any
+		if err := json.Unmarshal([]byte(line), &obj); err != nil {
+			return nil, &api.ValidationError{Field: "stdin", Message: fmt.Sprintf("line %d: invalid JSON: %v", lineNo, err)}
+		}
+		if _, ok := obj["_meta"]; ok {
+			continue
+		}
+		objs := []any{obj}
+		if results, ok := obj["results"].([]any); ok {
+			objs = results
+		}
+		for _, o := range objs {
+			m, ok := o.(map[string]any)
+			if !ok {
+				return nil, &api.ValidationError{Field: "stdin", Message: fmt.Sprintf("line %d: expected a JSON object", lineNo)}
+			}
+			ident, ok := identFromObject(m, keys)
+			if !ok {
+				return nil, &api.ValidationError{Field: "stdin", Message: fmt.Sprintf("line %d: no %s or slug field found", lineNo, strings.Join(keys, "/"))}
+			}
+			out = append(out, ident)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read stdin: %w", err)
+	}
+	return out, nil
+}
+
+func identFromObject(obj map[string]any, keys []string) (string, bool) {
+	candidates := make([]string, 0, len(keys)+1)
+	candidates = append(candidates, keys...)
+	candidates = append(candidates, "slug")
+	for _, k := range candidates {
+		switch v := obj[k].(type) {
+		case float64:
+			if v > 0 {
+				return strconv.FormatInt(int64(v), 10), true
+			}
+		case string:
+			if v != "" {
+				return v, true
+			}
+		}
+	}
+	return "", false
+}
+
+// runStdinIDs runs fn for every identifier on stdin with bounded concurrency.
+// Results are written in input order: one JSON object per line in JSON/NDJSON modes,
+// or fn's summary line in text mode. Failures are reported per item and fail the command.
+func (cli *CLI) runStdinIDs(cmd *cobra.Command, keys []string, fn stdinFunc) error {
+	if cli.stdinConcurrency < 1 {
+		return &api.ValidationError{Field: "concurrency", Message: "must be >= 1"}
+	}
+	idents, err := readStdinIdents(cmd.InOrStdin(), keys)
+	if err != nil {
+		return err
+	}
+	mode := cli.getOutputMode()
+
+	failed := 0
+	runOrdered(cmd.Context(), len(idents), cli.stdinConcurrency, false,
+		func(ctx context.Context, i int) (any, error) {
+			result, line, err := fn(ctx, idents[i])
+			if err != nil {
+				return nil, err
+			}
+			return stdinResult{value: result, line: line}, nil
+		},
+		func(i int, result any, err error) {
+			var dr *api.DryRunError
+			switch {
+			case errors.As(err, &dr):
+				cli.writeStdinDryRun(idents[i], dr.Request)
+			case err != nil:
+				failed++
+				cli.writeStdinError(mode, idents[i], err)
+			default:
+				r := result.(stdinResult)
+				cli.journalRun.recordResult(r.value)
+				if mode == outfmt.Text {
+					fmt.Fprintln(cli.stdout, r.line)
+					return
+				}
+				value := r.value
+				if cli.selectFields != "" {
+					if projected, err := outfmt.ApplySelect(value, cli.selectFields); err == nil {
+						value = projected
+					}
+				}
+				if err := outfmt.WriteJSONCompact(cli.stdout, value); err != nil {
+					fmt.Fprintf(cli.stderr, "Error writing output: %v\n", err)
+				}
+			}
+		},
+	)
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d items failed", failed, len(idents))
+	}
+	return nil
+}
+
+type stdinResult struct {
+	value any
+	line  string
+}
+
+func (cli *CLI) writeStdinDryRun(ident string, req *api.PreparedRequest) {
+	cli.dryRunPreview("send %s %s", req.Method, req.URL)
+	if cli.curlOutput {
+		fmt.Fprintln(cli.stdout, req.Curl())
+		return
+	}
+	out := req.Redacted()
+	out["dry_run"] = true
+	out["input"] = ident
+	if err := outfmt.WriteJSONCompact(cli.stdout, out); err != nil {
+		fmt.Fprintf(cli.stderr, "Error writing output: %v\n", err)
+	}
+}
+
+func (cli *CLI) writeStdinError(mode outfmt.Mode, ident string, err error) {
+	if mode == outfmt.Text {
+		fmt.Fprintf(cli.stderr, "%s: %v\n", ident, err)
+		return
+	}
+	out := map[string]any{"input": ident, "error": errorPayload(err)}
+	if werr := outfmt.WriteJSONCompact(cli.stdout, out); werr != nil {
+		fmt.Fprintf(cli.stderr, "Error writing output: %v\n", werr)
+	}
+}
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadStdinIdents(t *testing.T) {
+	input := strings.Join([]string{
+		"42",
+		"",
+		"abc-slug",
+		`{"id":7,"submission_id":99,"email":"a@b.c"}`,
+		`{"id":8}`,
+		`{"slug":"xyz"}`,
+		`{"results":[{"id":1},{"id":2}],"count":2}`,
+		`{"_meta":{"count":2}}`,
+	}, "\n")
+
+	got, err := readStdinIdents(strings.NewReader(input), submissionIDKeys)
+	if err != nil {
+		t.Fatalf("readStdinIdents() error = %v", err)
+	}
+	want := []string{"42", "abc-slug", "99", "8", "xyz", "1", "2"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("readStdinIdents() = %v, want %v", got, want)
+	}
+
+	got, err = readStdinIdents(strings.NewReader(`{"id":7,"submission_id":99}`), submitterIDKeys)
+	if err != nil || !reflect.DeepEqual(got, []string{"7"}) {
+		t.Errorf("readStdinIdents(submitter) = %v, %v, want [7]", got, err)
+	}
+
+	if _, err := readStdinIdents(strings.NewReader(`{"email":"a@b.c"}`), submissionIDKeys); err == nil {
+		t.Error("readStdinIdents() error = nil, want error for object without ID")
+	}
+}
@@ -3,27 +3,83 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
+	"strconv"
 	"strings"
 
 	"github.com/docuseal/docuseal-cli/internal/api"
 	"github.com/docuseal/docuseal-cli/internal/outfmt"
+	"github.com/docuseal/docuseal-cli/internal/ui"
 	"github.com/docuseal/docuseal-cli/internal/validation"
 	"github.com/spf13/cobra"
 )
 
-var submissionsCmd = &cobra.Command{
-	Use:     "submissions",
-	Aliases: []string{"submission", "sub", "s"},
-	Short:   "Manage submissions",
-	Long:    `List, create, and manage document signing submissions.`,
+// submissionsState holds the submissions command state of a CLI.
+type submissionsState struct {
+	submissionsCmd             *cobra.Command
+	submissionsListCmd         *cobra.Command
+	submissionsGetCmd          *cobra.Command
+	submissionsCreateCmd       *cobra.Command
+	submissionsCreatePDFCmd    *cobra.Command
+	submissionsCreateDOCXCmd   *cobra.Command
+	submissionsCreateHTMLCmd   *cobra.Command
+	submissionsDocumentsCmd    *cobra.Command
+	submissionsArchiveCmd      *cobra.Command
+	submissionsInitCmd         *cobra.Command
+	submissionsCreateEmailsCmd *cobra.Command
+
+	// Flags
+	submissionsLimit                int
+	submissionsTemplateID           int
+	submissionsStatus               string
+	submissionsQuery                string
+	submissionsSlug                 string
+	submissionsTemplateFolder       string
+	submissionsArchived             bool
+	submissionsAfter                int
+	submissionsBefore               int
+	submissionsSubmitters           []string
+	submissionsSendEmail            bool
+	submissionsSendSMS              bool
+	submissionsMessage              string
+	submissionsName                 string
+	submissionsFile                 string
+	submissionsHTML                 string
+	submissionsVariables            []string
+	submissionsEmails               string
+	submissionsEmailsCSV            string
+	submissionsMessageSubject       string
+	submissionsMessageBody          string
+	submissionsCompletedRedirectURL string
+	submissionsBCCCompleted         string
+	submissionsReplyTo              string
+	submissionsExpireAt             string
+}
+
+// submissionColumns are the text-mode columns of 'submissions list'; hidden ones are shown with --columns.
+var submissionColumns = []ui.Column{
+	{Name: "id", Header: "ID", Fixed: true},
+	{Name: "status", Header: "STATUS", Fixed: true, Status: true},
+	{Name: "template", Header: "TEMPLATE"},
+	{Name: "created", Header: "CREATED", Fixed: true},
+	{Name: "name", Header: "NAME", Hidden: true},
+	{Name: "slug", Header: "SLUG", Hidden: true},
+	{Name: "completed", Header: "COMPLETED", Fixed: true, Hidden: true},
+	{Name: "submitters", Header: "SUBMITTERS", Fixed: true, Hidden: true},
 }
 
-var submissionsListCmd = &cobra.Command{
-	Use:   "list",
-	Short: "List submissions",
-	Long:  `List submissions with optional filtering by template, status, query, and more.`,
-	Example: `  # List all submissions
+func (cli *CLI) initSubmissions() {
+	cli.submissionsCmd = &cobra.Command{
+		Use:     "submissions",
+		Aliases: []string{"submission", "sub", "s"},
+		Short:   "Manage submissions",
+		Long:    `List, create, and manage document signing submissions.`,
+	}
+
+	cli.submissionsListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List submissions",
+		Long:  `List submissions with optional filtering by template, status, query, and more.`,
+		Example: `  # List all submissions
   docuseal submissions list
 
   # Filter by template
@@ -52,23 +108,29 @@ var submissionsListCmd = &cobra.Command{
   docuseal submissions list --before 500 --limit 50
 
   # Combine multiple filters
-  docuseal submissions list --template-id 123 --status pending --query "john"`,
-	RunE: runSubmissionsList,
-}
+  docuseal submissions list --template-id 123 --status pending --query "john"
 
-var submissionsGetCmd = &cobra.Command{
-	Use:   "get <id>",
-	Short: "Get submission details",
-	Long:  `Retrieve detailed information about a specific submission.`,
-	Args:  cobra.ExactArgs(1),
-	RunE:  runSubmissionsGet,
-}
+  # Client-side filter: sent over a week ago, someone never opened it
+  docuseal submissions list --where 'status == "pending" && created_at < now-7d && submitters.any(s, s.opened_at == null)'`,
+		RunE: cli.runSubmissionsList,
+	}
+
+	cli.submissionsGetCmd = &cobra.Command{
+		Use:   "get <id>",
+		Short: "Get submission details",
+		Long: `Retrieve detailed information about a specific submission.
+
+The submission can be given as a numeric ID, a slug, a name, a submission URL, a signing
+link of one of its submitters (https://.../s/<slug>), or ext:<external_id> / email:<address>
+of one of its submitters.`,
+		RunE: cli.runSubmissionsGet,
+	}
 
-var submissionsCreateCmd = &cobra.Command{
-	Use:   "create",
-	Short: "Create submission from template",
-	Long:  `Create a new submission from an existing template.`,
-	Example: `  # Create submission with single signer
+	cli.submissionsCreateCmd = &cobra.Command{
+		Use:   "create",
+		Short: "Create submission from template",
+		Long:  `Create a new submission from an existing template.`,
+		Example: `  # Create submission with single signer
   docuseal submissions create --template-id 123 --submitters "john@example.com:Signer"
 
   # Create with multiple signers
@@ -97,67 +159,65 @@ var submissionsCreateCmd = &cobra.Command{
   docuseal submissions create --template-id 123 \
     --submitters "john@example.com:Signer" \
     --expire-at "2025-12-31T23:59:59Z"`,
-	RunE: runSubmissionsCreate,
-}
+		RunE: cli.runSubmissionsCreate,
+	}
 
-var submissionsCreatePDFCmd = &cobra.Command{
-	Use:   "create-pdf",
-	Short: "Create submission from PDF",
-	Long:  `Create a new submission directly from a PDF file.`,
-	RunE:  runSubmissionsCreatePDF,
-}
+	cli.submissionsCreatePDFCmd = &cobra.Command{
+		Use:   "create-pdf",
+		Short: "Create submission from PDF",
+		Long:  `Create a new submission directly from a PDF file.`,
+		RunE:  cli.runSubmissionsCreatePDF,
+	}
 
-var submissionsCreateDOCXCmd = &cobra.Command{
-	Use:   "create-docx",
-	Short: "Create submission from DOCX",
-	Long:  `Create a new submission directly from a DOCX file.`,
-	RunE:  runSubmissionsCreateDOCX,
-}
+	cli.submissionsCreateDOCXCmd = &cobra.Command{
+		Use:   "create-docx",
+		Short: "Create submission from DOCX",
+		Long:  `Create a new submission directly from a DOCX file.`,
+		RunE:  cli.runSubmissionsCreateDOCX,
+	}
 
-var submissionsCreateHTMLCmd = &cobra.Command{
-	Use:   "create-html",
-	Short: "Create submission from HTML",
-	Long:  `Create a new submission directly from HTML content.`,
-	RunE:  runSubmissionsCreateHTML,
-}
+	cli.submissionsCreateHTMLCmd = &cobra.Command{
+		Use:   "create-html",
+		Short: "Create submission from HTML",
+		Long:  `Create a new submission directly from HTML content.`,
+		RunE:  cli.runSubmissionsCreateHTML,
+	}
 
-var submissionsDocumentsCmd = &cobra.Command{
-	Use:   "documents <id>",
-	Short: "Get submission documents",
-	Long:  `Retrieve the signed documents for a submission.`,
-	Args:  cobra.ExactArgs(1),
-	RunE:  runSubmissionsDocuments,
-}
+	cli.submissionsDocumentsCmd = &cobra.Command{
+		Use:   "documents <id>",
+		Short: "Get submission documents",
+		Long:  `Retrieve the signed documents for a submission.`,
+		RunE:  cli.runSubmissionsDocuments,
+	}
 
-var submissionsArchiveCmd = &cobra.Command{
-	Use:   "archive <id>",
-	Short: "Archive submission",
-	Long:  `Archive a submission (soft delete).`,
-	Args:  cobra.ExactArgs(1),
-	RunE:  runSubmissionsArchive,
-}
+	cli.submissionsArchiveCmd = &cobra.Command{
+		Use:   "archive <id>",
+		Short: "Archive submission",
+		Long:  `Archive a submission (soft delete).`,
+		RunE:  cli.runSubmissionsArchive,
+	}
 
-var submissionsInitCmd = &cobra.Command{
-	Use:   "init",
-	Short: "Initialize submission without sending emails",
-	Long: `Initialize a submission from a template without automatically sending emails.
+	cli.submissionsInitCmd = &cobra.Command{
+		Use:   "init",
+		Short: "Initialize submission without sending emails",
+		Long: `Initialize a submission from a template without automatically sending emails.
 
 Use this when you want to set up submitters and get signing URLs
 without immediately notifying them via email.`,
-	Example: `  # Initialize submission
+		Example: `  # Initialize submission
   docuseal submissions init --template-id 123 --submitters "john@example.com:Signer"
 
   # Get signing URLs without sending emails
   docuseal submissions init --template-id 123 --submitters "john@example.com:Signer" -o json`,
-	RunE: runSubmissionsInit,
-}
+		RunE: cli.runSubmissionsInit,
+	}
 
-var submissionsCreateEmailsCmd = &cobra.Command{
-	Use:   "create-emails",
-	Short: "Create submissions from comma-separated emails",
-	Long: `Create submissions from a comma-separated list of emails.
+	cli.submissionsCreateEmailsCmd = &cobra.Command{
+		Use:   "create-emails",
+		Short: "Create submissions from comma-separated emails",
+		Long: `Create submissions from a comma-separated list of emails.
 This is a simplified endpoint for automation/Zapier workflows.`,
-	Example: `  # Create submissions with email list
+		Example: `  # Create submissions with email list
   docuseal submissions create-emails --template-id 123 --emails "a@example.com,b@example.com"
 
   # Create with email sending enabled
@@ -166,145 +226,139 @@ This is a simplified endpoint for automation/Zapier workflows.`,
   # Create with custom message
   docuseal submissions create-emails --template-id 123 --emails "a@example.com,b@example.com" \
     --message-subject "Please sign" --message-body "Click link to sign"`,
-	RunE: runSubmissionsCreateEmails,
-}
+		RunE: cli.runSubmissionsCreateEmails,
+	}
 
-// Flags
-var (
-	submissionsLimit                int
-	submissionsTemplateID           int
-	submissionsStatus               string
-	submissionsQuery                string
-	submissionsSlug                 string
-	submissionsTemplateFolder       string
-	submissionsArchived             bool
-	submissionsAfter                int
-	submissionsBefore               int
-	submissionsSubmitters           []string
-	submissionsSendEmail            bool
-	submissionsSendSMS              bool
-	submissionsMessage              string
-	submissionsName                 string
-	submissionsFile                 string
-	submissionsHTML                 string
-	submissionsVariables            []string
-	submissionsEmails               string
-	submissionsEmailsCSV            string
-	submissionsMessageSubject       string
-	submissionsMessageBody          string
-	submissionsCompletedRedirectURL string
-	submissionsBCCCompleted         string
-	submissionsReplyTo              string
-	submissionsExpireAt             string
-)
+	cli.rootCmd.AddCommand(cli.submissionsCmd)
+
+	cli.submissionsCmd.AddCommand(cli.submissionsListCmd)
+	cli.submissionsCmd.AddCommand(cli.submissionsGetCmd)
+	cli.submissionsCmd.AddCommand(cli.submissionsCreateCmd)
+	cli.submissionsCmd.AddCommand(cli.submissionsCreatePDFCmd)
+	cli.submissionsCmd.AddCommand(cli.submissionsCreateDOCXCmd)
+	cli.submissionsCmd.AddCommand(cli.submissionsCreateHTMLCmd)
+	cli.submissionsCmd.AddCommand(cli.submissionsDocumentsCmd)
+	cli.submissionsCmd.AddCommand(cli.submissionsArchiveCmd)
+	cli.submissionsCmd.AddCommand(cli.submissionsInitCmd)
+	cli.submissionsCmd.AddCommand(cli.submissionsCreateEmailsCmd)
 
-func init() {
-	rootCmd.AddCommand(submissionsCmd)
+	markMutating(cli.submissionsCreateCmd, cli.submissionsCreatePDFCmd, cli.submissionsCreateDOCXCmd, cli.submissionsCreateHTMLCmd, cli.submissionsInitCmd, cli.submissionsCreateEmailsCmd)
+	markDestructive(cli.submissionsArchiveCmd)
 
-	submissionsCmd.AddCommand(submissionsListCmd)
-	submissionsCmd.AddCommand(submissionsGetCmd)
-	submissionsCmd.AddCommand(submissionsCreateCmd)
-	submissionsCmd.AddCommand(submissionsCreatePDFCmd)
-	submissionsCmd.AddCommand(submissionsCreateDOCXCmd)
-	submissionsCmd.AddCommand(submissionsCreateHTMLCmd)
-	submissionsCmd.AddCommand(submissionsDocumentsCmd)
-	submissionsCmd.AddCommand(submissionsArchiveCmd)
-	submissionsCmd.AddCommand(submissionsInitCmd)
-	submissionsCmd.AddCommand(submissionsCreateEmailsCmd)
+	for _, c := range []*cobra.Command{cli.submissionsGetCmd, cli.submissionsDocumentsCmd, cli.submissionsArchiveCmd} {
+		cli.addStdinFlags(c)
+	}
 
 	// List flags
-	submissionsListCmd.Flags().IntVar(&submissionsLimit, "limit", 0, "Maximum number of submissions to return")
-	submissionsListCmd.Flags().IntVar(&submissionsTemplateID, "template-id", 0, "Filter by template ID")
-	submissionsListCmd.Flags().StringVar(&submissionsStatus, "status", "", "Filter by status (pending, completed)")
-	submissionsListCmd.Flags().StringVarP(&submissionsQuery, "query", "q", "", "Search by submitter name/email/phone")
-	submissionsListCmd.Flags().StringVar(&submissionsSlug, "slug", "", "Filter by submission slug")
-	submissionsListCmd.Flags().StringVar(&submissionsTemplateFolder, "template-folder", "", "Filter by template folder name")
-	submissionsListCmd.Flags().BoolVar(&submissionsArchived, "archived", false, "Show archived submissions")
-	submissionsListCmd.Flags().IntVar(&submissionsAfter, "after", 0, "Pagination cursor, get IDs greater than value")
-	submissionsListCmd.Flags().IntVar(&submissionsBefore, "before", 0, "Pagination cursor, get IDs less than value")
+	cli.submissionsListCmd.Flags().IntVar(&cli.submissionsLimit, "limit", 0, "Maximum number of submissions to return")
+	cli.addTableFlags(cli.submissionsListCmd, submissionColumns)
+	cli.addWhereFlag(cli.submissionsListCmd)
+	cli.submissionsListCmd.Flags().IntVar(&cli.submissionsTemplateID, "template-id", 0, "Filter by template ID")
+	cli.submissionsListCmd.Flags().StringVar(&cli.submissionsStatus, "status", "", "Filter by status (pending, completed)")
+	cli.submissionsListCmd.Flags().StringVarP(&cli.submissionsQuery, "query", "q", "", "Search by submitter name/email/phone")
+	cli.submissionsListCmd.Flags().StringVar(&cli.submissionsSlug, "slug", "", "Filter by submission slug")
+	cli.submissionsListCmd.Flags().StringVar(&cli.submissionsTemplateFolder, "template-folder", "", "Filter by template folder name")
+	cli.submissionsListCmd.Flags().BoolVar(&cli.submissionsArchived, "archived", false, "Show archived submissions")
+	cli.submissionsListCmd.Flags().IntVar(&cli.submissionsAfter, "after", 0, "Pagination cursor, get IDs greater than value")
+	cli.submissionsListCmd.Flags().IntVar(&cli.submissionsBefore, "before", 0, "Pagination cursor, get IDs less than value")
 
 	// Create flags
-	submissionsCreateCmd.Flags().IntVar(&submissionsTemplateID, "template-id", 0, "Template ID (required)")
-	submissionsCreateCmd.Flags().StringArrayVar(&submissionsSubmitters, "submitters", nil, "Submitters in EMAIL[:ROLE] format (can be repeated; ROLE optional when resolvable from template)")
-	submissionsCreateCmd.Flags().StringVar(&submissionsEmailsCSV, "emails", "", "Comma-separated emails (shortcut; uses template roles/order)")
-	submissionsCreateCmd.Flags().BoolVar(&submissionsSendEmail, "send-email", false, "Send email to submitters")
-	submissionsCreateCmd.Flags().BoolVar(&submissionsSendSMS, "send-sms", false, "Send SMS notification to submitters")
-	submissionsCreateCmd.Flags().StringVar(&submissionsMessage, "message", "", "Custom message in SUBJECT:BODY format")
-	submissionsCreateCmd.Flags().StringVar(&submissionsCompletedRedirectURL, "completed-redirect-url", "", "URL to redirect after completion")
-	submissionsCreateCmd.Flags().StringVar(&submissionsBCCCompleted, "bcc-completed", "", "BCC email address for completed documents")
-	submissionsCreateCmd.Flags().StringVar(&submissionsReplyTo, "reply-to", "", "Reply-To address for notification emails")
-	submissionsCreateCmd.Flags().StringVar(&submissionsExpireAt, "expire-at", "", "Expiration datetime (ISO 8601 format)")
-	mustMarkFlagRequired(submissionsCreateCmd, "template-id")
+	cli.submissionsCreateCmd.Flags().IntVar(&cli.submissionsTemplateID, "template-id", 0, "Template ID (required)")
+	cli.submissionsCreateCmd.Flags().StringArrayVar(&cli.submissionsSubmitters, "submitters", nil, "Submitters in EMAIL[:ROLE] format (can be repeated; ROLE optional when resolvable from template)")
+	cli.submissionsCreateCmd.Flags().StringVar(&cli.submissionsEmailsCSV, "emails", "", "Comma-separated emails (shortcut; uses template roles/order)")
+	cli.submissionsCreateCmd.Flags().BoolVar(&cli.submissionsSendEmail, "send-email", false, "Send email to submitters")
+	cli.submissionsCreateCmd.Flags().BoolVar(&cli.submissionsSendSMS, "send-sms", false, "Send SMS notification to submitters")
+	cli.submissionsCreateCmd.Flags().StringVar(&cli.submissionsMessage, "message", "", "Custom message in SUBJECT:BODY format")
+	cli.submissionsCreateCmd.Flags().StringVar(&cli.submissionsCompletedRedirectURL, "completed-redirect-url", "", "URL to redirect after completion")
+	cli.submissionsCreateCmd.Flags().StringVar(&cli.submissionsBCCCompleted, "bcc-completed", "", "BCC email address for completed documents")
+	cli.submissionsCreateCmd.Flags().StringVar(&cli.submissionsReplyTo, "reply-to", "", "Reply-To address for notification emails")
+	cli.submissionsCreateCmd.Flags().StringVar(&cli.submissionsExpireAt, "expire-at", "", "Expiration datetime (ISO 8601 format)")
+	mustMarkFlagRequired(cli.submissionsCreateCmd, "template-id")
 	// Either --submitters or --emails must be provided (validated at runtime).
 
 	// Init flags (reuse existing flags from create)
-	submissionsInitCmd.Flags().IntVar(&submissionsTemplateID, "template-id", 0, "Template ID (required)")
-	submissionsInitCmd.Flags().StringArrayVar(&submissionsSubmitters, "submitters", nil, "Submitters in EMAIL[:ROLE] format (required; ROLE optional when resolvable from template)")
-	mustMarkFlagRequired(submissionsInitCmd, "template-id")
-	mustMarkFlagRequired(submissionsInitCmd, "submitters")
+	cli.submissionsInitCmd.Flags().IntVar(&cli.submissionsTemplateID, "template-id", 0, "Template ID (required)")
+	cli.submissionsInitCmd.Flags().StringArrayVar(&cli.submissionsSubmitters, "submitters", nil, "Submitters in EMAIL[:ROLE] format (required; ROLE optional when resolvable from template)")
+	mustMarkFlagRequired(cli.submissionsInitCmd, "template-id")
+	mustMarkFlagRequired(cli.submissionsInitCmd, "submitters")
 
 	// Create emails flags
-	submissionsCreateEmailsCmd.Flags().IntVar(&submissionsTemplateID, "template-id", 0, "Template ID (required)")
-	submissionsCreateEmailsCmd.Flags().StringVar(&submissionsEmails, "emails", "", "Comma-separated list of emails (required)")
-	submissionsCreateEmailsCmd.Flags().BoolVar(&submissionsSendEmail, "send-email", false, "Send email to submitters")
-	submissionsCreateEmailsCmd.Flags().StringVar(&submissionsMessageSubject, "message-subject", "", "Custom email subject")
-	submissionsCreateEmailsCmd.Flags().StringVar(&submissionsMessageBody, "message-body", "", "Custom email body")
-	mustMarkFlagRequired(submissionsCreateEmailsCmd, "template-id")
-	mustMarkFlagRequired(submissionsCreateEmailsCmd, "emails")
+	cli.submissionsCreateEmailsCmd.Flags().IntVar(&cli.submissionsTemplateID, "template-id", 0, "Template ID (required)")
+	cli.submissionsCreateEmailsCmd.Flags().StringVar(&cli.submissionsEmails, "emails", "", "Comma-separated list of emails (required)")
+	cli.submissionsCreateEmailsCmd.Flags().BoolVar(&cli.submissionsSendEmail, "send-email", false, "Send email to submitters")
+	cli.submissionsCreateEmailsCmd.Flags().StringVar(&cli.submissionsMessageSubject, "message-subject", "", "Custom email subject")
+	cli.submissionsCreateEmailsCmd.Flags().StringVar(&cli.submissionsMessageBody, "message-body", "", "Custom email body")
+	mustMarkFlagRequired(cli.submissionsCreateEmailsCmd, "template-id")
+	mustMarkFlagRequired(cli.submissionsCreateEmailsCmd, "emails")
 
 	// Create PDF flags
-	submissionsCreatePDFCmd.Flags().StringVar(&submissionsFile, "file", "", "PDF file path (required)")
-	submissionsCreatePDFCmd.Flags().StringArrayVar(&submissionsSubmitters, "submitters", nil, "Submitters in EMAIL[:ROLE] format (required; default ROLE: Signer)")
-	submissionsCreatePDFCmd.Flags().StringVar(&submissionsName, "name", "", "Submission name")
-	mustMarkFlagRequired(submissionsCreatePDFCmd, "file")
-	mustMarkFlagRequired(submissionsCreatePDFCmd, "submitters")
+	cli.submissionsCreatePDFCmd.Flags().StringVar(&cli.submissionsFile, "file", "", "PDF file path (required)")
+	cli.submissionsCreatePDFCmd.Flags().StringArrayVar(&cli.submissionsSubmitters, "submitters", nil, "Submitters in EMAIL[:ROLE] format (required; default ROLE: Signer)")
+	cli.submissionsCreatePDFCmd.Flags().StringVar(&cli.submissionsName, "name", "", "Submission name")
+	mustMarkFlagRequired(cli.submissionsCreatePDFCmd, "file")
+	mustMarkFlagRequired(cli.submissionsCreatePDFCmd, "submitters")
 
 	// Create DOCX flags
-	submissionsCreateDOCXCmd.Flags().StringVar(&submissionsFile, "file", "", "DOCX file path (required)")
-	submissionsCreateDOCXCmd.Flags().StringArrayVar(&submissionsSubmitters, "submitters", nil, "Submitters in EMAIL[:ROLE] format (required; default ROLE: Signer)")
-	submissionsCreateDOCXCmd.Flags().StringVar(&submissionsName, "name", "", "Submission name")
-	submissionsCreateDOCXCmd.Flags().StringArrayVar(&submissionsVariables, "variables", nil, "Variables in KEY=VALUE format")
-	mustMarkFlagRequired(submissionsCreateDOCXCmd, "file")
-	mustMarkFlagRequired(submissionsCreateDOCXCmd, "submitters")
+	cli.submissionsCreateDOCXCmd.Flags().StringVar(&cli.submissionsFile, "file", "", "DOCX file path (required)")
+	cli.submissionsCreateDOCXCmd.Flags().StringArrayVar(&cli.submissionsSubmitters, "submitters", nil, "Submitters in EMAIL[:ROLE] format (required; default ROLE: Signer)")
+	cli.submissionsCreateDOCXCmd.Flags().StringVar(&cli.submissionsName, "name", "", "Submission name")
+	cli.submissionsCreateDOCXCmd.Flags().StringArrayVar(&cli.submissionsVariables, "variables", nil, "Variables in KEY=VALUE format")
+	mustMarkFlagRequired(cli.submissionsCreateDOCXCmd, "file")
+	mustMarkFlagRequired(cli.submissionsCreateDOCXCmd, "submitters")
 
 	// Create HTML flags
-	submissionsCreateHTMLCmd.Flags().StringVar(&submissionsHTML, "html", "", "HTML content (required)")
-	submissionsCreateHTMLCmd.Flags().StringArrayVar(&submissionsSubmitters, "submitters", nil, "Submitters in EMAIL[:ROLE] format (required; default ROLE: Signer)")
-	submissionsCreateHTMLCmd.Flags().StringVar(&submissionsName, "name", "", "Submission name")
-	mustMarkFlagRequired(submissionsCreateHTMLCmd, "html")
-	mustMarkFlagRequired(submissionsCreateHTMLCmd, "submitters")
+	cli.submissionsCreateHTMLCmd.Flags().StringVar(&cli.submissionsHTML, "html", "", "HTML content (required)")
+	cli.submissionsCreateHTMLCmd.Flags().StringArrayVar(&cli.submissionsSubmitters, "submitters", nil, "Submitters in EMAIL[:ROLE] format (required; default ROLE: Signer)")
+	cli.submissionsCreateHTMLCmd.Flags().StringVar(&cli.submissionsName, "name", "", "Submission name")
+	mustMarkFlagRequired(cli.submissionsCreateHTMLCmd, "html")
+	mustMarkFlagRequired(cli.submissionsCreateHTMLCmd, "submitters")
+
+	// Shell completion
+	for _, c := range []*cobra.Command{cli.submissionsGetCmd, cli.submissionsDocumentsCmd, cli.submissionsArchiveCmd} {
+		c.ValidArgsFunction = cli.idCompletion("completion-submissions", true)
+		cli.addPicker(c, "completion-submissions")
+	}
+	for _, c := range []*cobra.Command{cli.submissionsListCmd, cli.submissionsCreateCmd, cli.submissionsInitCmd, cli.submissionsCreateEmailsCmd} {
+		mustRegisterFlagCompletion(c, "template-id", cli.idFlagCompletion("completion-templates"))
+	}
+	mustRegisterFlagCompletion(cli.submissionsListCmd, "template-folder", cli.completeFolders)
 }
 
-func runSubmissionsList(cmd *cobra.Command, args []string) error {
-	client, err := getClient()
+func (cli *CLI) runSubmissionsList(cmd *cobra.Command, args []string) error {
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
-	limit := submissionsLimit
+	limit := cli.submissionsLimit
 	reqLimit := limit
-	if ((mode == outfmt.JSON && !bareJSON) || (mode == outfmt.NDJSON && withMeta)) && limit > 0 {
+	if ((mode == outfmt.JSON && !cli.bareJSON) || (mode == outfmt.NDJSON && cli.withMeta)) && limit > 0 {
 		reqLimit = limit + 1
 	}
 
-	submissions, err := client.ListSubmissions(
-		cmd.Context(),
-		reqLimit,
-		submissionsTemplateID,
-		submissionsStatus,
-		submissionsQuery,
-		submissionsSlug,
-		submissionsTemplateFolder,
-		submissionsArchived,
-		submissionsAfter,
-		submissionsBefore,
+	submissions, err := listFiltered(cmd.Context(), cli, reqLimit, cli.submissionsAfter, cli.submissionsBefore,
+		func(s api.Submission) int { return s.ID },
+		func(limit, after, before int) ([]api.Submission, error) {
+			return client.ListSubmissions(
+				cmd.Context(),
+				limit,
+				cli.submissionsTemplateID,
+				cli.submissionsStatus,
+				cli.submissionsQuery,
+				cli.submissionsSlug,
+				cli.submissionsTemplateFolder,
+				cli.submissionsArchived,
+				after,
+				before,
+			)
+		},
 	)
 	if err != nil {
 		return fmt.Errorf("failed to list submissions: %w", err)
 	}
 
-	if (mode == outfmt.JSON && !bareJSON) || (mode == outfmt.NDJSON && withMeta) {
+	if (mode == outfmt.JSON && !cli.bareJSON) || (mode == outfmt.NDJSON && cli.withMeta) {
 		out := submissions
 		hasMore := false
 		if limit > 0 && len(out) > limit {
@@ -319,10 +373,11 @@ func runSubmissionsList(cmd *cobra.Command, args []string) error {
 				nextAfter = out[len(out)-1].ID
 			}
 		}
+		sortItems(cli.sortFlag, out)
 
-		if mode == outfmt.JSON && !bareJSON {
-			env := makeListEnvelope(out, len(out), limit, submissionsAfter, submissionsBefore, hasMore, nextAfter, nextBefore)
-			outputResult(mode, env, func() {})
+		if mode == outfmt.JSON && !cli.bareJSON {
+			env := makeListEnvelope(out, len(out), limit, cli.submissionsAfter, cli.submissionsBefore, hasMore, nextAfter, nextBefore)
+			cli.outputResult(mode, env, func() {})
 			return nil
 		}
 
@@ -330,8 +385,8 @@ func runSubmissionsList(cmd *cobra.Command, args []string) error {
 			"_meta": map[string]any{
 				"count":       len(out),
 				"limit":       limit,
-				"after":       submissionsAfter,
-				"before":      submissionsBefore,
+				"after":       cli.submissionsAfter,
+				"before":      cli.submissionsBefore,
 				"has_more":    hasMore,
 				"next_after":  nextAfter,
 				"next_before": nextBefore,
@@ -342,50 +397,67 @@ func runSubmissionsList(cmd *cobra.Command, args []string) error {
 			stream = append(stream, s)
 		}
 		stream = append(stream, meta)
-		outputResult(mode, stream, func() {})
+		cli.outputResult(mode, stream, func() {})
 		return nil
 	}
 
-	outputResult(mode, submissions, func() {
+	nextPage := 0
+	if len(submissions) > 0 {
+		nextPage = submissions[len(submissions)-1].ID
+	}
+	sortItems(cli.sortFlag, submissions)
+
+	cli.outputResult(mode, submissions, func() {
 		if len(submissions) == 0 {
-			fmt.Println("No submissions found")
+			fmt.Fprintln(cli.stdout, "No submissions found")
 			return
 		}
-		w := newTabWriter()
-		if _, err := fmt.Fprintln(w, "ID\tSTATUS\tTEMPLATE\tCREATED"); err != nil {
-			fmt.Fprintf(os.Stderr, "Error writing output: %v\n", err)
-		}
+		rows := make([][]string, 0, len(submissions))
 		for _, s := range submissions {
-			if _, err := fmt.Fprintf(w, "%d\t%s\t%s\t%s\n",
-				s.ID,
+			rows = append(rows, []string{
+				strconv.Itoa(s.ID),
 				s.Status,
-				truncateString(s.TemplateName, 30),
+				s.TemplateName,
 				formatTime(s.CreatedAt),
-			); err != nil {
-				fmt.Fprintf(os.Stderr, "Error writing output: %v\n", err)
-			}
-		}
-		if err := w.Flush(); err != nil {
-			fmt.Fprintf(os.Stderr, "Error flushing output: %v\n", err)
+				s.Name,
+				s.Slug,
+				formatTimePtr(s.CompletedAt),
+				strconv.Itoa(len(s.Submitters)),
+			})
 		}
+		cli.renderTable(submissionColumns, rows)
 
 		// Pagination hint
-		if submissionsLimit > 0 && len(submissions) == submissionsLimit {
-			fmt.Fprintf(os.Stderr, "\n# More results may be available. Use --after %d to see next page.\n", submissions[len(submissions)-1].ID)
+		if cli.submissionsLimit > 0 && len(submissions) == cli.submissionsLimit {
+			cli.getLogger().Info("More results may be available; use --after to see the next page", "after", nextPage)
 		}
 	})
 
 	return nil
 }
 
-func runSubmissionsGet(cmd *cobra.Command, args []string) error {
-	client, err := getClient()
+func (cli *CLI) runSubmissionsGet(cmd *cobra.Command, args []string) error {
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
-	id, err := resolveSubmissionID(cmd.Context(), client, args[0])
+	if cli.stdinIDs {
+		return cli.runStdinIDs(cmd, submissionIDKeys, func(ctx context.Context, ident string) (any, string, error) {
+			id, err := cli.resolveSubmissionID(ctx, client, ident)
+			if err != nil {
+				return nil, "", err
+			}
+			submission, err := client.GetSubmission(ctx, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("failed to get submission: %w", err)
+			}
+			return submission, fmt.Sprintf("%d\t%s\t%s", submission.ID, submission.Status, submission.TemplateName), nil
+		})
+	}
+
+	id, err := cli.resolveSubmissionID(cmd.Context(), client, args[0])
 	if err != nil {
 		return err
 	}
@@ -395,20 +467,20 @@ func runSubmissionsGet(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get submission: %w", err)
 	}
 
-	outputResult(mode, submission, func() {
-		fmt.Printf("ID: %d\n", submission.ID)
-		fmt.Printf("Status: %s\n", submission.Status)
-		fmt.Printf("Slug: %s\n", submission.Slug)
-		fmt.Printf("Template: %s (ID: %d)\n", submission.TemplateName, submission.TemplateID)
-		fmt.Printf("Created: %s\n", formatTime(submission.CreatedAt))
-		fmt.Printf("Updated: %s\n", formatTime(submission.UpdatedAt))
+	cli.outputResult(mode, submission, func() {
+		fmt.Fprintf(cli.stdout, "ID: %d\n", submission.ID)
+		fmt.Fprintf(cli.stdout, "Status: %s\n", submission.Status)
+		fmt.Fprintf(cli.stdout, "Slug: %s\n", submission.Slug)
+		fmt.Fprintf(cli.stdout, "Template: %s (ID: %d)\n", submission.TemplateName, submission.TemplateID)
+		fmt.Fprintf(cli.stdout, "Created: %s\n", formatTime(submission.CreatedAt))
+		fmt.Fprintf(cli.stdout, "Updated: %s\n", formatTime(submission.UpdatedAt))
 		if submission.CompletedAt != nil {
-			fmt.Printf("Completed: %s\n", formatTimePtr(submission.CompletedAt))
+			fmt.Fprintf(cli.stdout, "Completed: %s\n", formatTimePtr(submission.CompletedAt))
 		}
 		if len(submission.Submitters) > 0 {
-			fmt.Println("Submitters:")
+			fmt.Fprintln(cli.stdout, "Submitters:")
 			for _, sub := range submission.Submitters {
-				fmt.Printf("  - %s (%s): %s\n", sub.Email, sub.Role, sub.Status)
+				fmt.Fprintf(cli.stdout, "  - %s (%s): %s\n", sub.Email, sub.Role, sub.Status)
 			}
 		}
 	})
@@ -416,37 +488,37 @@ func runSubmissionsGet(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func runSubmissionsCreate(cmd *cobra.Command, args []string) error {
-	message, err := parseMessage(submissionsMessage)
+func (cli *CLI) runSubmissionsCreate(cmd *cobra.Command, args []string) error {
+	message, err := parseMessage(cli.submissionsMessage)
 	if err != nil {
 		return err
 	}
 
 	// Validate optional email fields
-	if submissionsBCCCompleted != "" {
-		if err := validation.ValidateEmail(submissionsBCCCompleted); err != nil {
+	if cli.submissionsBCCCompleted != "" {
+		if err := validation.ValidateEmail(cli.submissionsBCCCompleted); err != nil {
 			return fmt.Errorf("invalid bcc-completed email: %w", err)
 		}
 	}
 
-	if submissionsReplyTo != "" {
-		if err := validation.ValidateEmail(submissionsReplyTo); err != nil {
+	if cli.submissionsReplyTo != "" {
+		if err := validation.ValidateEmail(cli.submissionsReplyTo); err != nil {
 			return fmt.Errorf("invalid reply-to email: %w", err)
 		}
 	}
 
-	client, err := getClient()
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
 	// Desire path: allow --emails to avoid role syntax entirely.
-	if strings.TrimSpace(submissionsEmailsCSV) != "" {
+	if strings.TrimSpace(cli.submissionsEmailsCSV) != "" {
 		req := &api.CreateSubmissionsFromEmailsRequest{
-			TemplateID: submissionsTemplateID,
-			Emails:     submissionsEmailsCSV,
-			SendEmail:  submissionsSendEmail,
+			TemplateID: cli.submissionsTemplateID,
+			Emails:     cli.submissionsEmailsCSV,
+			SendEmail:  cli.submissionsSendEmail,
 			Message:    message,
 		}
 
@@ -455,36 +527,36 @@ func runSubmissionsCreate(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("failed to create submissions from emails: %w", err)
 		}
 
-		outputResult(mode, createdSubmitters, func() {
+		cli.outputResult(mode, createdSubmitters, func() {
 			if len(createdSubmitters) > 0 {
-				fmt.Printf("Created submission %d with %d submitter(s)\n", createdSubmitters[0].SubmissionID, len(createdSubmitters))
+				fmt.Fprintf(cli.stdout, "Created submission %d with %d submitter(s)\n", createdSubmitters[0].SubmissionID, len(createdSubmitters))
 			}
 		})
 		return nil
 	}
 
-	if len(submissionsSubmitters) == 0 {
+	if len(cli.submissionsSubmitters) == 0 {
 		return fmt.Errorf("either --submitters or --emails is required")
 	}
 
-	submitters, err := parseSubmitters(submissionsSubmitters)
+	submitters, err := parseSubmitters(cli.submissionsSubmitters)
 	if err != nil {
 		return err
 	}
-	if err := resolveMissingRolesFromTemplate(cmd.Context(), client, submissionsTemplateID, submitters); err != nil {
+	if err := resolveMissingRolesFromTemplate(cmd.Context(), client, cli.submissionsTemplateID, submitters); err != nil {
 		return err
 	}
 
 	req := &api.CreateSubmissionRequest{
-		TemplateID:           submissionsTemplateID,
+		TemplateID:           cli.submissionsTemplateID,
 		Submitters:           submitters,
-		SendEmail:            submissionsSendEmail,
-		SendSMS:              submissionsSendSMS,
+		SendEmail:            cli.submissionsSendEmail,
+		SendSMS:              cli.submissionsSendSMS,
 		Message:              message,
-		CompletedRedirectURL: submissionsCompletedRedirectURL,
-		BCCCompleted:         submissionsBCCCompleted,
-		ReplyTo:              submissionsReplyTo,
-		ExpireAt:             submissionsExpireAt,
+		CompletedRedirectURL: cli.submissionsCompletedRedirectURL,
+		BCCCompleted:         cli.submissionsBCCCompleted,
+		ReplyTo:              cli.submissionsReplyTo,
+		ExpireAt:             cli.submissionsExpireAt,
 	}
 
 	createdSubmitters, err := client.CreateSubmission(cmd.Context(), req)
@@ -492,17 +564,17 @@ func runSubmissionsCreate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to create submission: %w", err)
 	}
 
-	outputResult(mode, createdSubmitters, func() {
+	cli.outputResult(mode, createdSubmitters, func() {
 		if len(createdSubmitters) > 0 {
-			fmt.Printf("Created submission %d with %d submitter(s)\n", createdSubmitters[0].SubmissionID, len(createdSubmitters))
+			fmt.Fprintf(cli.stdout, "Created submission %d with %d submitter(s)\n", createdSubmitters[0].SubmissionID, len(createdSubmitters))
 			for _, sub := range createdSubmitters {
 				displayName := sub.Email
 				if sub.Name != "" {
 					displayName = sub.Name + " <" + sub.Email + ">"
 				}
-				fmt.Printf("  - %s (%s): %s\n", displayName, sub.Role, sub.Status)
+				fmt.Fprintf(cli.stdout, "  - %s (%s): %s\n", displayName, sub.Role, sub.Status)
 				if sub.EmbedSrc != "" {
-					fmt.Printf("    Sign URL: %s\n", sub.EmbedSrc)
+					fmt.Fprintf(cli.stdout, "    Sign URL: %s\n", sub.EmbedSrc)
 				}
 			}
 		}
@@ -511,8 +583,8 @@ func runSubmissionsCreate(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func runSubmissionsCreatePDF(cmd *cobra.Command, args []string) error {
-	submitters, err := parseSubmitters(submissionsSubmitters)
+func (cli *CLI) runSubmissionsCreatePDF(cmd *cobra.Command, args []string) error {
+	submitters, err := parseSubmitters(cli.submissionsSubmitters)
 	if err != nil {
 		return err
 	}
@@ -522,26 +594,26 @@ func runSubmissionsCreatePDF(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	client, err := getClient()
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
-	submission, err := client.CreateSubmissionFromPDF(cmd.Context(), submissionsFile, submitters, submissionsName)
+	submission, err := client.CreateSubmissionFromPDF(cmd.Context(), cli.submissionsFile, submitters, cli.submissionsName)
 	if err != nil {
 		return fmt.Errorf("failed to create submission: %w", err)
 	}
 
-	outputResult(mode, submission, func() {
-		fmt.Printf("Created submission %d from PDF\n", submission.ID)
+	cli.outputResult(mode, submission, func() {
+		fmt.Fprintf(cli.stdout, "Created submission %d from PDF\n", submission.ID)
 	})
 
 	return nil
 }
 
-func runSubmissionsCreateDOCX(cmd *cobra.Command, args []string) error {
-	submitters, err := parseSubmitters(submissionsSubmitters)
+func (cli *CLI) runSubmissionsCreateDOCX(cmd *cobra.Command, args []string) error {
+	submitters, err := parseSubmitters(cli.submissionsSubmitters)
 	if err != nil {
 		return err
 	}
@@ -551,28 +623,28 @@ func runSubmissionsCreateDOCX(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	variables := parseVariables(submissionsVariables)
+	variables := parseVariables(cli.submissionsVariables)
 
-	client, err := getClient()
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
-	submission, err := client.CreateSubmissionFromDOCX(cmd.Context(), submissionsFile, submitters, submissionsName, variables)
+	submission, err := client.CreateSubmissionFromDOCX(cmd.Context(), cli.submissionsFile, submitters, cli.submissionsName, variables)
 	if err != nil {
 		return fmt.Errorf("failed to create submission: %w", err)
 	}
 
-	outputResult(mode, submission, func() {
-		fmt.Printf("Created submission %d from DOCX\n", submission.ID)
+	cli.outputResult(mode, submission, func() {
+		fmt.Fprintf(cli.stdout, "Created submission %d from DOCX\n", submission.ID)
 	})
 
 	return nil
 }
 
-func runSubmissionsCreateHTML(cmd *cobra.Command, args []string) error {
-	submitters, err := parseSubmitters(submissionsSubmitters)
+func (cli *CLI) runSubmissionsCreateHTML(cmd *cobra.Command, args []string) error {
+	submitters, err := parseSubmitters(cli.submissionsSubmitters)
 	if err != nil {
 		return err
 	}
@@ -582,32 +654,50 @@ func runSubmissionsCreateHTML(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	client, err := getClient()
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
-	submission, err := client.CreateSubmissionFromHTML(cmd.Context(), submissionsHTML, submitters, submissionsName)
+	submission, err := client.CreateSubmissionFromHTML(cmd.Context(), cli.submissionsHTML, submitters, cli.submissionsName)
 	if err != nil {
 		return fmt.Errorf("failed to create submission: %w", err)
 	}
 
-	outputResult(mode, submission, func() {
-		fmt.Printf("Created submission %d from HTML\n", submission.ID)
+	cli.outputResult(mode, submission, func() {
+		fmt.Fprintf(cli.stdout, "Created submission %d from HTML\n", submission.ID)
 	})
 
 	return nil
 }
 
-func runSubmissionsDocuments(cmd *cobra.Command, args []string) error {
-	client, err := getClient()
+func (cli *CLI) runSubmissionsDocuments(cmd *cobra.Command, args []string) error {
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
+
+	if cli.stdinIDs {
+		return cli.runStdinIDs(cmd, submissionIDKeys, func(ctx context.Context, ident string) (any, string, error) {
+			id, err := cli.resolveSubmissionID(ctx, client, ident)
+			if err != nil {
+				return nil, "", err
+			}
+			documents, err := client.GetSubmissionDocuments(ctx, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("failed to get documents: %w", err)
+			}
+			lines := make([]string, 0, len(documents))
+			for _, d := range documents {
+				lines = append(lines, fmt.Sprintf("%d\t%s\t%s", id, d.Name, d.URL))
+			}
+			return map[string]any{"submission_id": id, "documents": documents}, strings.Join(lines, "\n"), nil
+		})
+	}
 
-	id, err := resolveSubmissionID(cmd.Context(), client, args[0])
+	id, err := cli.resolveSubmissionID(cmd.Context(), client, args[0])
 	if err != nil {
 		return err
 	}
@@ -617,42 +707,52 @@ func runSubmissionsDocuments(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get documents: %w", err)
 	}
 
-	outputResult(mode, documents, func() {
+	cli.outputResult(mode, documents, func() {
 		if len(documents) == 0 {
-			fmt.Println("No documents found")
+			fmt.Fprintln(cli.stdout, "No documents found")
 			return
 		}
-		w := newTabWriter()
+		w := cli.newTabWriter()
 		if _, err := fmt.Fprintln(w, "NAME\tURL"); err != nil {
-			fmt.Fprintf(os.Stderr, "Error writing output: %v\n", err)
+			fmt.Fprintf(cli.stderr, "Error writing output: %v\n", err)
 		}
 		for _, d := range documents {
 			if _, err := fmt.Fprintf(w, "%s\t%s\n", d.Name, d.URL); err != nil {
-				fmt.Fprintf(os.Stderr, "Error writing output: %v\n", err)
+				fmt.Fprintf(cli.stderr, "Error writing output: %v\n", err)
 			}
 		}
 		if err := w.Flush(); err != nil {
-			fmt.Fprintf(os.Stderr, "Error flushing output: %v\n", err)
+			fmt.Fprintf(cli.stderr, "Error flushing output: %v\n", err)
 		}
 	})
 
 	return nil
 }
 
-func runSubmissionsArchive(cmd *cobra.Command, args []string) error {
-	client, err := getClient()
+func (cli *CLI) runSubmissionsArchive(cmd *cobra.Command, args []string) error {
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
-	id, err := resolveSubmissionID(cmd.Context(), client, args[0])
-	if err != nil {
-		return err
+	if cli.stdinIDs {
+		return cli.runStdinIDs(cmd, submissionIDKeys, func(ctx context.Context, ident string) (any, string, error) {
+			id, err := cli.resolveSubmissionID(ctx, client, ident)
+			if err != nil {
+				return nil, "", err
+			}
+			result, err := client.ArchiveSubmission(ctx, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("failed to archive submission: %w", err)
+			}
+			return result, fmt.Sprintf("Archived submission %d", result.ID), nil
+		})
 	}
 
-	if dryRunPreview("archive submission %d", id) {
-		return nil
+	id, err := cli.resolveSubmissionID(cmd.Context(), client, args[0])
+	if err != nil {
+		return err
 	}
 
 	result, err := client.ArchiveSubmission(cmd.Context(), id)
@@ -660,31 +760,31 @@ func runSubmissionsArchive(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to archive submission: %w", err)
 	}
 
-	outputResult(mode, result, func() {
-		fmt.Printf("Archived submission %d\n", result.ID)
+	cli.outputResult(mode, result, func() {
+		fmt.Fprintf(cli.stdout, "Archived submission %d\n", result.ID)
 	})
 
 	return nil
 }
 
-func runSubmissionsInit(cmd *cobra.Command, args []string) error {
-	submitters, err := parseSubmitters(submissionsSubmitters)
+func (cli *CLI) runSubmissionsInit(cmd *cobra.Command, args []string) error {
+	submitters, err := parseSubmitters(cli.submissionsSubmitters)
 	if err != nil {
 		return err
 	}
 
-	client, err := getClient()
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
-	if err := resolveMissingRolesFromTemplate(cmd.Context(), client, submissionsTemplateID, submitters); err != nil {
+	if err := resolveMissingRolesFromTemplate(cmd.Context(), client, cli.submissionsTemplateID, submitters); err != nil {
 		return err
 	}
 
 	req := &api.CreateSubmissionRequest{
-		TemplateID: submissionsTemplateID,
+		TemplateID: cli.submissionsTemplateID,
 		Submitters: submitters,
 		SendEmail:  false,
 	}
@@ -694,14 +794,14 @@ func runSubmissionsInit(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to initialize submission: %w", err)
 	}
 
-	outputResult(mode, submission, func() {
-		fmt.Printf("Initialized submission %d\n", submission.ID)
+	cli.outputResult(mode, submission, func() {
+		fmt.Fprintf(cli.stdout, "Initialized submission %d\n", submission.ID)
 		if len(submission.Submitters) > 0 {
-			fmt.Println("Submitters:")
+			fmt.Fprintln(cli.stdout, "Submitters:")
 			for _, sub := range submission.Submitters {
-				fmt.Printf("  - %s (%s)\n", sub.Email, sub.Role)
+				fmt.Fprintf(cli.stdout, "  - %s (%s)\n", sub.Email, sub.Role)
 				if sub.EmbedSrc != "" {
-					fmt.Printf("    Sign URL: %s\n", sub.EmbedSrc)
+					fmt.Fprintf(cli.stdout, "    Sign URL: %s\n", sub.EmbedSrc)
 				}
 			}
 		}
@@ -779,36 +879,36 @@ func resolveMissingRolesFromTemplate(ctx context.Context, client *api.Client, te
 	return nil
 }
 
-func runSubmissionsCreateEmails(cmd *cobra.Command, args []string) error {
+func (cli *CLI) runSubmissionsCreateEmails(cmd *cobra.Command, args []string) error {
 	// Validate inputs
-	if submissionsEmails == "" {
+	if cli.submissionsEmails == "" {
 		return fmt.Errorf("--emails is required and cannot be empty")
 	}
-	if submissionsTemplateID <= 0 {
+	if cli.submissionsTemplateID <= 0 {
 		return fmt.Errorf("--template-id must be a positive integer")
 	}
 
 	// Validate email list
-	if _, err := validation.ValidateEmailList(submissionsEmails); err != nil {
+	if _, err := validation.ValidateEmailList(cli.submissionsEmails); err != nil {
 		return fmt.Errorf("invalid email list: %w", err)
 	}
 
-	client, err := getClient()
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
 	req := &api.CreateSubmissionsFromEmailsRequest{
-		TemplateID: submissionsTemplateID,
-		Emails:     submissionsEmails,
-		SendEmail:  submissionsSendEmail,
+		TemplateID: cli.submissionsTemplateID,
+		Emails:     cli.submissionsEmails,
+		SendEmail:  cli.submissionsSendEmail,
 	}
 
-	if submissionsMessageSubject != "" || submissionsMessageBody != "" {
+	if cli.submissionsMessageSubject != "" || cli.submissionsMessageBody != "" {
 		req.Message = &api.Message{
-			Subject: submissionsMessageSubject,
-			Body:    submissionsMessageBody,
+			Subject: cli.submissionsMessageSubject,
+			Body:    cli.submissionsMessageBody,
 		}
 	}
 
@@ -817,12 +917,12 @@ func runSubmissionsCreateEmails(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to create submissions: %w", err)
 	}
 
-	outputResult(mode, submitters, func() {
-		fmt.Printf("Created %d submitter(s)\n", len(submitters))
+	cli.outputResult(mode, submitters, func() {
+		fmt.Fprintf(cli.stdout, "Created %d submitter(s)\n", len(submitters))
 		for _, sub := range submitters {
-			fmt.Printf("  - %s (%s): %s\n", sub.Email, sub.Role, sub.Status)
+			fmt.Fprintf(cli.stdout, "  - %s (%s): %s\n", sub.Email, sub.Role, sub.Status)
 			if sub.EmbedSrc != "" {
-				fmt.Printf("    Sign URL: %s\n", sub.EmbedSrc)
+				fmt.Fprintf(cli.stdout, "    Sign URL: %s\n", sub.EmbedSrc)
 			}
 		}
 	})
@@ -1,46 +1,95 @@
 package cmd
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"os"
+	"strconv"
 
 	"github.com/docuseal/docuseal-cli/internal/api"
 	"github.com/docuseal/docuseal-cli/internal/outfmt"
+	"github.com/docuseal/docuseal-cli/internal/ui"
 	"github.com/docuseal/docuseal-cli/internal/validation"
 	"github.com/spf13/cobra"
 )
 
-var submittersCmd = &cobra.Command{
-	Use:     "submitters",
-	Aliases: []string{"submitter", "signers", "signer"},
-	Short:   "Manage submitters",
-	Long:    `List, view, and update submission submitters.`,
-}
+// submittersState holds the submitters command state of a CLI.
+type submittersState struct {
+	submittersCmd       *cobra.Command
+	submittersListCmd   *cobra.Command
+	submittersGetCmd    *cobra.Command
+	submittersUpdateCmd *cobra.Command
 
-var submittersListCmd = &cobra.Command{
-	Use:   "list",
-	Short: "List submitters",
-	Long:  `List submitters with optional filtering by submission.`,
-	RunE:  runSubmittersList,
+	// Flags
+	submittersLimit             int
+	submittersSubmissionID      int
+	submittersEmail             string
+	submittersName              string
+	submittersPhone             string
+	submittersCompleted         bool
+	submittersSendEmail         bool
+	submittersSendSMS           bool
+	submittersValues            string
+	submittersExternalID        string
+	submittersReplyTo           string
+	submittersMetadata          string
+	submittersCompletedRedirect string
+	submittersRequirePhone2FA   bool
+	submittersFields            string
+	submittersMessageSubject    string
+	submittersMessageBody       string
 }
 
-var submittersGetCmd = &cobra.Command{
-	Use:   "get <id>",
-	Short: "Get submitter details",
-	Long:  `Retrieve detailed information about a specific submitter.`,
-	Args:  cobra.ExactArgs(1),
-	RunE:  runSubmittersGet,
+// submitterColumns are the text-mode columns of 'submitters list'; hidden ones are shown with --columns.
+var submitterColumns = []ui.Column{
+	{Name: "id", Header: "ID", Fixed: true},
+	{Name: "email", Header: "EMAIL"},
+	{Name: "role", Header: "ROLE"},
+	{Name: "status", Header: "STATUS", Fixed: true, Status: true},
+	{Name: "submission", Header: "SUBMISSION", Fixed: true},
+	{Name: "name", Header: "NAME", Hidden: true},
+	{Name: "sent", Header: "SENT", Fixed: true, Hidden: true},
+	{Name: "opened", Header: "OPENED", Fixed: true, Hidden: true},
+	{Name: "completed", Header: "COMPLETED", Fixed: true, Hidden: true},
+	{Name: "created", Header: "CREATED", Fixed: true, Hidden: true},
 }
 
-var submittersUpdateCmd = &cobra.Command{
-	Use:   "update <id>",
-	Short: "Update submitter",
-	Long: `Update a submitter's details or mark as completed.
+func (cli *CLI) initSubmitters() {
+	cli.submittersCmd = &cobra.Command{
+		Use:     "submitters",
+		Aliases: []string{"submitter", "signers", "signer"},
+		Short:   "Manage submitters",
+		Long:    `List, view, and update submission submitters.`,
+	}
+
+	cli.submittersListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List submitters",
+		Long:  `List submitters with optional filtering by submission.`,
+		RunE:  cli.runSubmittersList,
+	}
+
+	cli.submittersGetCmd = &cobra.Command{
+		Use:   "get <id>",
+		Short: "Get submitter details",
+		Long: `Retrieve detailed information about a specific submitter.
+
+The submitter can be given as a numeric ID, a slug, a signing link (https://.../s/<slug>),
+ext:<external_id>, or email:<address>.`,
+		Example: `  docuseal submitters get 456
+  docuseal submitters get https://docuseal.com/s/pAMimKcyrLjqVt
+  docuseal submitters get email:alice@example.com
+  docuseal submitters get ext:EMP-1042`,
+		RunE: cli.runSubmittersGet,
+	}
+
+	cli.submittersUpdateCmd = &cobra.Command{
+		Use:   "update <id>",
+		Short: "Update submitter",
+		Long: `Update a submitter's details or mark as completed.
 
 Use --completed to programmatically complete signing on behalf of the submitter.
 This is useful for auto-signing workflows.`,
-	Example: `  # Update submitter email
+		Example: `  # Update submitter email
   docuseal submitters update 456 --email newemail@example.com
 
   # Mark as completed (auto-sign)
@@ -60,90 +109,87 @@ This is useful for auto-signing workflows.`,
 
   # Require phone 2FA and set redirect
   docuseal submitters update 456 --require-phone-2fa --completed-redirect-url https://example.com/thanks`,
-	Args: cobra.ExactArgs(1),
-	RunE: runSubmittersUpdate,
-}
+		RunE: cli.runSubmittersUpdate,
+	}
 
-// Flags
-var (
-	submittersLimit             int
-	submittersSubmissionID      int
-	submittersEmail             string
-	submittersName              string
-	submittersPhone             string
-	submittersCompleted         bool
-	submittersSendEmail         bool
-	submittersSendSMS           bool
-	submittersValues            string
-	submittersExternalID        string
-	submittersReplyTo           string
-	submittersMetadata          string
-	submittersCompletedRedirect string
-	submittersRequirePhone2FA   bool
-	submittersFields            string
-	submittersMessageSubject    string
-	submittersMessageBody       string
-)
+	cli.rootCmd.AddCommand(cli.submittersCmd)
+
+	cli.submittersCmd.AddCommand(cli.submittersListCmd)
+	cli.submittersCmd.AddCommand(cli.submittersGetCmd)
+	cli.submittersCmd.AddCommand(cli.submittersUpdateCmd)
 
-func init() {
-	rootCmd.AddCommand(submittersCmd)
+	markMutating(cli.submittersUpdateCmd)
 
-	submittersCmd.AddCommand(submittersListCmd)
-	submittersCmd.AddCommand(submittersGetCmd)
-	submittersCmd.AddCommand(submittersUpdateCmd)
+	cli.addStdinFlags(cli.submittersGetCmd)
+	cli.addStdinFlags(cli.submittersUpdateCmd)
 
 	// List flags
-	submittersListCmd.Flags().IntVar(&submittersLimit, "limit", 0, "Maximum number of submitters to return")
-	submittersListCmd.Flags().IntVar(&submittersSubmissionID, "submission-id", 0, "Filter by submission ID")
+	cli.submittersListCmd.Flags().IntVar(&cli.submittersLimit, "limit", 0, "Maximum number of submitters to return")
+	cli.addTableFlags(cli.submittersListCmd, submitterColumns)
+	cli.addWhereFlag(cli.submittersListCmd)
+	cli.submittersListCmd.Flags().IntVar(&cli.submittersSubmissionID, "submission-id", 0, "Filter by submission ID")
 
 	// Update flags
-	submittersUpdateCmd.Flags().StringVar(&submittersEmail, "email", "", "New email address")
-	submittersUpdateCmd.Flags().StringVar(&submittersName, "name", "", "New name")
-	submittersUpdateCmd.Flags().StringVar(&submittersPhone, "phone", "", "New phone number")
-	submittersUpdateCmd.Flags().BoolVar(&submittersCompleted, "completed", false, "Mark as completed (auto-sign)")
-	submittersUpdateCmd.Flags().BoolVar(&submittersSendEmail, "send-email", false, "Send notification email")
-	submittersUpdateCmd.Flags().BoolVar(&submittersSendSMS, "send-sms", false, "Send notification SMS")
-	submittersUpdateCmd.Flags().StringVar(&submittersValues, "values", "", "Pre-fill field values (JSON string, e.g., '{\"field_name\":\"value\"}')")
-	submittersUpdateCmd.Flags().StringVar(&submittersExternalID, "external-id", "", "App-specific identifier")
-	submittersUpdateCmd.Flags().StringVar(&submittersReplyTo, "reply-to", "", "Reply-To address for emails")
-	submittersUpdateCmd.Flags().StringVar(&submittersMetadata, "metadata", "", "Custom metadata (JSON string)")
-	submittersUpdateCmd.Flags().StringVar(&submittersCompletedRedirect, "completed-redirect-url", "", "Redirect URL after completion")
-	submittersUpdateCmd.Flags().BoolVar(&submittersRequirePhone2FA, "require-phone-2fa", false, "Require phone verification")
-	submittersUpdateCmd.Flags().StringVar(&submittersFields, "fields", "", "Field configurations (JSON string)")
-	submittersUpdateCmd.Flags().StringVar(&submittersMessageSubject, "message-subject", "", "Custom email subject")
-	submittersUpdateCmd.Flags().StringVar(&submittersMessageBody, "message-body", "", "Custom email body")
+	cli.submittersUpdateCmd.Flags().StringVar(&cli.submittersEmail, "email", "", "New email address")
+	cli.submittersUpdateCmd.Flags().StringVar(&cli.submittersName, "name", "", "New name")
+	cli.submittersUpdateCmd.Flags().StringVar(&cli.submittersPhone, "phone", "", "New phone number")
+	cli.submittersUpdateCmd.Flags().BoolVar(&cli.submittersCompleted, "completed", false, "Mark as completed (auto-sign)")
+	cli.submittersUpdateCmd.Flags().BoolVar(&cli.submittersSendEmail, "send-email", false, "Send notification email")
+	cli.submittersUpdateCmd.Flags().BoolVar(&cli.submittersSendSMS, "send-sms", false, "Send notification SMS")
+	cli.submittersUpdateCmd.Flags().StringVar(&cli.submittersValues, "values", "", "Pre-fill field values (JSON string, e.g., '{\"field_name\":\"value\"}', or @file.json / @file.yaml)")
+	cli.submittersUpdateCmd.Flags().StringVar(&cli.submittersExternalID, "external-id", "", "App-specific identifier")
+	cli.submittersUpdateCmd.Flags().StringVar(&cli.submittersReplyTo, "reply-to", "", "Reply-To address for emails")
+	cli.submittersUpdateCmd.Flags().StringVar(&cli.submittersMetadata, "metadata", "", "Custom metadata (JSON string, or @file.json / @file.yaml)")
+	cli.submittersUpdateCmd.Flags().StringVar(&cli.submittersCompletedRedirect, "completed-redirect-url", "", "Redirect URL after completion")
+	cli.submittersUpdateCmd.Flags().BoolVar(&cli.submittersRequirePhone2FA, "require-phone-2fa", false, "Require phone verification")
+	cli.submittersUpdateCmd.Flags().StringVar(&cli.submittersFields, "fields", "", "Field configurations (JSON array string, or @file.json / @file.yaml)")
+	cli.submittersUpdateCmd.Flags().StringVar(&cli.submittersMessageSubject, "message-subject", "", "Custom email subject")
+	cli.submittersUpdateCmd.Flags().StringVar(&cli.submittersMessageBody, "message-body", "", "Custom email body")
+
+	// Shell completion
+	for _, c := range []*cobra.Command{cli.submittersGetCmd, cli.submittersUpdateCmd} {
+		c.ValidArgsFunction = cli.idCompletion("completion-submitters", false)
+		cli.addPicker(c, "completion-submitters")
+	}
+	mustRegisterFlagCompletion(cli.submittersListCmd, "submission-id", cli.idFlagCompletion("completion-submissions"))
 }
 
-func runSubmittersList(cmd *cobra.Command, args []string) error {
-	client, err := getClient()
+func (cli *CLI) runSubmittersList(cmd *cobra.Command, args []string) error {
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
-	limit := submittersLimit
+	limit := cli.submittersLimit
 	reqLimit := limit
-	if ((mode == outfmt.JSON && !bareJSON) || (mode == outfmt.NDJSON && withMeta)) && limit > 0 {
+	if ((mode == outfmt.JSON && !cli.bareJSON) || (mode == outfmt.NDJSON && cli.withMeta)) && limit > 0 {
 		reqLimit = limit + 1
 	}
 
-	submitters, err := client.ListSubmitters(cmd.Context(), reqLimit, submittersSubmissionID)
+	submitters, err := listFiltered(cmd.Context(), cli, reqLimit, 0, 0,
+		func(s api.Submitter) int { return s.ID },
+		func(limit, after, before int) ([]api.Submitter, error) {
+			return client.ListSubmitters(cmd.Context(), limit, cli.submittersSubmissionID, "", "", "", after, before)
+		},
+	)
 	if err != nil {
 		return fmt.Errorf("failed to list submitters: %w", err)
 	}
 
-	if (mode == outfmt.JSON && !bareJSON) || (mode == outfmt.NDJSON && withMeta) {
+	if (mode == outfmt.JSON && !cli.bareJSON) || (mode == outfmt.NDJSON && cli.withMeta) {
 		out := submitters
 		hasMore := false
 		if limit > 0 && len(out) > limit {
 			hasMore = true
 			out = out[:limit]
 		}
+		sortItems(cli.sortFlag, out)
 
-		if mode == outfmt.JSON && !bareJSON {
+		if mode == outfmt.JSON && !cli.bareJSON {
 			env := makeListEnvelope(out, len(out), limit, 0, 0, hasMore, 0, 0)
-			env["submission_id"] = submittersSubmissionID
-			outputResult(mode, env, func() {})
+			env["submission_id"] = cli.submittersSubmissionID
+			cli.outputResult(mode, env, func() {})
 			return nil
 		}
 
@@ -152,7 +198,7 @@ func runSubmittersList(cmd *cobra.Command, args []string) error {
 				"count":         len(out),
 				"limit":         limit,
 				"has_more":      hasMore,
-				"submission_id": submittersSubmissionID,
+				"submission_id": cli.submittersSubmissionID,
 			},
 		}
 		stream := make([]any, 0, len(out)+1)
@@ -160,92 +206,110 @@ func runSubmittersList(cmd *cobra.Command, args []string) error {
 			stream = append(stream, s)
 		}
 		stream = append(stream, meta)
-		outputResult(mode, stream, func() {})
+		cli.outputResult(mode, stream, func() {})
 		return nil
 	}
 
-	outputResult(mode, submitters, func() {
+	sortItems(cli.sortFlag, submitters)
+
+	cli.outputResult(mode, submitters, func() {
 		if len(submitters) == 0 {
-			fmt.Println("No submitters found")
+			fmt.Fprintln(cli.stdout, "No submitters found")
 			return
 		}
-		w := newTabWriter()
-		if _, err := fmt.Fprintln(w, "ID\tEMAIL\tROLE\tSTATUS\tSUBMISSION"); err != nil {
-			fmt.Fprintf(os.Stderr, "Error writing output: %v\n", err)
-		}
+		rows := make([][]string, 0, len(submitters))
 		for _, s := range submitters {
-			if _, err := fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%d\n",
-				s.ID,
-				truncateString(s.Email, 30),
+			rows = append(rows, []string{
+				strconv.Itoa(s.ID),
+				s.Email,
 				s.Role,
 				s.Status,
-				s.SubmissionID,
-			); err != nil {
-				fmt.Fprintf(os.Stderr, "Error writing output: %v\n", err)
-			}
-		}
-		if err := w.Flush(); err != nil {
-			fmt.Fprintf(os.Stderr, "Error flushing output: %v\n", err)
+				strconv.Itoa(s.SubmissionID),
+				s.Name,
+				formatTimePtr(s.SentAt),
+				formatTimePtr(s.OpenedAt),
+				formatTimePtr(s.CompletedAt),
+				formatTime(s.CreatedAt),
+			})
 		}
+		cli.renderTable(submitterColumns, rows)
 
 		// Pagination hint
-		if submittersLimit > 0 && len(submitters) == submittersLimit {
-			fmt.Fprintf(os.Stderr, "\n# More results may be available. Use --limit with higher value.\n")
+		if cli.submittersLimit > 0 && len(submitters) == cli.submittersLimit {
+			cli.getLogger().Info("More results may be available; use a higher --limit", "limit", cli.submittersLimit)
 		}
 	})
 
 	return nil
 }
 
-func runSubmittersGet(cmd *cobra.Command, args []string) error {
-	id, err := parseIDArg(args[0])
+func (cli *CLI) runSubmittersGet(cmd *cobra.Command, args []string) error {
+	if cli.stdinIDs {
+		client, err := cli.getClient()
+		if err != nil {
+			return err
+		}
+		return cli.runStdinIDs(cmd, submitterIDKeys, func(ctx context.Context, ident string) (any, string, error) {
+			id, err := resolveSubmitterID(ctx, client, ident)
+			if err != nil {
+				return nil, "", err
+			}
+			submitter, err := client.GetSubmitter(ctx, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("failed to get submitter: %w", err)
+			}
+			return submitter, fmt.Sprintf("%d\t%s\t%s\t%s", submitter.ID, submitter.Email, submitter.Role, submitter.Status), nil
+		})
+	}
+
+	client, err := cli.getClient()
 	if err != nil {
-		return fmt.Errorf("invalid submitter ID: %w", err)
+		return err
 	}
+	mode := cli.getOutputMode()
 
-	client, err := getClient()
+	id, err := resolveSubmitterID(cmd.Context(), client, args[0])
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
 
 	submitter, err := client.GetSubmitter(cmd.Context(), id)
 	if err != nil {
 		return fmt.Errorf("failed to get submitter: %w", err)
 	}
 
-	outputResult(mode, submitter, func() {
-		fmt.Printf("ID: %d\n", submitter.ID)
-		fmt.Printf("Email: %s\n", submitter.Email)
+	cli.outputResult(mode, submitter, func() {
+		fmt.Fprintf(cli.stdout, "ID: %d\n", submitter.ID)
+		fmt.Fprintf(cli.stdout, "Email: %s\n", submitter.Email)
 		if submitter.Name != "" {
-			fmt.Printf("Name: %s\n", submitter.Name)
+			fmt.Fprintf(cli.stdout, "Name: %s\n", submitter.Name)
 		}
 		if submitter.Phone != "" {
-			fmt.Printf("Phone: %s\n", submitter.Phone)
+			fmt.Fprintf(cli.stdout, "Phone: %s\n", submitter.Phone)
 		}
-		fmt.Printf("Role: %s\n", submitter.Role)
-		fmt.Printf("Status: %s\n", submitter.Status)
-		fmt.Printf("Submission ID: %d\n", submitter.SubmissionID)
-		fmt.Printf("Created: %s\n", formatTime(submitter.CreatedAt))
+		fmt.Fprintf(cli.stdout, "Role: %s\n", submitter.Role)
+		fmt.Fprintf(cli.stdout, "Status: %s\n", submitter.Status)
+		fmt.Fprintf(cli.stdout, "Submission ID: %d\n", submitter.SubmissionID)
+		fmt.Fprintf(cli.stdout, "Created: %s\n", formatTime(submitter.CreatedAt))
 		if submitter.SentAt != nil {
-			fmt.Printf("Sent: %s\n", formatTimePtr(submitter.SentAt))
+			fmt.Fprintf(cli.stdout, "Sent: %s\n", formatTimePtr(submitter.SentAt))
 		}
 		if submitter.OpenedAt != nil {
-			fmt.Printf("Opened: %s\n", formatTimePtr(submitter.OpenedAt))
+			fmt.Fprintf(cli.stdout, "Opened: %s\n", formatTimePtr(submitter.OpenedAt))
 		}
 		if submitter.CompletedAt != nil {
-			fmt.Printf("Completed: %s\n", formatTimePtr(submitter.CompletedAt))
+			fmt.Fprintf(cli.stdout, "Completed: %s\n", formatTimePtr(submitter.CompletedAt))
 		}
 		if submitter.DeclinedAt != nil {
-			fmt.Printf("Declined: %s\n", formatTimePtr(submitter.DeclinedAt))
+			fmt.Fprintf(cli.stdout, "Declined: %s\n", formatTimePtr(submitter.DeclinedAt))
 		}
 		if submitter.EmbedSrc != "" {
-			fmt.Printf("Sign URL: %s\n", submitter.EmbedSrc)
+			fmt.Fprintf(cli.stdout, "Sign URL: %s\n", submitter.EmbedSrc)
 		}
 		if len(submitter.Values) > 0 {
-			fmt.Println("Values:")
+			fmt.Fprintln(cli.stdout, "Values:")
 			for _, v := range submitter.Values {
-				fmt.Printf("  %s: %v\n", v.Field, v.Value)
+				fmt.Fprintf(cli.stdout, "  %s: %v\n", v.Field, v.Value)
 			}
 		}
 	})
@@ -253,87 +317,101 @@ func runSubmittersGet(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func runSubmittersUpdate(cmd *cobra.Command, args []string) error {
-	id, err := parseIDArg(args[0])
-	if err != nil {
-		return fmt.Errorf("invalid submitter ID: %w", err)
-	}
-
+func (cli *CLI) runSubmittersUpdate(cmd *cobra.Command, args []string) error {
 	// Validate email addresses if provided
-	if submittersEmail != "" {
-		if err := validation.ValidateEmail(submittersEmail); err != nil {
+	if cli.submittersEmail != "" {
+		if err := validation.ValidateEmail(cli.submittersEmail); err != nil {
 			return fmt.Errorf("invalid email: %w", err)
 		}
 	}
 
-	if submittersReplyTo != "" {
-		if err := validation.ValidateEmail(submittersReplyTo); err != nil {
+	if cli.submittersReplyTo != "" {
+		if err := validation.ValidateEmail(cli.submittersReplyTo); err != nil {
 			return fmt.Errorf("invalid reply-to email: %w", err)
 		}
 	}
 
-	client, err := getClient()
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
 	req := &api.UpdateSubmitterRequest{
-		Email:                submittersEmail,
-		Name:                 submittersName,
-		Phone:                submittersPhone,
-		Completed:            submittersCompleted,
-		SendEmail:            submittersSendEmail,
-		SendSMS:              submittersSendSMS,
-		ExternalID:           submittersExternalID,
-		ReplyTo:              submittersReplyTo,
-		CompletedRedirectURL: submittersCompletedRedirect,
-		RequirePhone2FA:      submittersRequirePhone2FA,
+		Email:                cli.submittersEmail,
+		Name:                 cli.submittersName,
+		Phone:                cli.submittersPhone,
+		Completed:            cli.submittersCompleted,
+		SendEmail:            cli.submittersSendEmail,
+		SendSMS:              cli.submittersSendSMS,
+		ExternalID:           cli.submittersExternalID,
+		ReplyTo:              cli.submittersReplyTo,
+		CompletedRedirectURL: cli.submittersCompletedRedirect,
+		RequirePhone2FA:      cli.submittersRequirePhone2FA,
 	}
 
-	// Parse JSON fields
-	if submittersValues != "" {
+	// Parse JSON fields (inline, or @file.json / @file.yaml)
+	if cli.submittersValues != "" {
 		var values map[string]any
-		if err := json.Unmarshal([]byte(submittersValues), &values); err != nil {
-			return fmt.Errorf("invalid values JSON: %w", err)
+		if err := parseJSONFlag(cli.submittersValues, &values); err != nil {
+			return fmt.Errorf("invalid values: %w", err)
 		}
 		req.Values = values
 	}
 
-	if submittersMetadata != "" {
+	if cli.submittersMetadata != "" {
 		var metadata map[string]any
-		if err := json.Unmarshal([]byte(submittersMetadata), &metadata); err != nil {
-			return fmt.Errorf("invalid metadata JSON: %w", err)
+		if err := parseJSONFlag(cli.submittersMetadata, &metadata); err != nil {
+			return fmt.Errorf("invalid metadata: %w", err)
 		}
 		req.Metadata = metadata
 	}
 
-	if submittersFields != "" {
+	if cli.submittersFields != "" {
 		var fields []api.FieldConfig
-		if err := json.Unmarshal([]byte(submittersFields), &fields); err != nil {
-			return fmt.Errorf("invalid fields JSON: %w", err)
+		if err := parseJSONFlag(cli.submittersFields, &fields); err != nil {
+			return fmt.Errorf("invalid fields: %w", err)
 		}
 		req.Fields = fields
 	}
 
 	// Handle custom message
-	if submittersMessageSubject != "" || submittersMessageBody != "" {
+	if cli.submittersMessageSubject != "" || cli.submittersMessageBody != "" {
 		req.Message = &api.Message{
-			Subject: submittersMessageSubject,
-			Body:    submittersMessageBody,
+			Subject: cli.submittersMessageSubject,
+			Body:    cli.submittersMessageBody,
 		}
 	}
 
+	if cli.stdinIDs {
+		return cli.runStdinIDs(cmd, submitterIDKeys, func(ctx context.Context, ident string) (any, string, error) {
+			id, err := resolveSubmitterID(ctx, client, ident)
+			if err != nil {
+				return nil, "", err
+			}
+			submitter, err := client.UpdateSubmitter(ctx, id, req)
+			if err != nil {
+				return nil, "", fmt.Errorf("failed to update submitter: %w", err)
+			}
+			return submitter, fmt.Sprintf("Updated submitter %d (%s)", submitter.ID, submitter.Status), nil
+		})
+	}
+
+	id, err := resolveSubmitterID(cmd.Context(), client, args[0])
+	if err != nil {
+		return err
+	}
+
 	submitter, err := client.UpdateSubmitter(cmd.Context(), id, req)
 	if err != nil {
 		return fmt.Errorf("failed to update submitter: %w", err)
 	}
 
-	outputResult(mode, submitter, func() {
-		fmt.Printf("Updated submitter %d\n", submitter.ID)
-		fmt.Printf("Status: %s\n", submitter.Status)
-		if submittersCompleted {
-			fmt.Println("Marked as completed")
+	cli.outputResult(mode, submitter, func() {
+		fmt.Fprintf(cli.stdout, "Updated submitter %d\n", submitter.ID)
+		fmt.Fprintf(cli.stdout, "Status: %s\n", submitter.Status)
+		if cli.submittersCompleted {
+			fmt.Fprintln(cli.stdout, "Marked as completed")
 		}
 	})
 
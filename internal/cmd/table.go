@@ -0,0 +1,173 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+	"github.com/docuseal/docuseal-cli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// tableState holds the --columns and --sort flags of a CLI.
+type tableState struct {
+	columnsFlag string
+	sortFlag    string
+}
+
+// addTableFlags adds --columns and --sort to a list command rendered with columns.
+// Both are validated before any request is made.
+func (cli *CLI) addTableFlags(cmd *cobra.Command, columns []ui.Column) {
+	cmd.Flags().StringVar(&cli.columnsFlag, "columns", "", fmt.Sprintf("Table columns to show in text output, comma-separated (available: %s)", strings.Join(ui.ColumnNames(columns), ", ")))
+	cmd.Flags().StringVar(&cli.sortFlag, "sort", "", "Sort results by JSON fields, comma-separated; prefix with - for descending (e.g. -created_at)")
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		if err := ui.ValidateColumns(columns, cli.columnsFlag); err != nil {
+			return &api.ValidationError{Field: "columns", Message: err.Error()}
+		}
+		if _, err := parseSortSpec(cli.sortFlag); err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// renderTable prints rows as a table using --columns and the terminal width.
+func (cli *CLI) renderTable(columns []ui.Column, rows [][]string) {
+	t := cli.getUI().NewTable(columns)
+	if err := t.SetColumns(cli.columnsFlag); err != nil {
+		fmt.Fprintf(cli.stderr, "Error writing output: %v\n", err)
+		return
+	}
+	for _, row := range rows {
+		t.AddRow(row...)
+	}
+	if err := t.Render(cli.stdout); err != nil {
+		fmt.Fprintf(cli.stderr, "Error writing output: %v\n", err)
+	}
+}
+
+type sortKey struct {
+	path []string
+	desc bool
+}
+
+func parseSortSpec(spec string) ([]sortKey, error) {
+	var keys []sortKey
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		k := sortKey{}
+		switch part[0] {
+		case '-':
+			k.desc = true
+			part = part[1:]
+		case '+':
+			part = part[1:]
+		}
+		if part == "" {
+			return nil, &api.ValidationError{Field: "sort", Message: "empty field name"}
+		}
+		k.path = strings.Split(part, ".")
+		keys = append(keys, k)
+	}
+	return keys, nil
+}
+
+// sortItems orders items in place by spec, the value of --sort. Fields are JSON names (dot
+// paths for nested values); missing values sort last regardless of direction.
+func sortItems[T any](spec string, items []T) {
+	keys, err := parseSortSpec(spec)
+	if err != nil || len(keys) == 0 || len(items) < 2 {
+		return
+	}
+
+	values := make([][]any, len(items))
+	for i, item := range items {
+		var doc any
+		if data, err := json.Marshal(item); err == nil {
+			_ = json.Unmarshal(data, &doc)
+		}
+		values[i] = make([]any, len(keys))
+		for k, key := range keys {
+			values[i][k] = lookupPath(doc, key.path)
+		}
+	}
+
+	order := make([]int, len(items))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		va, vb := values[order[a]], values[order[b]]
+		for k, key := range keys {
+			c := compareSortValues(va[k], vb[k], key.desc)
+			if c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
+
+	sorted := make([]T, len(items))
+	for i, idx := range order {
+		sorted[i] = items[idx]
+	}
+	copy(items, sorted)
+}
+
+func lookupPath(v any, path []string) any {
+	for _, p := range path {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		v = m[p]
+	}
+	return v
+}
+
+// compareSortValues orders two JSON values; nil always sorts after non-nil values.
+func compareSortValues(a, b any, desc bool) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+
+	c := 0
+	switch av := a.(type) {
+	case float64:
+		if bv, ok := b.(float64); ok {
+			switch {
+			case av < bv:
+				c = -1
+			case av > bv:
+				c = 1
+			}
+		}
+	case string:
+		if bv, ok := b.(string); ok {
+			c = strings.Compare(strings.ToLower(av), strings.ToLower(bv))
+		}
+	case bool:
+		if bv, ok := b.(bool); ok && av != bv {
+			c = 1
+			if !av {
+				c = -1
+			}
+		}
+	default:
+		c = strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+	}
+	if desc {
+		return -c
+	}
+	return c
+}
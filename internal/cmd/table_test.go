@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSortItems(t *testing.T) {
+	type item struct {
+		ID        int            `json:"id"`
+		Name      string         `json:"name"`
+		CreatedAt time.Time      `json:"created_at"`
+		Metadata  map[string]any `json:"metadata,omitempty"`
+	}
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	items := []item{
+		{ID: 1, Name: "beta", CreatedAt: base.Add(2 * time.Hour), Metadata: map[string]any{"rank": 2}},
+		{ID: 2, Name: "Alpha", CreatedAt: base.Add(1 * time.Hour)},
+		{ID: 3, Name: "alpha", CreatedAt: base.Add(3 * time.Hour), Metadata: map[string]any{"rank": 1}},
+	}
+
+	tests := []struct {
+		spec string
+		want []int
+	}{
+		{"-created_at", []int{3, 1, 2}},
+		{"name,-id", []int{3, 2, 1}},
+		{"metadata.rank", []int{3, 1, 2}},
+		{"-metadata.rank", []int{1, 3, 2}},
+		{"", []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			got := append([]item(nil), items...)
+			sortItems(tt.spec, got)
+			var ids []int
+			for _, it := range got {
+				ids = append(ids, it.ID)
+			}
+			if !reflect.DeepEqual(ids, tt.want) {
+				t.Errorf("sortItems(%q) = %v, want %v", tt.spec, ids, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSortSpecInvalid(t *testing.T) {
+	if _, err := parseSortSpec("id,-"); err == nil {
+		t.Error("parseSortSpec() expected error for empty field")
+	}
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"os"
@@ -9,21 +10,72 @@ import (
 
 	"github.com/docuseal/docuseal-cli/internal/api"
 	"github.com/docuseal/docuseal-cli/internal/outfmt"
+	"github.com/docuseal/docuseal-cli/internal/ui"
 	"github.com/spf13/cobra"
 )
 
-var templatesCmd = &cobra.Command{
-	Use:     "templates",
-	Aliases: []string{"template", "tpl", "t"},
-	Short:   "Manage templates",
-	Long:    `List, create, update, and manage DocuSeal templates.`,
+// templatesState holds the templates command state of a CLI.
+type templatesState struct {
+	templatesCmd                *cobra.Command
+	templatesListCmd            *cobra.Command
+	templatesGetCmd             *cobra.Command
+	templatesCreatePDFCmd       *cobra.Command
+	templatesCreateDOCXCmd      *cobra.Command
+	templatesCreateHTMLCmd      *cobra.Command
+	templatesCloneCmd           *cobra.Command
+	templatesMergeCmd           *cobra.Command
+	templatesUpdateCmd          *cobra.Command
+	templatesArchiveCmd         *cobra.Command
+	templatesUpdateDocumentsCmd *cobra.Command
+
+	// Flags
+	templatesLimit       int
+	templatesAfter       int
+	templatesBefore      int
+	templatesFolder      string
+	templatesArchived    bool
+	templatesName        string
+	templatesFile        string
+	templatesHTML        string
+	templatesIDs         string
+	templatesExternalID  string
+	templatesSharedLink  string
+	templatesHTMLHeader  string
+	templatesHTMLFooter  string
+	templatesSize        string
+	templatesDocFiles    []string
+	templatesDocHTML     string
+	templatesDocName     string
+	templatesDocPosition int
+	templatesDocReplace  bool
+	templatesDocRemove   bool
+	templatesDocMerge    bool
 }
 
-var templatesListCmd = &cobra.Command{
-	Use:   "list",
-	Short: "List templates",
-	Long:  `List templates with optional filtering by folder or archived status.`,
-	Example: `  # List all templates
+// templateColumns are the text-mode columns of 'templates list'; hidden ones are shown with --columns.
+var templateColumns = []ui.Column{
+	{Name: "id", Header: "ID", Fixed: true},
+	{Name: "name", Header: "NAME"},
+	{Name: "folder", Header: "FOLDER"},
+	{Name: "created", Header: "CREATED", Fixed: true},
+	{Name: "slug", Header: "SLUG", Hidden: true},
+	{Name: "updated", Header: "UPDATED", Fixed: true, Hidden: true},
+	{Name: "external_id", Header: "EXTERNAL_ID", Hidden: true},
+}
+
+func (cli *CLI) initTemplates() {
+	cli.templatesCmd = &cobra.Command{
+		Use:     "templates",
+		Aliases: []string{"template", "tpl", "t"},
+		Short:   "Manage templates",
+		Long:    `List, create, update, and manage DocuSeal templates.`,
+	}
+
+	cli.templatesListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List templates",
+		Long:  `List templates with optional filtering by folder or archived status.`,
+		Example: `  # List all templates
   docuseal templates list
 
   # List templates in a specific folder
@@ -31,82 +83,83 @@ var templatesListCmd = &cobra.Command{
 
   # List with JSON output
   docuseal templates list -o json`,
-	RunE: runTemplatesList,
-}
+		RunE: cli.runTemplatesList,
+	}
 
-var templatesGetCmd = &cobra.Command{
-	Use:   "get <id>",
-	Short: "Get template details",
-	Long:  `Retrieve detailed information about a specific template.`,
-	Args:  cobra.ExactArgs(1),
-	RunE:  runTemplatesGet,
-}
+	cli.templatesGetCmd = &cobra.Command{
+		Use:   "get <id>",
+		Short: "Get template details",
+		Long: `Retrieve detailed information about a specific template.
+
+The template can be given as a numeric ID, a slug, a name, ext:<external_id>, or a URL:
+template URLs and shared document links (https://.../d/<slug>). Submission URLs and signing
+links are refused: they name a submission, not a template.`,
+		RunE: cli.runTemplatesGet,
+	}
 
-var templatesCreatePDFCmd = &cobra.Command{
-	Use:   "create-pdf",
-	Short: "Create template from PDF",
-	Long:  `Create a new template from a PDF file.`,
-	Example: `  # Create template from PDF
+	cli.templatesCreatePDFCmd = &cobra.Command{
+		Use:   "create-pdf",
+		Short: "Create template from PDF",
+		Long:  `Create a new template from a PDF file.`,
+		Example: `  # Create template from PDF
   docuseal templates create-pdf --name "Contract" --file contract.pdf
 
   # Create in a specific folder
   docuseal templates create-pdf --name "NDA" --file nda.pdf --folder "Legal"`,
-	RunE: runTemplatesCreatePDF,
-}
+		RunE: cli.runTemplatesCreatePDF,
+	}
 
-var templatesCreateDOCXCmd = &cobra.Command{
-	Use:   "create-docx",
-	Short: "Create template from DOCX",
-	Long:  `Create a new template from a DOCX file.`,
-	RunE:  runTemplatesCreateDOCX,
-}
+	cli.templatesCreateDOCXCmd = &cobra.Command{
+		Use:   "create-docx",
+		Short: "Create template from DOCX",
+		Long:  `Create a new template from a DOCX file.`,
+		RunE:  cli.runTemplatesCreateDOCX,
+	}
 
-var templatesCreateHTMLCmd = &cobra.Command{
-	Use:   "create-html",
-	Short: "Create template from HTML",
-	Long:  `Create a new template from HTML content.`,
-	RunE:  runTemplatesCreateHTML,
-}
+	cli.templatesCreateHTMLCmd = &cobra.Command{
+		Use:   "create-html",
+		Short: "Create template from HTML",
+		Long:  `Create a new template from HTML content.`,
+		RunE:  cli.runTemplatesCreateHTML,
+	}
 
-var templatesCloneCmd = &cobra.Command{
-	Use:   "clone <id>",
-	Short: "Clone a template",
-	Long:  `Create a copy of an existing template.`,
-	Args:  cobra.ExactArgs(1),
-	RunE:  runTemplatesClone,
-}
+	cli.templatesCloneCmd = &cobra.Command{
+		Use:   "clone <id>",
+		Short: "Clone a template",
+		Long:  `Create a copy of an existing template.`,
+		RunE:  cli.runTemplatesClone,
+	}
 
-var templatesMergeCmd = &cobra.Command{
-	Use:   "merge",
-	Short: "Merge templates",
-	Long:  `Merge multiple templates into a single template.`,
-	RunE:  runTemplatesMerge,
-}
+	cli.templatesMergeCmd = &cobra.Command{
+		Use:   "merge",
+		Short: "Merge templates",
+		Long:  `Merge multiple templates into a single template.`,
+		RunE:  cli.runTemplatesMerge,
+	}
 
-var templatesUpdateCmd = &cobra.Command{
-	Use:   "update <id>",
-	Short: "Update template",
-	Long:  `Update a template's name or folder.`,
-	Args:  cobra.ExactArgs(1),
-	RunE:  runTemplatesUpdate,
-}
+	cli.templatesUpdateCmd = &cobra.Command{
+		Use:   "update <id>",
+		Short: "Update template",
+		Long:  `Update a template's name or folder.`,
+		Args:  cobra.ExactArgs(1),
+		RunE:  cli.runTemplatesUpdate,
+	}
 
-var templatesArchiveCmd = &cobra.Command{
-	Use:   "archive <id>",
-	Short: "Archive template",
-	Long:  `Archive a template (soft delete).`,
-	Args:  cobra.ExactArgs(1),
-	RunE:  runTemplatesArchive,
-}
+	cli.templatesArchiveCmd = &cobra.Command{
+		Use:   "archive <id>",
+		Short: "Archive template",
+		Long:  `Archive a template (soft delete).`,
+		RunE:  cli.runTemplatesArchive,
+	}
 
-var templatesUpdateDocumentsCmd = &cobra.Command{
-	Use:   "update-documents <id>",
-	Short: "Update template documents",
-	Long: `Add, replace, or remove documents from an existing template.
+	cli.templatesUpdateDocumentsCmd = &cobra.Command{
+		Use:   "update-documents <id>",
+		Short: "Update template documents",
+		Long: `Add, replace, or remove documents from an existing template.
 
 When using multiple --file flags, documents are added sequentially and --position/--replace flags are ignored.
 The --position and --replace flags only apply when working with a single file or HTML document.`,
-	Example: `  # Add a new PDF document to template
+		Example: `  # Add a new PDF document to template
   docuseal templates update-documents 123 --file contract.pdf
 
   # Replace document at position 0
@@ -120,131 +173,128 @@ The --position and --replace flags only apply when working with a single file or
 
   # Merge multiple documents
   docuseal templates update-documents 123 --file doc1.pdf --file doc2.pdf --merge`,
-	Args: cobra.ExactArgs(1),
-	RunE: runTemplatesUpdateDocuments,
-}
+		Args: cobra.ExactArgs(1),
+		RunE: cli.runTemplatesUpdateDocuments,
+	}
 
-// Flags
-var (
-	templatesLimit       int
-	templatesAfter       int
-	templatesBefore      int
-	templatesFolder      string
-	templatesArchived    bool
-	templatesName        string
-	templatesFile        string
-	templatesHTML        string
-	templatesIDs         string
-	templatesExternalID  string
-	templatesSharedLink  string
-	templatesHTMLHeader  string
-	templatesHTMLFooter  string
-	templatesSize        string
-	templatesDocFiles    []string
-	templatesDocHTML     string
-	templatesDocName     string
-	templatesDocPosition int
-	templatesDocReplace  bool
-	templatesDocRemove   bool
-	templatesDocMerge    bool
-)
+	cli.rootCmd.AddCommand(cli.templatesCmd)
 
-func init() {
-	rootCmd.AddCommand(templatesCmd)
+	cli.templatesCmd.AddCommand(cli.templatesListCmd)
+	cli.templatesCmd.AddCommand(cli.templatesGetCmd)
+	cli.templatesCmd.AddCommand(cli.templatesCreatePDFCmd)
+	cli.templatesCmd.AddCommand(cli.templatesCreateDOCXCmd)
+	cli.templatesCmd.AddCommand(cli.templatesCreateHTMLCmd)
+	cli.templatesCmd.AddCommand(cli.templatesCloneCmd)
+	cli.templatesCmd.AddCommand(cli.templatesMergeCmd)
+	cli.templatesCmd.AddCommand(cli.templatesUpdateCmd)
+	cli.templatesCmd.AddCommand(cli.templatesArchiveCmd)
+	cli.templatesCmd.AddCommand(cli.templatesUpdateDocumentsCmd)
 
-	templatesCmd.AddCommand(templatesListCmd)
-	templatesCmd.AddCommand(templatesGetCmd)
-	templatesCmd.AddCommand(templatesCreatePDFCmd)
-	templatesCmd.AddCommand(templatesCreateDOCXCmd)
-	templatesCmd.AddCommand(templatesCreateHTMLCmd)
-	templatesCmd.AddCommand(templatesCloneCmd)
-	templatesCmd.AddCommand(templatesMergeCmd)
-	templatesCmd.AddCommand(templatesUpdateCmd)
-	templatesCmd.AddCommand(templatesArchiveCmd)
-	templatesCmd.AddCommand(templatesUpdateDocumentsCmd)
+	markMutating(cli.templatesCreatePDFCmd, cli.templatesCreateDOCXCmd, cli.templatesCreateHTMLCmd, cli.templatesCloneCmd, cli.templatesMergeCmd, cli.templatesUpdateCmd)
+	markDestructive(cli.templatesArchiveCmd, cli.templatesUpdateDocumentsCmd)
+
+	for _, c := range []*cobra.Command{cli.templatesGetCmd, cli.templatesCloneCmd, cli.templatesArchiveCmd} {
+		cli.addStdinFlags(c)
+	}
 
 	// List flags
-	templatesListCmd.Flags().IntVar(&templatesLimit, "limit", 0, "Maximum number of templates to return")
-	templatesListCmd.Flags().IntVar(&templatesAfter, "after", 0, "Pagination cursor, get IDs greater than value")
-	templatesListCmd.Flags().IntVar(&templatesBefore, "before", 0, "Pagination cursor, get IDs less than value")
-	templatesListCmd.Flags().StringVar(&templatesFolder, "folder", "", "Filter by folder name")
-	templatesListCmd.Flags().BoolVar(&templatesArchived, "archived", false, "Include archived templates")
+	cli.templatesListCmd.Flags().IntVar(&cli.templatesLimit, "limit", 0, "Maximum number of templates to return")
+	cli.addTableFlags(cli.templatesListCmd, templateColumns)
+	cli.addWhereFlag(cli.templatesListCmd)
+	cli.templatesListCmd.Flags().IntVar(&cli.templatesAfter, "after", 0, "Pagination cursor, get IDs greater than value")
+	cli.templatesListCmd.Flags().IntVar(&cli.templatesBefore, "before", 0, "Pagination cursor, get IDs less than value")
+	cli.templatesListCmd.Flags().StringVar(&cli.templatesFolder, "folder", "", "Filter by folder name")
+	cli.templatesListCmd.Flags().BoolVar(&cli.templatesArchived, "archived", false, "Include archived templates")
 
 	// Create PDF flags
-	templatesCreatePDFCmd.Flags().StringVar(&templatesName, "name", "", "Template name (required)")
-	templatesCreatePDFCmd.Flags().StringVar(&templatesFile, "file", "", "PDF file path (required)")
-	templatesCreatePDFCmd.Flags().StringVar(&templatesFolder, "folder", "", "Folder name")
-	templatesCreatePDFCmd.Flags().StringVar(&templatesExternalID, "external-id", "", "App-specific identifier")
-	templatesCreatePDFCmd.Flags().StringVar(&templatesSharedLink, "shared-link", "", "Enable/disable shared link (true/false, default: true)")
-	mustMarkFlagRequired(templatesCreatePDFCmd, "name")
-	mustMarkFlagRequired(templatesCreatePDFCmd, "file")
+	cli.templatesCreatePDFCmd.Flags().StringVar(&cli.templatesName, "name", "", "Template name (required)")
+	cli.templatesCreatePDFCmd.Flags().StringVar(&cli.templatesFile, "file", "", "PDF file path (required)")
+	cli.templatesCreatePDFCmd.Flags().StringVar(&cli.templatesFolder, "folder", "", "Folder name")
+	cli.templatesCreatePDFCmd.Flags().StringVar(&cli.templatesExternalID, "external-id", "", "App-specific identifier")
+	cli.templatesCreatePDFCmd.Flags().StringVar(&cli.templatesSharedLink, "shared-link", "", "Enable/disable shared link (true/false, default: true)")
+	mustMarkFlagRequired(cli.templatesCreatePDFCmd, "name")
+	mustMarkFlagRequired(cli.templatesCreatePDFCmd, "file")
 
 	// Create DOCX flags
-	templatesCreateDOCXCmd.Flags().StringVar(&templatesName, "name", "", "Template name (required)")
-	templatesCreateDOCXCmd.Flags().StringVar(&templatesFile, "file", "", "DOCX file path (required)")
-	templatesCreateDOCXCmd.Flags().StringVar(&templatesFolder, "folder", "", "Folder name")
-	templatesCreateDOCXCmd.Flags().StringVar(&templatesExternalID, "external-id", "", "App-specific identifier")
-	templatesCreateDOCXCmd.Flags().StringVar(&templatesSharedLink, "shared-link", "", "Enable/disable shared link (true/false, default: true)")
-	mustMarkFlagRequired(templatesCreateDOCXCmd, "name")
-	mustMarkFlagRequired(templatesCreateDOCXCmd, "file")
+	cli.templatesCreateDOCXCmd.Flags().StringVar(&cli.templatesName, "name", "", "Template name (required)")
+	cli.templatesCreateDOCXCmd.Flags().StringVar(&cli.templatesFile, "file", "", "DOCX file path (required)")
+	cli.templatesCreateDOCXCmd.Flags().StringVar(&cli.templatesFolder, "folder", "", "Folder name")
+	cli.templatesCreateDOCXCmd.Flags().StringVar(&cli.templatesExternalID, "external-id", "", "App-specific identifier")
+	cli.templatesCreateDOCXCmd.Flags().StringVar(&cli.templatesSharedLink, "shared-link", "", "Enable/disable shared link (true/false, default: true)")
+	mustMarkFlagRequired(cli.templatesCreateDOCXCmd, "name")
+	mustMarkFlagRequired(cli.templatesCreateDOCXCmd, "file")
 
 	// Create HTML flags
-	templatesCreateHTMLCmd.Flags().StringVar(&templatesName, "name", "", "Template name (required)")
-	templatesCreateHTMLCmd.Flags().StringVar(&templatesHTML, "html", "", "HTML content (required)")
-	templatesCreateHTMLCmd.Flags().StringVar(&templatesFolder, "folder", "", "Folder name")
-	templatesCreateHTMLCmd.Flags().StringVar(&templatesExternalID, "external-id", "", "App-specific identifier")
-	templatesCreateHTMLCmd.Flags().StringVar(&templatesSharedLink, "shared-link", "", "Enable/disable shared link (true/false, default: true)")
-	templatesCreateHTMLCmd.Flags().StringVar(&templatesHTMLHeader, "html-header", "", "Header HTML for every page")
-	templatesCreateHTMLCmd.Flags().StringVar(&templatesHTMLFooter, "html-footer", "", "Footer HTML for every page")
-	templatesCreateHTMLCmd.Flags().StringVar(&templatesSize, "size", "", "Page size (Letter, Legal, A4, A5, etc.)")
-	mustMarkFlagRequired(templatesCreateHTMLCmd, "name")
-	mustMarkFlagRequired(templatesCreateHTMLCmd, "html")
+	cli.templatesCreateHTMLCmd.Flags().StringVar(&cli.templatesName, "name", "", "Template name (required)")
+	cli.templatesCreateHTMLCmd.Flags().StringVar(&cli.templatesHTML, "html", "", "HTML content (required)")
+	cli.templatesCreateHTMLCmd.Flags().StringVar(&cli.templatesFolder, "folder", "", "Folder name")
+	cli.templatesCreateHTMLCmd.Flags().StringVar(&cli.templatesExternalID, "external-id", "", "App-specific identifier")
+	cli.templatesCreateHTMLCmd.Flags().StringVar(&cli.templatesSharedLink, "shared-link", "", "Enable/disable shared link (true/false, default: true)")
+	cli.templatesCreateHTMLCmd.Flags().StringVar(&cli.templatesHTMLHeader, "html-header", "", "Header HTML for every page")
+	cli.templatesCreateHTMLCmd.Flags().StringVar(&cli.templatesHTMLFooter, "html-footer", "", "Footer HTML for every page")
+	cli.templatesCreateHTMLCmd.Flags().StringVar(&cli.templatesSize, "size", "", "Page size (Letter, Legal, A4, A5, etc.)")
+	mustMarkFlagRequired(cli.templatesCreateHTMLCmd, "name")
+	mustMarkFlagRequired(cli.templatesCreateHTMLCmd, "html")
 
 	// Clone flags
-	templatesCloneCmd.Flags().StringVar(&templatesName, "name", "", "New template name")
-	templatesCloneCmd.Flags().StringVar(&templatesFolder, "folder", "", "Folder name")
+	cli.templatesCloneCmd.Flags().StringVar(&cli.templatesName, "name", "", "New template name")
+	cli.templatesCloneCmd.Flags().StringVar(&cli.templatesFolder, "folder", "", "Folder name")
 
 	// Merge flags
-	templatesMergeCmd.Flags().StringVar(&templatesIDs, "ids", "", "Comma-separated template IDs (required)")
-	templatesMergeCmd.Flags().StringVar(&templatesName, "name", "", "Merged template name (required)")
-	templatesMergeCmd.Flags().StringVar(&templatesFolder, "folder", "", "Folder name")
-	mustMarkFlagRequired(templatesMergeCmd, "ids")
-	mustMarkFlagRequired(templatesMergeCmd, "name")
+	cli.templatesMergeCmd.Flags().StringVar(&cli.templatesIDs, "ids", "", "Comma-separated template IDs (required)")
+	cli.templatesMergeCmd.Flags().StringVar(&cli.templatesName, "name", "", "Merged template name (required)")
+	cli.templatesMergeCmd.Flags().StringVar(&cli.templatesFolder, "folder", "", "Folder name")
+	mustMarkFlagRequired(cli.templatesMergeCmd, "ids")
+	mustMarkFlagRequired(cli.templatesMergeCmd, "name")
 
 	// Update flags
-	templatesUpdateCmd.Flags().StringVar(&templatesName, "name", "", "New template name")
-	templatesUpdateCmd.Flags().StringVar(&templatesFolder, "folder", "", "New folder name")
+	cli.templatesUpdateCmd.Flags().StringVar(&cli.templatesName, "name", "", "New template name")
+	cli.templatesUpdateCmd.Flags().StringVar(&cli.templatesFolder, "folder", "", "New folder name")
 
 	// Update documents flags
-	templatesUpdateDocumentsCmd.Flags().StringArrayVar(&templatesDocFiles, "file", []string{}, "Document file path (PDF/DOCX, can be specified multiple times)")
-	templatesUpdateDocumentsCmd.Flags().StringVar(&templatesDocHTML, "html", "", "HTML content for document")
-	templatesUpdateDocumentsCmd.Flags().StringVar(&templatesDocName, "name", "", "Document name")
-	templatesUpdateDocumentsCmd.Flags().IntVar(&templatesDocPosition, "position", 0, "Document position (0-based index)")
-	templatesUpdateDocumentsCmd.Flags().BoolVar(&templatesDocReplace, "replace", false, "Replace document at position")
-	templatesUpdateDocumentsCmd.Flags().BoolVar(&templatesDocRemove, "remove", false, "Remove document at position")
-	templatesUpdateDocumentsCmd.Flags().BoolVar(&templatesDocMerge, "merge", false, "Merge all documents")
+	cli.templatesUpdateDocumentsCmd.Flags().StringArrayVar(&cli.templatesDocFiles, "file", []string{}, "Document file path (PDF/DOCX, can be specified multiple times)")
+	cli.templatesUpdateDocumentsCmd.Flags().StringVar(&cli.templatesDocHTML, "html", "", "HTML content for document")
+	cli.templatesUpdateDocumentsCmd.Flags().StringVar(&cli.templatesDocName, "name", "", "Document name")
+	cli.templatesUpdateDocumentsCmd.Flags().IntVar(&cli.templatesDocPosition, "position", 0, "Document position (0-based index)")
+	cli.templatesUpdateDocumentsCmd.Flags().BoolVar(&cli.templatesDocReplace, "replace", false, "Replace document at position")
+	cli.templatesUpdateDocumentsCmd.Flags().BoolVar(&cli.templatesDocRemove, "remove", false, "Remove document at position")
+	cli.templatesUpdateDocumentsCmd.Flags().BoolVar(&cli.templatesDocMerge, "merge", false, "Merge all documents")
+
+	// Shell completion
+	for _, c := range []*cobra.Command{cli.templatesGetCmd, cli.templatesCloneCmd, cli.templatesUpdateCmd, cli.templatesArchiveCmd, cli.templatesUpdateDocumentsCmd} {
+		c.ValidArgsFunction = cli.idCompletion("completion-templates", true)
+		cli.addPicker(c, "completion-templates")
+	}
+	for _, c := range []*cobra.Command{cli.templatesListCmd, cli.templatesCreatePDFCmd, cli.templatesCreateDOCXCmd, cli.templatesCreateHTMLCmd, cli.templatesCloneCmd, cli.templatesMergeCmd, cli.templatesUpdateCmd} {
+		mustRegisterFlagCompletion(c, "folder", cli.completeFolders)
+	}
 }
 
-func runTemplatesList(cmd *cobra.Command, args []string) error {
-	client, err := getClient()
+func (cli *CLI) runTemplatesList(cmd *cobra.Command, args []string) error {
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
-	limit := templatesLimit
+	limit := cli.templatesLimit
 	reqLimit := limit
-	if ((mode == outfmt.JSON && !bareJSON) || (mode == outfmt.NDJSON && withMeta)) && limit > 0 {
+	if ((mode == outfmt.JSON && !cli.bareJSON) || (mode == outfmt.NDJSON && cli.withMeta)) && limit > 0 {
 		reqLimit = limit + 1
 	}
 
-	templates, err := client.ListTemplates(cmd.Context(), reqLimit, templatesFolder, templatesArchived, templatesAfter, templatesBefore)
+	templates, err := listFiltered(cmd.Context(), cli, reqLimit, cli.templatesAfter, cli.templatesBefore,
+		func(t api.Template) int { return t.ID },
+		func(limit, after, before int) ([]api.Template, error) {
+			return client.ListTemplates(cmd.Context(), limit, cli.templatesFolder, cli.templatesArchived, after, before)
+		},
+	)
 	if err != nil {
 		return fmt.Errorf("failed to list templates: %w", err)
 	}
 
 	// Machine-friendly envelope / meta.
-	if (mode == outfmt.JSON && !bareJSON) || (mode == outfmt.NDJSON && withMeta) {
+	if (mode == outfmt.JSON && !cli.bareJSON) || (mode == outfmt.NDJSON && cli.withMeta) {
 		out := templates
 		hasMore := false
 		if limit > 0 && len(out) > limit {
@@ -259,10 +309,11 @@ func runTemplatesList(cmd *cobra.Command, args []string) error {
 				nextAfter = out[len(out)-1].ID
 			}
 		}
+		sortItems(cli.sortFlag, out)
 
-		if mode == outfmt.JSON && !bareJSON {
-			env := makeListEnvelope(out, len(out), limit, templatesAfter, templatesBefore, hasMore, nextAfter, nextBefore)
-			outputResult(mode, env, func() {})
+		if mode == outfmt.JSON && !cli.bareJSON {
+			env := makeListEnvelope(out, len(out), limit, cli.templatesAfter, cli.templatesBefore, hasMore, nextAfter, nextBefore)
+			cli.outputResult(mode, env, func() {})
 			return nil
 		}
 
@@ -271,8 +322,8 @@ func runTemplatesList(cmd *cobra.Command, args []string) error {
 			"_meta": map[string]any{
 				"count":       len(out),
 				"limit":       limit,
-				"after":       templatesAfter,
-				"before":      templatesBefore,
+				"after":       cli.templatesAfter,
+				"before":      cli.templatesBefore,
 				"has_more":    hasMore,
 				"next_after":  nextAfter,
 				"next_before": nextBefore,
@@ -283,50 +334,66 @@ func runTemplatesList(cmd *cobra.Command, args []string) error {
 			stream = append(stream, t)
 		}
 		stream = append(stream, meta)
-		outputResult(mode, stream, func() {})
+		cli.outputResult(mode, stream, func() {})
 		return nil
 	}
 
-	outputResult(mode, templates, func() {
+	nextPage := 0
+	if len(templates) > 0 {
+		nextPage = templates[len(templates)-1].ID
+	}
+	sortItems(cli.sortFlag, templates)
+
+	cli.outputResult(mode, templates, func() {
 		if len(templates) == 0 {
-			fmt.Println("No templates found")
+			fmt.Fprintln(cli.stdout, "No templates found")
 			return
 		}
-		w := newTabWriter()
-		if _, err := fmt.Fprintln(w, "ID\tNAME\tFOLDER\tCREATED"); err != nil {
-			fmt.Fprintf(os.Stderr, "Error writing output: %v\n", err)
-		}
+		rows := make([][]string, 0, len(templates))
 		for _, t := range templates {
-			if _, err := fmt.Fprintf(w, "%d\t%s\t%s\t%s\n",
-				t.ID,
-				truncateString(t.Name, 40),
+			rows = append(rows, []string{
+				strconv.Itoa(t.ID),
+				t.Name,
 				t.FolderName,
 				formatTime(t.CreatedAt),
-			); err != nil {
-				fmt.Fprintf(os.Stderr, "Error writing output: %v\n", err)
-			}
-		}
-		if err := w.Flush(); err != nil {
-			fmt.Fprintf(os.Stderr, "Error flushing output: %v\n", err)
+				t.Slug,
+				formatTime(t.UpdatedAt),
+				t.ExternalID,
+			})
 		}
+		cli.renderTable(templateColumns, rows)
 
 		// Pagination hint
-		if templatesLimit > 0 && len(templates) == templatesLimit {
-			fmt.Fprintf(os.Stderr, "\n# More results may be available. Use --after %d to see next page.\n", templates[len(templates)-1].ID)
+		if cli.templatesLimit > 0 && len(templates) == cli.templatesLimit {
+			cli.getLogger().Info("More results may be available; use --after to see the next page", "after", nextPage)
 		}
 	})
 
 	return nil
 }
 
-func runTemplatesGet(cmd *cobra.Command, args []string) error {
-	client, err := getClient()
+func (cli *CLI) runTemplatesGet(cmd *cobra.Command, args []string) error {
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
-	id, err := resolveTemplateID(cmd.Context(), client, args[0])
+	if cli.stdinIDs {
+		return cli.runStdinIDs(cmd, templateIDKeys, func(ctx context.Context, ident string) (any, string, error) {
+			id, err := cli.resolveTemplateID(ctx, client, ident)
+			if err != nil {
+				return nil, "", err
+			}
+			template, err := client.GetTemplate(ctx, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("failed to get template: %w", err)
+			}
+			return template, fmt.Sprintf("%d\t%s\t%s", template.ID, template.Name, template.FolderName), nil
+		})
+	}
+
+	id, err := cli.resolveTemplateID(cmd.Context(), client, args[0])
 	if err != nil {
 		return err
 	}
@@ -336,204 +403,228 @@ func runTemplatesGet(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get template: %w", err)
 	}
 
-	outputResult(mode, template, func() {
-		fmt.Printf("ID: %d\n", template.ID)
-		fmt.Printf("Name: %s\n", template.Name)
-		fmt.Printf("Slug: %s\n", template.Slug)
-		fmt.Printf("Folder: %s\n", template.FolderName)
-		fmt.Printf("Created: %s\n", formatTime(template.CreatedAt))
-		fmt.Printf("Updated: %s\n", formatTime(template.UpdatedAt))
+	cli.outputResult(mode, template, func() {
+		fmt.Fprintf(cli.stdout, "ID: %d\n", template.ID)
+		fmt.Fprintf(cli.stdout, "Name: %s\n", template.Name)
+		fmt.Fprintf(cli.stdout, "Slug: %s\n", template.Slug)
+		fmt.Fprintf(cli.stdout, "Folder: %s\n", template.FolderName)
+		fmt.Fprintf(cli.stdout, "Created: %s\n", formatTime(template.CreatedAt))
+		fmt.Fprintf(cli.stdout, "Updated: %s\n", formatTime(template.UpdatedAt))
 		if template.ArchivedAt != nil {
-			fmt.Printf("Archived: %s\n", formatTimePtr(template.ArchivedAt))
+			fmt.Fprintf(cli.stdout, "Archived: %s\n", formatTimePtr(template.ArchivedAt))
 		}
 		if len(template.Fields) > 0 {
-			fmt.Printf("Fields: %d\n", len(template.Fields))
+			fmt.Fprintf(cli.stdout, "Fields: %d\n", len(template.Fields))
 		}
 		if len(template.Submitters) > 0 {
-			fmt.Printf("Roles: ")
+			fmt.Fprintf(cli.stdout, "Roles: ")
 			for i, s := range template.Submitters {
 				if i > 0 {
-					fmt.Printf(", ")
+					fmt.Fprintf(cli.stdout, ", ")
 				}
-				fmt.Printf("%s", s.Name)
+				fmt.Fprintf(cli.stdout, "%s", s.Name)
 			}
-			fmt.Println()
+			fmt.Fprintln(cli.stdout)
 		}
 	})
 
 	return nil
 }
 
-func runTemplatesCreatePDF(cmd *cobra.Command, args []string) error {
-	client, err := getClient()
+func (cli *CLI) runTemplatesCreatePDF(cmd *cobra.Command, args []string) error {
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
 	var sharedLink *bool
-	if templatesSharedLink != "" {
-		val, err := strconv.ParseBool(templatesSharedLink)
+	if cli.templatesSharedLink != "" {
+		val, err := strconv.ParseBool(cli.templatesSharedLink)
 		if err != nil {
 			return fmt.Errorf("invalid shared-link value: must be true or false")
 		}
 		sharedLink = &val
 	}
 
-	template, err := client.CreateTemplateFromPDF(cmd.Context(), templatesName, templatesFile, templatesFolder, templatesExternalID, sharedLink)
+	template, err := client.CreateTemplateFromPDF(cmd.Context(), cli.templatesName, cli.templatesFile, cli.templatesFolder, cli.templatesExternalID, sharedLink)
 	if err != nil {
 		return fmt.Errorf("failed to create template: %w", err)
 	}
 
-	outputResult(mode, template, func() {
-		fmt.Printf("Created template %d: %s\n", template.ID, template.Name)
+	cli.outputResult(mode, template, func() {
+		fmt.Fprintf(cli.stdout, "Created template %d: %s\n", template.ID, template.Name)
 	})
 
 	return nil
 }
 
-func runTemplatesCreateDOCX(cmd *cobra.Command, args []string) error {
-	client, err := getClient()
+func (cli *CLI) runTemplatesCreateDOCX(cmd *cobra.Command, args []string) error {
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
 	var sharedLink *bool
-	if templatesSharedLink != "" {
-		val, err := strconv.ParseBool(templatesSharedLink)
+	if cli.templatesSharedLink != "" {
+		val, err := strconv.ParseBool(cli.templatesSharedLink)
 		if err != nil {
 			return fmt.Errorf("invalid shared-link value: must be true or false")
 		}
 		sharedLink = &val
 	}
 
-	template, err := client.CreateTemplateFromDOCX(cmd.Context(), templatesName, templatesFile, templatesFolder, templatesExternalID, sharedLink)
+	template, err := client.CreateTemplateFromDOCX(cmd.Context(), cli.templatesName, cli.templatesFile, cli.templatesFolder, cli.templatesExternalID, sharedLink)
 	if err != nil {
 		return fmt.Errorf("failed to create template: %w", err)
 	}
 
-	outputResult(mode, template, func() {
-		fmt.Printf("Created template %d: %s\n", template.ID, template.Name)
+	cli.outputResult(mode, template, func() {
+		fmt.Fprintf(cli.stdout, "Created template %d: %s\n", template.ID, template.Name)
 	})
 
 	return nil
 }
 
-func runTemplatesCreateHTML(cmd *cobra.Command, args []string) error {
-	client, err := getClient()
+func (cli *CLI) runTemplatesCreateHTML(cmd *cobra.Command, args []string) error {
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
 	var sharedLink *bool
-	if templatesSharedLink != "" {
-		val, err := strconv.ParseBool(templatesSharedLink)
+	if cli.templatesSharedLink != "" {
+		val, err := strconv.ParseBool(cli.templatesSharedLink)
 		if err != nil {
 			return fmt.Errorf("invalid shared-link value: must be true or false")
 		}
 		sharedLink = &val
 	}
 
-	template, err := client.CreateTemplateFromHTML(cmd.Context(), templatesName, templatesHTML, templatesFolder, templatesExternalID, templatesHTMLHeader, templatesHTMLFooter, templatesSize, sharedLink)
+	template, err := client.CreateTemplateFromHTML(cmd.Context(), cli.templatesName, cli.templatesHTML, cli.templatesFolder, cli.templatesExternalID, cli.templatesHTMLHeader, cli.templatesHTMLFooter, cli.templatesSize, sharedLink)
 	if err != nil {
 		return fmt.Errorf("failed to create template: %w", err)
 	}
 
-	outputResult(mode, template, func() {
-		fmt.Printf("Created template %d: %s\n", template.ID, template.Name)
+	cli.outputResult(mode, template, func() {
+		fmt.Fprintf(cli.stdout, "Created template %d: %s\n", template.ID, template.Name)
 	})
 
 	return nil
 }
 
-func runTemplatesClone(cmd *cobra.Command, args []string) error {
-	client, err := getClient()
+func (cli *CLI) runTemplatesClone(cmd *cobra.Command, args []string) error {
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
-	id, err := resolveTemplateID(cmd.Context(), client, args[0])
+	if cli.stdinIDs {
+		return cli.runStdinIDs(cmd, templateIDKeys, func(ctx context.Context, ident string) (any, string, error) {
+			id, err := cli.resolveTemplateID(ctx, client, ident)
+			if err != nil {
+				return nil, "", err
+			}
+			template, err := client.CloneTemplate(ctx, id, cli.templatesName, cli.templatesFolder)
+			if err != nil {
+				return nil, "", fmt.Errorf("failed to clone template: %w", err)
+			}
+			return template, fmt.Sprintf("Cloned template %d: %s", template.ID, template.Name), nil
+		})
+	}
+
+	id, err := cli.resolveTemplateID(cmd.Context(), client, args[0])
 	if err != nil {
 		return err
 	}
 
-	template, err := client.CloneTemplate(cmd.Context(), id, templatesName, templatesFolder)
+	template, err := client.CloneTemplate(cmd.Context(), id, cli.templatesName, cli.templatesFolder)
 	if err != nil {
 		return fmt.Errorf("failed to clone template: %w", err)
 	}
 
-	outputResult(mode, template, func() {
-		fmt.Printf("Cloned template %d: %s\n", template.ID, template.Name)
+	cli.outputResult(mode, template, func() {
+		fmt.Fprintf(cli.stdout, "Cloned template %d: %s\n", template.ID, template.Name)
 	})
 
 	return nil
 }
 
-func runTemplatesMerge(cmd *cobra.Command, args []string) error {
-	ids, err := parseIntSlice(templatesIDs)
+func (cli *CLI) runTemplatesMerge(cmd *cobra.Command, args []string) error {
+	ids, err := parseIntSlice(cli.templatesIDs)
 	if err != nil {
 		return fmt.Errorf("invalid template IDs: %w", err)
 	}
 
-	client, err := getClient()
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
-	template, err := client.MergeTemplates(cmd.Context(), ids, templatesName, templatesFolder)
+	template, err := client.MergeTemplates(cmd.Context(), ids, cli.templatesName, cli.templatesFolder)
 	if err != nil {
 		return fmt.Errorf("failed to merge templates: %w", err)
 	}
 
-	outputResult(mode, template, func() {
-		fmt.Printf("Merged into template %d: %s\n", template.ID, template.Name)
+	cli.outputResult(mode, template, func() {
+		fmt.Fprintf(cli.stdout, "Merged into template %d: %s\n", template.ID, template.Name)
 	})
 
 	return nil
 }
 
-func runTemplatesUpdate(cmd *cobra.Command, args []string) error {
-	client, err := getClient()
+func (cli *CLI) runTemplatesUpdate(cmd *cobra.Command, args []string) error {
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
-	id, err := resolveTemplateID(cmd.Context(), client, args[0])
+	id, err := cli.resolveTemplateID(cmd.Context(), client, args[0])
 	if err != nil {
 		return err
 	}
 
-	template, err := client.UpdateTemplate(cmd.Context(), id, templatesName, templatesFolder)
+	template, err := client.UpdateTemplate(cmd.Context(), id, cli.templatesName, cli.templatesFolder)
 	if err != nil {
 		return fmt.Errorf("failed to update template: %w", err)
 	}
 
-	outputResult(mode, template, func() {
-		fmt.Printf("Updated template %d: %s\n", template.ID, template.Name)
+	cli.outputResult(mode, template, func() {
+		fmt.Fprintf(cli.stdout, "Updated template %d: %s\n", template.ID, template.Name)
 	})
 
 	return nil
 }
 
-func runTemplatesArchive(cmd *cobra.Command, args []string) error {
-	client, err := getClient()
+func (cli *CLI) runTemplatesArchive(cmd *cobra.Command, args []string) error {
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
-	id, err := resolveTemplateID(cmd.Context(), client, args[0])
-	if err != nil {
-		return err
+	if cli.stdinIDs {
+		return cli.runStdinIDs(cmd, templateIDKeys, func(ctx context.Context, ident string) (any, string, error) {
+			id, err := cli.resolveTemplateID(ctx, client, ident)
+			if err != nil {
+				return nil, "", err
+			}
+			result, err := client.ArchiveTemplate(ctx, id)
+			if err != nil {
+				return nil, "", fmt.Errorf("failed to archive template: %w", err)
+			}
+			return result, fmt.Sprintf("Archived template %d", result.ID), nil
+		})
 	}
 
-	if dryRunPreview("archive template %d", id) {
-		return nil
+	id, err := cli.resolveTemplateID(cmd.Context(), client, args[0])
+	if err != nil {
+		return err
 	}
 
 	result, err := client.ArchiveTemplate(cmd.Context(), id)
@@ -541,27 +632,27 @@ func runTemplatesArchive(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to archive template: %w", err)
 	}
 
-	outputResult(mode, result, func() {
-		fmt.Printf("Archived template %d\n", result.ID)
+	cli.outputResult(mode, result, func() {
+		fmt.Fprintf(cli.stdout, "Archived template %d\n", result.ID)
 	})
 
 	return nil
 }
 
-func runTemplatesUpdateDocuments(cmd *cobra.Command, args []string) error {
-	client, err := getClient()
+func (cli *CLI) runTemplatesUpdateDocuments(cmd *cobra.Command, args []string) error {
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
-	id, err := resolveTemplateID(cmd.Context(), client, args[0])
+	id, err := cli.resolveTemplateID(cmd.Context(), client, args[0])
 	if err != nil {
 		return err
 	}
 
 	// Validate position
-	if templatesDocPosition < 0 {
+	if cli.templatesDocPosition < 0 {
 		return fmt.Errorf("position must be non-negative")
 	}
 
@@ -569,39 +660,36 @@ func runTemplatesUpdateDocuments(cmd *cobra.Command, args []string) error {
 	var operations []api.TemplateDocumentOperation
 
 	// Handle file uploads
-	for i, filePath := range templatesDocFiles {
+	for i, filePath := range cli.templatesDocFiles {
 		op, err := buildDocumentOperationFromFile(filePath, i)
 		if err != nil {
 			return err
 		}
-		if templatesDocName != "" && len(templatesDocFiles) == 1 {
-			op.Name = templatesDocName
+		if cli.templatesDocName != "" && len(cli.templatesDocFiles) == 1 {
+			op.Name = cli.templatesDocName
 		}
 		operations = append(operations, op)
 	}
 
 	// Handle HTML content
-	if templatesDocHTML != "" {
-		if err := api.ValidateHTMLContent(templatesDocHTML); err != nil {
+	if cli.templatesDocHTML != "" {
+		if err := api.ValidateHTMLContent(cli.templatesDocHTML); err != nil {
 			return err
 		}
 		op := api.TemplateDocumentOperation{
-			HTML:     templatesDocHTML,
-			Name:     templatesDocName,
-			Position: templatesDocPosition,
-			Replace:  templatesDocReplace,
-			Remove:   templatesDocRemove,
+			HTML:     cli.templatesDocHTML,
+			Name:     cli.templatesDocName,
+			Position: cli.templatesDocPosition,
+			Replace:  cli.templatesDocReplace,
+			Remove:   cli.templatesDocRemove,
 		}
 		operations = append(operations, op)
 	}
 
 	// Handle remove operation (no file or HTML)
-	if templatesDocRemove && len(operations) == 0 {
-		if dryRunPreview("remove document at position %d from template %d", templatesDocPosition, id) {
-			return nil
-		}
+	if cli.templatesDocRemove && len(operations) == 0 {
 		op := api.TemplateDocumentOperation{
-			Position: templatesDocPosition,
+			Position: cli.templatesDocPosition,
 			Remove:   true,
 		}
 		operations = append(operations, op)
@@ -613,14 +701,14 @@ func runTemplatesUpdateDocuments(cmd *cobra.Command, args []string) error {
 	}
 
 	// Apply position/replace flags to file operations if only one file
-	if len(templatesDocFiles) == 1 && templatesDocHTML == "" {
-		operations[0].Position = templatesDocPosition
-		operations[0].Replace = templatesDocReplace
+	if len(cli.templatesDocFiles) == 1 && cli.templatesDocHTML == "" {
+		operations[0].Position = cli.templatesDocPosition
+		operations[0].Replace = cli.templatesDocReplace
 	}
 
 	req := &api.UpdateTemplateDocumentsRequest{
 		Documents: operations,
-		Merge:     templatesDocMerge,
+		Merge:     cli.templatesDocMerge,
 	}
 
 	template, err := client.UpdateTemplateDocuments(cmd.Context(), id, req)
@@ -628,10 +716,10 @@ func runTemplatesUpdateDocuments(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to update template documents: %w", err)
 	}
 
-	outputResult(mode, template, func() {
-		fmt.Printf("Updated documents for template %d: %s\n", template.ID, template.Name)
+	cli.outputResult(mode, template, func() {
+		fmt.Fprintf(cli.stdout, "Updated documents for template %d: %s\n", template.ID, template.Name)
 		if template.DocumentsCount > 0 {
-			fmt.Printf("Total documents: %d\n", template.DocumentsCount)
+			fmt.Fprintf(cli.stdout, "Total documents: %d\n", template.DocumentsCount)
 		}
 	})
 
@@ -8,54 +8,58 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var toolsCmd = &cobra.Command{
-	Use:     "tools",
-	Aliases: []string{"tool"},
-	Short:   "PDF utility tools",
-	Long:    `Tools for PDF manipulation and verification.`,
+// toolsState holds the tools command state of a CLI.
+type toolsState struct {
+	toolsCmd       *cobra.Command
+	toolsMergeCmd  *cobra.Command
+	toolsVerifyCmd *cobra.Command
+	toolsFiles     string
+	toolsOutput    string
+	toolsFile      string
 }
 
-var toolsMergeCmd = &cobra.Command{
-	Use:   "merge-pdfs",
-	Short: "Merge multiple PDFs into one",
-	Long:  `Merge multiple PDF files into a single PDF document.`,
-	RunE:  runToolsMerge,
-}
+func (cli *CLI) initTools() {
+	cli.toolsCmd = &cobra.Command{
+		Use:     "tools",
+		Aliases: []string{"tool"},
+		Short:   "PDF utility tools",
+		Long:    `Tools for PDF manipulation and verification.`,
+	}
 
-var toolsVerifyCmd = &cobra.Command{
-	Use:   "verify-signature",
-	Short: "Verify PDF signature",
-	Long:  `Verify digital signatures in a PDF document.`,
-	RunE:  runToolsVerify,
-}
+	cli.toolsMergeCmd = &cobra.Command{
+		Use:   "merge-pdfs",
+		Short: "Merge multiple PDFs into one",
+		Long:  `Merge multiple PDF files into a single PDF document.`,
+		RunE:  cli.runToolsMerge,
+	}
 
-var (
-	toolsFiles  string
-	toolsOutput string
-	toolsFile   string
-)
+	cli.toolsVerifyCmd = &cobra.Command{
+		Use:   "verify-signature",
+		Short: "Verify PDF signature",
+		Long:  `Verify digital signatures in a PDF document.`,
+		RunE:  cli.runToolsVerify,
+	}
 
-func init() {
-	rootCmd.AddCommand(toolsCmd)
-	toolsCmd.AddCommand(toolsMergeCmd)
-	toolsCmd.AddCommand(toolsVerifyCmd)
+	cli.rootCmd.AddCommand(cli.toolsCmd)
+	cli.toolsCmd.AddCommand(cli.toolsMergeCmd)
+	cli.toolsCmd.AddCommand(cli.toolsVerifyCmd)
 
-	toolsMergeCmd.Flags().StringVar(&toolsFiles, "files", "", "Comma-separated PDF file paths (required)")
-	toolsMergeCmd.Flags().StringVar(&toolsOutput, "output", "merged.pdf", "Output file path")
-	mustMarkFlagRequired(toolsMergeCmd, "files")
+	cli.toolsMergeCmd.Flags().StringVar(&cli.toolsFiles, "files", "", "Comma-separated PDF file paths (required)")
+	cli.toolsMergeCmd.Flags().StringVar(&cli.toolsOutput, "output", "merged.pdf", "Output file path")
+	mustMarkFlagRequired(cli.toolsMergeCmd, "files")
 
-	toolsVerifyCmd.Flags().StringVar(&toolsFile, "file", "", "PDF file to verify (required)")
-	mustMarkFlagRequired(toolsVerifyCmd, "file")
+	cli.toolsVerifyCmd.Flags().StringVar(&cli.toolsFile, "file", "", "PDF file to verify (required)")
+	mustMarkFlagRequired(cli.toolsVerifyCmd, "file")
 }
 
-func runToolsMerge(cmd *cobra.Command, args []string) error {
-	client, err := getClient()
+func (cli *CLI) runToolsMerge(cmd *cobra.Command, args []string) error {
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
-	files := strings.Split(toolsFiles, ",")
+	files := strings.Split(cli.toolsFiles, ",")
 	for i := range files {
 		files[i] = strings.TrimSpace(files[i])
 	}
@@ -69,46 +73,46 @@ func runToolsMerge(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to merge PDFs: %w", err)
 	}
 
-	if err := os.WriteFile(toolsOutput, merged, 0600); err != nil {
+	if err := os.WriteFile(cli.toolsOutput, merged, 0600); err != nil {
 		return fmt.Errorf("failed to write output file: %w", err)
 	}
 
-	outputResult(mode, map[string]any{
+	cli.outputResult(mode, map[string]any{
 		"files":         files,
 		"files_count":   len(files),
-		"output_path":   toolsOutput,
+		"output_path":   cli.toolsOutput,
 		"bytes_written": len(merged),
 	}, func() {
-		fmt.Printf("Merged %d PDFs into %s\n", len(files), toolsOutput)
+		fmt.Fprintf(cli.stdout, "Merged %d PDFs into %s\n", len(files), cli.toolsOutput)
 	})
 	return nil
 }
 
-func runToolsVerify(cmd *cobra.Command, args []string) error {
-	client, err := getClient()
+func (cli *CLI) runToolsVerify(cmd *cobra.Command, args []string) error {
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
-	result, err := client.VerifySignature(cmd.Context(), toolsFile)
+	result, err := client.VerifySignature(cmd.Context(), cli.toolsFile)
 	if err != nil {
 		return fmt.Errorf("failed to verify signature: %w", err)
 	}
 
-	outputResult(mode, result, func() {
-		fmt.Printf("Checksum: %s\n", result.ChecksumStatus)
+	cli.outputResult(mode, result, func() {
+		fmt.Fprintf(cli.stdout, "Checksum: %s\n", result.ChecksumStatus)
 		if len(result.Signatures) == 0 {
-			fmt.Println("No signatures found")
+			fmt.Fprintln(cli.stdout, "No signatures found")
 			return
 		}
 		for i, sig := range result.Signatures {
-			fmt.Printf("\nSignature %d:\n", i+1)
-			fmt.Printf("  Signer: %s\n", sig.SignerName)
-			fmt.Printf("  Time: %s\n", sig.SigningTime)
-			fmt.Printf("  Type: %s\n", sig.SignatureType)
+			fmt.Fprintf(cli.stdout, "\nSignature %d:\n", i+1)
+			fmt.Fprintf(cli.stdout, "  Signer: %s\n", sig.SignerName)
+			fmt.Fprintf(cli.stdout, "  Time: %s\n", sig.SigningTime)
+			fmt.Fprintf(cli.stdout, "  Type: %s\n", sig.SignatureType)
 			if sig.SigningReason != "" {
-				fmt.Printf("  Reason: %s\n", sig.SigningReason)
+				fmt.Fprintf(cli.stdout, "  Reason: %s\n", sig.SigningReason)
 			}
 		}
 	})
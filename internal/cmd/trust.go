@@ -0,0 +1,252 @@
+package cmd
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+)
+
+// tlsVersions maps --tls-min-version values to crypto/tls versions.
+var tlsVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// trustState holds the TLS and proxy settings of a CLI: the flag values and what
+// loadTrust made of them.
+type trustState struct {
+	caCertFile     string
+	clientCertFile string
+	clientKeyFile  string
+	tlsMinVersion  string
+	proxyFlag      string
+
+	rootCAs    *x509.CertPool
+	caCerts    int
+	clientCert *tls.Certificate
+	minVersion uint16
+	proxyURL   *url.URL
+}
+
+// initTrustFlags registers the TLS and proxy flags with their environment defaults.
+func (cli *CLI) initTrustFlags() {
+	flags := cli.rootCmd.PersistentFlags()
+	flags.StringVar(&cli.caCertFile, "ca-cert", os.Getenv("DOCUSEAL_CA_CERT"), "PEM file of CA certificates to trust besides the system roots (env: DOCUSEAL_CA_CERT)")
+	flags.StringVar(&cli.clientCertFile, "client-cert", os.Getenv("DOCUSEAL_CLIENT_CERT"), "PEM client certificate for mutual TLS (env: DOCUSEAL_CLIENT_CERT)")
+	flags.StringVar(&cli.clientKeyFile, "client-key", os.Getenv("DOCUSEAL_CLIENT_KEY"), "PEM private key of --client-cert (default: the certificate file) (env: DOCUSEAL_CLIENT_KEY)")
+	flags.StringVar(&cli.tlsMinVersion, "tls-min-version", getEnvOrDefault("DOCUSEAL_TLS_MIN_VERSION", "1.2"), "Minimum TLS version: 1.2 or 1.3 (env: DOCUSEAL_TLS_MIN_VERSION)")
+	flags.StringVar(&cli.proxyFlag, "proxy", os.Getenv("DOCUSEAL_PROXY"), "HTTP proxy URL, optionally with user:password (default: HTTPS_PROXY/HTTP_PROXY) (env: DOCUSEAL_PROXY)")
+}
+
+// loadTrust validates the TLS and proxy flags and loads the certificate files.
+func (cli *CLI) loadTrust() error {
+	cli.rootCAs, cli.caCerts, cli.clientCert, cli.proxyURL = nil, 0, nil, nil
+
+	version, ok := tlsVersions[cli.tlsMinVersion]
+	if !ok {
+		return &api.ValidationError{Field: "tls-min-version", Message: fmt.Sprintf("%q must be 1.2 or 1.3", cli.tlsMinVersion)}
+	}
+	cli.minVersion = version
+
+	if cli.caCertFile != "" {
+		pool, n, err := loadCAFile(cli.caCertFile)
+		if err != nil {
+			return &api.ValidationError{Field: "ca-cert", Message: err.Error()}
+		}
+		cli.rootCAs, cli.caCerts = pool, n
+	}
+
+	if cli.clientKeyFile != "" && cli.clientCertFile == "" {
+		return &api.ValidationError{Field: "client-key", Message: "--client-key requires --client-cert"}
+	}
+	if cli.clientCertFile != "" {
+		keyFile := cli.clientKeyFile
+		if keyFile == "" {
+			keyFile = cli.clientCertFile
+		}
+		cert, err := tls.LoadX509KeyPair(cli.clientCertFile, keyFile)
+		if err != nil {
+			return &api.ValidationError{Field: "client-cert", Message: err.Error()}
+		}
+		cli.clientCert = &cert
+	}
+
+	if cli.proxyFlag != "" {
+		u, err := url.Parse(cli.proxyFlag)
+		if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https" && u.Scheme != "socks5") {
+			return &api.ValidationError{Field: "proxy", Message: fmt.Sprintf("%q must be an http://, https:// or socks5:// URL", redactProxy(cli.proxyFlag))}
+		}
+		cli.proxyURL = u
+	}
+	return nil
+}
+
+// loadCAFile returns the system roots plus the certificates in a PEM file.
+func loadCAFile(path string) (*x509.CertPool, int, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read CA file: %w", err)
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	n := 0
+	for rest := data; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid certificate in %s: %w", path, err)
+		}
+		pool.AddCert(cert)
+		n++
+	}
+	if n == 0 {
+		return nil, 0, fmt.Errorf("no PEM certificates in %s", path)
+	}
+	return pool, n, nil
+}
+
+// transportOptions are the client options every client the CLI builds shares: timeouts,
+// retries, TLS, proxy and tracing.
+func (cli *CLI) transportOptions() []api.ClientOption {
+	opts := []api.ClientOption{
+		api.WithTimeout(cli.timeout),
+		api.WithRetries(cli.retries),
+		api.WithRetryBaseDelay(cli.retryDelay),
+		api.WithLogger(cli.getLogger()),
+	}
+	if cli.insecureTLS {
+		opts = append(opts, api.WithInsecureSkipVerify())
+	}
+	if cli.rootCAs != nil {
+		opts = append(opts, api.WithRootCAs(cli.rootCAs))
+	}
+	if cli.clientCert != nil {
+		opts = append(opts, api.WithClientCertificate(*cli.clientCert))
+	}
+	if cli.minVersion > tls.VersionTLS12 {
+		opts = append(opts, api.WithMinTLSVersion(cli.minVersion))
+	}
+	if cli.proxyURL != nil {
+		opts = append(opts, api.WithProxy(cli.proxyURL))
+	}
+	if cli.tracer != nil {
+		opts = append(opts, api.WithTracer(cli.tracer))
+	}
+	return opts
+}
+
+// trustKey describes the TLS and proxy settings, for caching clients per setting.
+func (cli *CLI) trustKey() string {
+	return fmt.Sprint(cli.insecureTLS, cli.caCertFile, cli.clientCertFile, cli.clientKeyFile, cli.tlsMinVersion, cli.proxyFlag)
+}
+
+// trustReport says which trust settings apply to requests to a URL.
+type trustReport struct {
+	Verify        bool   `json:"verify"`
+	RootCAs       string `json:"root_cas"`
+	ClientCert    string `json:"client_cert,omitempty"`
+	ClientCertEnd string `json:"client_cert_expires,omitempty"`
+	MinTLSVersion string `json:"min_tls_version"`
+	Proxy         string `json:"proxy"`
+}
+
+func (cli *CLI) trustReport(target string) trustReport {
+	r := trustReport{Verify: !cli.insecureTLS, RootCAs: "system", MinTLSVersion: cli.tlsMinVersion, Proxy: "none"}
+	if cli.rootCAs != nil {
+		r.RootCAs = fmt.Sprintf("system + %s (%d)", cli.caCertFile, cli.caCerts)
+	}
+	if leaf := cli.clientCertLeaf(); leaf != nil {
+		r.ClientCert = leaf.Subject.String()
+		r.ClientCertEnd = leaf.NotAfter.UTC().Format(time.RFC3339)
+	}
+	switch p := cli.proxyFor(target); {
+	case p != nil && cli.proxyURL != nil:
+		r.Proxy = p.Redacted()
+	case p != nil:
+		r.Proxy = p.Redacted() + " (environment)"
+	case target == "" && (os.Getenv("HTTPS_PROXY") != "" || os.Getenv("https_proxy") != ""):
+		// The URL is not known yet (browser login); the environment decides per host.
+		r.Proxy = "environment (HTTPS_PROXY)"
+	}
+	return r
+}
+
+// String summarizes the report on one line.
+func (r trustReport) String() string {
+	parts := []string{"roots " + r.RootCAs}
+	if !r.Verify {
+		parts = []string{"certificate verification disabled"}
+	}
+	if r.ClientCert != "" {
+		parts = append(parts, "client certificate "+r.ClientCert)
+	}
+	parts = append(parts, "TLS >= "+r.MinTLSVersion, "proxy "+r.Proxy)
+	return strings.Join(parts, ", ")
+}
+
+// clientCertLeaf returns the parsed client certificate, if one is configured.
+func (cli *CLI) clientCertLeaf() *x509.Certificate {
+	if cli.clientCert == nil || len(cli.clientCert.Certificate) == 0 {
+		return nil
+	}
+	if cli.clientCert.Leaf != nil {
+		return cli.clientCert.Leaf
+	}
+	leaf, err := x509.ParseCertificate(cli.clientCert.Certificate[0])
+	if err != nil {
+		return nil
+	}
+	return leaf
+}
+
+// proxyFor returns the proxy requests to target go through: --proxy, else the
+// environment's choice (nil for an empty target).
+func (cli *CLI) proxyFor(target string) *url.URL {
+	if cli.proxyURL != nil {
+		return cli.proxyURL
+	}
+	if target == "" {
+		return nil
+	}
+	req, err := http.NewRequest(http.MethodGet, target, nil)
+	if err != nil {
+		return nil
+	}
+	p, err := http.ProxyFromEnvironment(req)
+	if err != nil {
+		return nil
+	}
+	return p
+}
+
+// redactProxy hides the password of a proxy URL that may not parse.
+func redactProxy(raw string) string {
+	if u, err := url.Parse(raw); err == nil {
+		return u.Redacted()
+	}
+	if at := strings.LastIndex(raw, "@"); at >= 0 {
+		scheme, _, found := strings.Cut(raw[:at], "://")
+		if found {
+			return scheme + "://xxxxx" + raw[at:]
+		}
+		return "xxxxx" + raw[at:]
+	}
+	return raw
+}
@@ -14,19 +14,24 @@ var (
 	BuildDate = "unknown"
 )
 
-var versionCmd = &cobra.Command{
-	Use:   "version",
-	Short: "Show version information",
-	Long:  `Display version, commit hash, and build date.`,
-	RunE:  runVersion,
+// versionState holds the version command state of a CLI.
+type versionState struct {
+	versionCmd *cobra.Command
 }
 
-func init() {
-	rootCmd.AddCommand(versionCmd)
+func (cli *CLI) initVersion() {
+	cli.versionCmd = &cobra.Command{
+		Use:   "version",
+		Short: "Show version information",
+		Long:  `Display version, commit hash, and build date.`,
+		RunE:  cli.runVersion,
+	}
+
+	cli.rootCmd.AddCommand(cli.versionCmd)
 }
 
-func runVersion(cmd *cobra.Command, args []string) error {
-	mode := getOutputMode()
+func (cli *CLI) runVersion(cmd *cobra.Command, args []string) error {
+	mode := cli.getOutputMode()
 
 	info := map[string]string{
 		"version":    Version,
@@ -37,12 +42,12 @@ func runVersion(cmd *cobra.Command, args []string) error {
 		"arch":       runtime.GOARCH,
 	}
 
-	outputResult(mode, info, func() {
-		fmt.Printf("docuseal version %s\n", Version)
-		fmt.Printf("  commit:     %s\n", Commit)
-		fmt.Printf("  built:      %s\n", BuildDate)
-		fmt.Printf("  go version: %s\n", runtime.Version())
-		fmt.Printf("  platform:   %s/%s\n", runtime.GOOS, runtime.GOARCH)
+	cli.outputResult(mode, info, func() {
+		fmt.Fprintf(cli.stdout, "docuseal version %s\n", Version)
+		fmt.Fprintf(cli.stdout, "  commit:     %s\n", Commit)
+		fmt.Fprintf(cli.stdout, "  built:      %s\n", BuildDate)
+		fmt.Fprintf(cli.stdout, "  go version: %s\n", runtime.Version())
+		fmt.Fprintf(cli.stdout, "  platform:   %s/%s\n", runtime.GOOS, runtime.GOARCH)
 	})
 
 	return nil
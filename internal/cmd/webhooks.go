@@ -2,27 +2,65 @@ package cmd
 
 import (
 	"fmt"
-	"os"
 	"strconv"
 	"strings"
 
 	"github.com/docuseal/docuseal-cli/internal/api"
 	"github.com/docuseal/docuseal-cli/internal/outfmt"
+	"github.com/docuseal/docuseal-cli/internal/ui"
 	"github.com/spf13/cobra"
 )
 
-var webhooksCmd = &cobra.Command{
-	Use:     "webhooks",
-	Aliases: []string{"webhook", "wh"},
-	Short:   "Manage webhooks",
-	Long:    `List, create, update, and manage DocuSeal webhooks.`,
+// webhooksState holds the webhooks command state of a CLI.
+type webhooksState struct {
+	webhooksCmd       *cobra.Command
+	webhooksListCmd   *cobra.Command
+	webhooksGetCmd    *cobra.Command
+	webhooksCreateCmd *cobra.Command
+	webhooksUpdateCmd *cobra.Command
+	webhooksDeleteCmd *cobra.Command
+
+	// Flags
+	webhooksLimit     int
+	webhooksAfter     int
+	webhooksBefore    int
+	webhooksURL       string
+	webhooksEvents    []string
+	webhooksActive    string
+	webhookShowSecret bool
 }
 
-var webhooksListCmd = &cobra.Command{
-	Use:   "list",
-	Short: "List webhooks",
-	Long:  `List all configured webhooks.`,
-	Example: `  # List all webhooks
+// maskSecret masks a secret string by showing only first and last 4 characters
+func maskSecret(s string) string {
+	if len(s) <= 8 {
+		return "****"
+	}
+	return s[:4] + "****" + s[len(s)-4:]
+}
+
+// webhookColumns are the text-mode columns of 'webhooks list'; hidden ones are shown with --columns.
+var webhookColumns = []ui.Column{
+	{Name: "id", Header: "ID", Fixed: true},
+	{Name: "url", Header: "URL"},
+	{Name: "events", Header: "EVENTS"},
+	{Name: "active", Header: "ACTIVE", Fixed: true},
+	{Name: "created", Header: "CREATED", Fixed: true},
+	{Name: "updated", Header: "UPDATED", Fixed: true, Hidden: true},
+}
+
+func (cli *CLI) initWebhooks() {
+	cli.webhooksCmd = &cobra.Command{
+		Use:     "webhooks",
+		Aliases: []string{"webhook", "wh"},
+		Short:   "Manage webhooks",
+		Long:    `List, create, update, and manage DocuSeal webhooks.`,
+	}
+
+	cli.webhooksListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List webhooks",
+		Long:  `List all configured webhooks.`,
+		Example: `  # List all webhooks
   docuseal webhooks list
 
   # List with JSON output
@@ -30,22 +68,22 @@ var webhooksListCmd = &cobra.Command{
 
   # List with pagination
   docuseal webhooks list --limit 10 --after 5`,
-	RunE: runWebhooksList,
-}
+		RunE: cli.runWebhooksList,
+	}
 
-var webhooksGetCmd = &cobra.Command{
-	Use:   "get <id>",
-	Short: "Get webhook details",
-	Long:  `Retrieve detailed information about a specific webhook.`,
-	Args:  cobra.ExactArgs(1),
-	RunE:  runWebhooksGet,
-}
+	cli.webhooksGetCmd = &cobra.Command{
+		Use:   "get <id>",
+		Short: "Get webhook details",
+		Long:  `Retrieve detailed information about a specific webhook.`,
+		Args:  cobra.ExactArgs(1),
+		RunE:  cli.runWebhooksGet,
+	}
 
-var webhooksCreateCmd = &cobra.Command{
-	Use:   "create",
-	Short: "Create webhook",
-	Long:  `Create a new webhook to receive event notifications.`,
-	Example: `  # Create webhook for submission completed events
+	cli.webhooksCreateCmd = &cobra.Command{
+		Use:   "create",
+		Short: "Create webhook",
+		Long:  `Create a new webhook to receive event notifications.`,
+		Example: `  # Create webhook for submission completed events
   docuseal webhooks create --url https://example.com/webhook --events submission.completed
 
   # Create webhook for multiple events
@@ -53,15 +91,15 @@ var webhooksCreateCmd = &cobra.Command{
     --events submission.created \
     --events submission.completed \
     --events submission.archived`,
-	RunE: runWebhooksCreate,
-}
+		RunE: cli.runWebhooksCreate,
+	}
 
-var webhooksUpdateCmd = &cobra.Command{
-	Use:   "update <id>",
-	Short: "Update webhook",
-	Long:  `Update an existing webhook's URL, events, or active status.`,
-	Args:  cobra.ExactArgs(1),
-	Example: `  # Update webhook URL
+	cli.webhooksUpdateCmd = &cobra.Command{
+		Use:   "update <id>",
+		Short: "Update webhook",
+		Long:  `Update an existing webhook's URL, events, or active status.`,
+		Args:  cobra.ExactArgs(1),
+		Example: `  # Update webhook URL
   docuseal webhooks update 123 --url https://example.com/new-webhook
 
   # Update events
@@ -72,85 +110,84 @@ var webhooksUpdateCmd = &cobra.Command{
 
   # Enable webhook
   docuseal webhooks update 123 --active true`,
-	RunE: runWebhooksUpdate,
-}
-
-var webhooksDeleteCmd = &cobra.Command{
-	Use:   "delete <id>",
-	Short: "Delete webhook",
-	Long:  `Delete a webhook configuration.`,
-	Args:  cobra.ExactArgs(1),
-	RunE:  runWebhooksDelete,
-}
-
-// Flags
-var (
-	webhooksLimit     int
-	webhooksAfter     int
-	webhooksBefore    int
-	webhooksURL       string
-	webhooksEvents    []string
-	webhooksActive    string
-	webhookShowSecret bool
-)
+		RunE: cli.runWebhooksUpdate,
+	}
 
-// maskSecret masks a secret string by showing only first and last 4 characters
-func maskSecret(s string) string {
-	if len(s) <= 8 {
-		return "****"
+	cli.webhooksDeleteCmd = &cobra.Command{
+		Use:   "delete <id>",
+		Short: "Delete webhook",
+		Long:  `Delete a webhook configuration.`,
+		Args:  cobra.ExactArgs(1),
+		RunE:  cli.runWebhooksDelete,
 	}
-	return s[:4] + "****" + s[len(s)-4:]
-}
 
-func init() {
-	rootCmd.AddCommand(webhooksCmd)
+	cli.rootCmd.AddCommand(cli.webhooksCmd)
+
+	cli.webhooksCmd.AddCommand(cli.webhooksListCmd)
+	cli.webhooksCmd.AddCommand(cli.webhooksGetCmd)
+	cli.webhooksCmd.AddCommand(cli.webhooksCreateCmd)
+	cli.webhooksCmd.AddCommand(cli.webhooksUpdateCmd)
+	cli.webhooksCmd.AddCommand(cli.webhooksDeleteCmd)
 
-	webhooksCmd.AddCommand(webhooksListCmd)
-	webhooksCmd.AddCommand(webhooksGetCmd)
-	webhooksCmd.AddCommand(webhooksCreateCmd)
-	webhooksCmd.AddCommand(webhooksUpdateCmd)
-	webhooksCmd.AddCommand(webhooksDeleteCmd)
+	markMutating(cli.webhooksCreateCmd, cli.webhooksUpdateCmd)
+	markDestructive(cli.webhooksDeleteCmd)
 
 	// List flags
-	webhooksListCmd.Flags().IntVar(&webhooksLimit, "limit", 0, "Maximum number of webhooks to return")
-	webhooksListCmd.Flags().IntVar(&webhooksAfter, "after", 0, "Pagination cursor, get IDs greater than value")
-	webhooksListCmd.Flags().IntVar(&webhooksBefore, "before", 0, "Pagination cursor, get IDs less than value")
+	cli.webhooksListCmd.Flags().IntVar(&cli.webhooksLimit, "limit", 0, "Maximum number of webhooks to return")
+	cli.addTableFlags(cli.webhooksListCmd, webhookColumns)
+	cli.addWhereFlag(cli.webhooksListCmd)
+	cli.webhooksListCmd.Flags().IntVar(&cli.webhooksAfter, "after", 0, "Pagination cursor, get IDs greater than value")
+	cli.webhooksListCmd.Flags().IntVar(&cli.webhooksBefore, "before", 0, "Pagination cursor, get IDs less than value")
 
 	// Create flags
-	webhooksCreateCmd.Flags().StringVar(&webhooksURL, "url", "", "Webhook URL (required)")
-	webhooksCreateCmd.Flags().StringArrayVar(&webhooksEvents, "events", []string{}, "Event types to subscribe to (required, can be specified multiple times)")
-	webhooksCreateCmd.Flags().BoolVar(&webhookShowSecret, "show-secret", false, "Show webhook secret in output (security risk)")
-	mustMarkFlagRequired(webhooksCreateCmd, "url")
-	mustMarkFlagRequired(webhooksCreateCmd, "events")
+	cli.webhooksCreateCmd.Flags().StringVar(&cli.webhooksURL, "url", "", "Webhook URL (required)")
+	cli.webhooksCreateCmd.Flags().StringArrayVar(&cli.webhooksEvents, "events", []string{}, "Event types to subscribe to (required, can be specified multiple times)")
+	cli.webhooksCreateCmd.Flags().BoolVar(&cli.webhookShowSecret, "show-secret", false, "Show webhook secret in output (security risk)")
+	mustMarkFlagRequired(cli.webhooksCreateCmd, "url")
+	mustMarkFlagRequired(cli.webhooksCreateCmd, "events")
 
 	// Get flags
-	webhooksGetCmd.Flags().BoolVar(&webhookShowSecret, "show-secret", false, "Show webhook secret in output (security risk)")
+	cli.webhooksGetCmd.Flags().BoolVar(&cli.webhookShowSecret, "show-secret", false, "Show webhook secret in output (security risk)")
 
 	// Update flags
-	webhooksUpdateCmd.Flags().StringVar(&webhooksURL, "url", "", "New webhook URL")
-	webhooksUpdateCmd.Flags().StringArrayVar(&webhooksEvents, "events", []string{}, "Event types to subscribe to (can be specified multiple times)")
-	webhooksUpdateCmd.Flags().StringVar(&webhooksActive, "active", "", "Enable or disable webhook (true/false)")
+	cli.webhooksUpdateCmd.Flags().StringVar(&cli.webhooksURL, "url", "", "New webhook URL")
+	cli.webhooksUpdateCmd.Flags().StringArrayVar(&cli.webhooksEvents, "events", []string{}, "Event types to subscribe to (can be specified multiple times)")
+	cli.webhooksUpdateCmd.Flags().StringVar(&cli.webhooksActive, "active", "", "Enable or disable webhook (true/false)")
+
+	// Shell completion
+	for _, c := range []*cobra.Command{cli.webhooksGetCmd, cli.webhooksUpdateCmd, cli.webhooksDeleteCmd} {
+		c.ValidArgsFunction = cli.idCompletion("completion-webhooks", false)
+		cli.addPicker(c, "completion-webhooks")
+	}
+	for _, c := range []*cobra.Command{cli.webhooksCreateCmd, cli.webhooksUpdateCmd} {
+		mustRegisterFlagCompletion(c, "events", completeWebhookEvents)
+	}
 }
 
-func runWebhooksList(cmd *cobra.Command, args []string) error {
-	client, err := getClient()
+func (cli *CLI) runWebhooksList(cmd *cobra.Command, args []string) error {
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
-	limit := webhooksLimit
+	limit := cli.webhooksLimit
 	reqLimit := limit
-	if ((mode == outfmt.JSON && !bareJSON) || (mode == outfmt.NDJSON && withMeta)) && limit > 0 {
+	if ((mode == outfmt.JSON && !cli.bareJSON) || (mode == outfmt.NDJSON && cli.withMeta)) && limit > 0 {
 		reqLimit = limit + 1
 	}
 
-	webhooks, err := client.ListWebhooks(cmd.Context(), reqLimit, webhooksAfter, webhooksBefore)
+	webhooks, err := listFiltered(cmd.Context(), cli, reqLimit, cli.webhooksAfter, cli.webhooksBefore,
+		func(w api.Webhook) int { return w.ID },
+		func(limit, after, before int) ([]api.Webhook, error) {
+			return client.ListWebhooks(cmd.Context(), limit, after, before)
+		},
+	)
 	if err != nil {
 		return fmt.Errorf("failed to list webhooks: %w", err)
 	}
 
-	if (mode == outfmt.JSON && !bareJSON) || (mode == outfmt.NDJSON && withMeta) {
+	if (mode == outfmt.JSON && !cli.bareJSON) || (mode == outfmt.NDJSON && cli.withMeta) {
 		out := webhooks
 		hasMore := false
 		if limit > 0 && len(out) > limit {
@@ -165,10 +202,11 @@ func runWebhooksList(cmd *cobra.Command, args []string) error {
 				nextAfter = out[len(out)-1].ID
 			}
 		}
+		sortItems(cli.sortFlag, out)
 
-		if mode == outfmt.JSON && !bareJSON {
-			env := makeListEnvelope(out, len(out), limit, webhooksAfter, webhooksBefore, hasMore, nextAfter, nextBefore)
-			outputResult(mode, env, func() {})
+		if mode == outfmt.JSON && !cli.bareJSON {
+			env := makeListEnvelope(out, len(out), limit, cli.webhooksAfter, cli.webhooksBefore, hasMore, nextAfter, nextBefore)
+			cli.outputResult(mode, env, func() {})
 			return nil
 		}
 
@@ -176,8 +214,8 @@ func runWebhooksList(cmd *cobra.Command, args []string) error {
 			"_meta": map[string]any{
 				"count":       len(out),
 				"limit":       limit,
-				"after":       webhooksAfter,
-				"before":      webhooksBefore,
+				"after":       cli.webhooksAfter,
+				"before":      cli.webhooksBefore,
 				"has_more":    hasMore,
 				"next_after":  nextAfter,
 				"next_before": nextBefore,
@@ -188,94 +226,93 @@ func runWebhooksList(cmd *cobra.Command, args []string) error {
 			stream = append(stream, wh)
 		}
 		stream = append(stream, meta)
-		outputResult(mode, stream, func() {})
+		cli.outputResult(mode, stream, func() {})
 		return nil
 	}
 
-	outputResult(mode, webhooks, func() {
+	nextPage := 0
+	if len(webhooks) > 0 {
+		nextPage = webhooks[len(webhooks)-1].ID
+	}
+	sortItems(cli.sortFlag, webhooks)
+
+	cli.outputResult(mode, webhooks, func() {
 		if len(webhooks) == 0 {
-			fmt.Println("No webhooks found")
+			fmt.Fprintln(cli.stdout, "No webhooks found")
 			return
 		}
-		w := newTabWriter()
-		if _, err := fmt.Fprintln(w, "ID\tURL\tEVENTS\tACTIVE\tCREATED"); err != nil {
-			fmt.Fprintf(os.Stderr, "Error writing output: %v\n", err)
-		}
+		rows := make([][]string, 0, len(webhooks))
 		for _, wh := range webhooks {
-			events := strings.Join(wh.Events, ", ")
 			active := "Yes"
 			if !wh.Active {
 				active = "No"
 			}
-			if _, err := fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n",
-				wh.ID,
-				truncateString(wh.URL, 50),
-				truncateString(events, 40),
+			rows = append(rows, []string{
+				strconv.Itoa(wh.ID),
+				wh.URL,
+				strings.Join(wh.Events, ", "),
 				active,
 				formatTime(wh.CreatedAt),
-			); err != nil {
-				fmt.Fprintf(os.Stderr, "Error writing output: %v\n", err)
-			}
-		}
-		if err := w.Flush(); err != nil {
-			fmt.Fprintf(os.Stderr, "Error flushing output: %v\n", err)
+				formatTime(wh.UpdatedAt),
+			})
 		}
+		cli.renderTable(webhookColumns, rows)
 
 		// Pagination hint
-		if webhooksLimit > 0 && len(webhooks) == webhooksLimit {
-			fmt.Fprintf(os.Stderr, "\n# More results may be available. Use --after %d to see next page.\n", webhooks[len(webhooks)-1].ID)
+		if cli.webhooksLimit > 0 && len(webhooks) == cli.webhooksLimit {
+			cli.getLogger().Info("More results may be available; use --after to see the next page", "after", nextPage)
 		}
 	})
 
 	return nil
 }
 
-func runWebhooksGet(cmd *cobra.Command, args []string) error {
+func (cli *CLI) runWebhooksGet(cmd *cobra.Command, args []string) error {
 	id, err := parseIDArg(args[0])
 	if err != nil {
 		return fmt.Errorf("invalid webhook ID: %w", err)
 	}
 
-	client, err := getClient()
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
 	webhook, err := client.GetWebhook(cmd.Context(), id)
 	if err != nil {
 		return fmt.Errorf("failed to get webhook: %w", err)
 	}
 
-	outputResult(mode, webhook, func() {
-		fmt.Printf("ID: %d\n", webhook.ID)
-		fmt.Printf("URL: %s\n", webhook.URL)
-		fmt.Printf("Events: %s\n", strings.Join(webhook.Events, ", "))
-		fmt.Printf("Active: %t\n", webhook.Active)
+	cli.outputResult(mode, webhook, func() {
+		fmt.Fprintf(cli.stdout, "ID: %d\n", webhook.ID)
+		fmt.Fprintf(cli.stdout, "URL: %s\n", webhook.URL)
+		fmt.Fprintf(cli.stdout, "Events: %s\n", strings.Join(webhook.Events, ", "))
+		fmt.Fprintf(cli.stdout, "Active: %t\n", webhook.Active)
 		if webhook.Secret != "" {
-			if webhookShowSecret {
-				fmt.Printf("Secret: %s\n", webhook.Secret)
+			if cli.webhookShowSecret {
+				fmt.Fprintf(cli.stdout, "Secret: %s\n", webhook.Secret)
 			} else {
-				fmt.Printf("Secret: %s (use --show-secret to reveal)\n", maskSecret(webhook.Secret))
+				fmt.Fprintf(cli.stdout, "Secret: %s (use --show-secret to reveal)\n", maskSecret(webhook.Secret))
 			}
 		}
-		fmt.Printf("Created: %s\n", formatTime(webhook.CreatedAt))
-		fmt.Printf("Updated: %s\n", formatTime(webhook.UpdatedAt))
+		fmt.Fprintf(cli.stdout, "Created: %s\n", formatTime(webhook.CreatedAt))
+		fmt.Fprintf(cli.stdout, "Updated: %s\n", formatTime(webhook.UpdatedAt))
 	})
 
 	return nil
 }
 
-func runWebhooksCreate(cmd *cobra.Command, args []string) error {
-	client, err := getClient()
+func (cli *CLI) runWebhooksCreate(cmd *cobra.Command, args []string) error {
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
 	req := &api.CreateWebhookRequest{
-		URL:    webhooksURL,
-		Events: webhooksEvents,
+		URL:    cli.webhooksURL,
+		Events: cli.webhooksEvents,
 	}
 
 	webhook, err := client.CreateWebhook(cmd.Context(), req)
@@ -283,15 +320,15 @@ func runWebhooksCreate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to create webhook: %w", err)
 	}
 
-	outputResult(mode, webhook, func() {
-		fmt.Printf("Created webhook %d\n", webhook.ID)
-		fmt.Printf("URL: %s\n", webhook.URL)
-		fmt.Printf("Events: %s\n", strings.Join(webhook.Events, ", "))
+	cli.outputResult(mode, webhook, func() {
+		fmt.Fprintf(cli.stdout, "Created webhook %d\n", webhook.ID)
+		fmt.Fprintf(cli.stdout, "URL: %s\n", webhook.URL)
+		fmt.Fprintf(cli.stdout, "Events: %s\n", strings.Join(webhook.Events, ", "))
 		if webhook.Secret != "" {
-			if webhookShowSecret {
-				fmt.Printf("Secret: %s\n", webhook.Secret)
+			if cli.webhookShowSecret {
+				fmt.Fprintf(cli.stdout, "Secret: %s\n", webhook.Secret)
 			} else {
-				fmt.Printf("Secret: %s (use --show-secret to reveal)\n", maskSecret(webhook.Secret))
+				fmt.Fprintf(cli.stdout, "Secret: %s (use --show-secret to reveal)\n", maskSecret(webhook.Secret))
 			}
 		}
 	})
@@ -299,26 +336,26 @@ func runWebhooksCreate(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func runWebhooksUpdate(cmd *cobra.Command, args []string) error {
+func (cli *CLI) runWebhooksUpdate(cmd *cobra.Command, args []string) error {
 	id, err := parseIDArg(args[0])
 	if err != nil {
 		return fmt.Errorf("invalid webhook ID: %w", err)
 	}
 
-	client, err := getClient()
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
-	mode := getOutputMode()
+	mode := cli.getOutputMode()
 
 	req := &api.UpdateWebhookRequest{
-		URL:    webhooksURL,
-		Events: webhooksEvents,
+		URL:    cli.webhooksURL,
+		Events: cli.webhooksEvents,
 	}
 
 	// Parse active flag if provided
-	if webhooksActive != "" {
-		active, err := strconv.ParseBool(webhooksActive)
+	if cli.webhooksActive != "" {
+		active, err := strconv.ParseBool(cli.webhooksActive)
 		if err != nil {
 			return fmt.Errorf("invalid active value: must be true or false")
 		}
@@ -330,36 +367,39 @@ func runWebhooksUpdate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to update webhook: %w", err)
 	}
 
-	outputResult(mode, webhook, func() {
-		fmt.Printf("Updated webhook %d\n", webhook.ID)
-		fmt.Printf("URL: %s\n", webhook.URL)
-		fmt.Printf("Events: %s\n", strings.Join(webhook.Events, ", "))
-		fmt.Printf("Active: %t\n", webhook.Active)
+	cli.outputResult(mode, webhook, func() {
+		fmt.Fprintf(cli.stdout, "Updated webhook %d\n", webhook.ID)
+		fmt.Fprintf(cli.stdout, "URL: %s\n", webhook.URL)
+		fmt.Fprintf(cli.stdout, "Events: %s\n", strings.Join(webhook.Events, ", "))
+		fmt.Fprintf(cli.stdout, "Active: %t\n", webhook.Active)
 	})
 
 	return nil
 }
 
-func runWebhooksDelete(cmd *cobra.Command, args []string) error {
+func (cli *CLI) runWebhooksDelete(cmd *cobra.Command, args []string) error {
 	id, err := parseIDArg(args[0])
 	if err != nil {
 		return fmt.Errorf("invalid webhook ID: %w", err)
 	}
 
-	if dryRunPreview("delete webhook %d", id) {
-		return nil
-	}
-
-	client, err := getClient()
+	client, err := cli.getClient()
 	if err != nil {
 		return err
 	}
 
+	mode := cli.getOutputMode()
+
 	err = client.DeleteWebhook(cmd.Context(), id)
 	if err != nil {
 		return fmt.Errorf("failed to delete webhook: %w", err)
 	}
 
-	fmt.Printf("Deleted webhook %d\n", id)
+	cli.outputResult(mode, map[string]any{
+		"id":      id,
+		"deleted": true,
+	}, func() {
+		fmt.Fprintf(cli.stdout, "Deleted webhook %d\n", id)
+	})
 	return nil
 }
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/docuseal/docuseal-cli/internal/api"
+	"github.com/docuseal/docuseal-cli/internal/filter"
+	"github.com/spf13/cobra"
+)
+
+// whereState holds the --where flag of a CLI.
+type whereState struct {
+	whereFlag string
+}
+
+// wherePageSize is the page size used while scanning pages for --where matches.
+const wherePageSize = 100
+
+// addWhereFlag adds --where to a list command.
+func (cli *CLI) addWhereFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&cli.whereFlag, "where", "", `Filter results client-side with an expression (e.g. 'status == "pending" && created_at < now-7d'); pages through results until --limit matches are found`)
+}
+
+// parseWhere compiles --where, or returns nil when it is unset.
+func (cli *CLI) parseWhere() (*filter.Expr, error) {
+	if cli.whereFlag == "" {
+		return nil, nil
+	}
+	expr, err := filter.Parse(cli.whereFlag)
+	if err != nil {
+		return nil, &api.ValidationError{Field: "where", Message: err.Error()}
+	}
+	return expr, nil
+}
+
+// listFiltered fetches a list page. Without --where it makes a single request for limit items.
+// With --where it follows the pagination cursor, keeping only matching items, until limit
+// matches are collected (all pages when limit is 0) or the server has no more items.
+func listFiltered[T any](ctx context.Context, cli *CLI, limit, after, before int, id func(T) int, fetch func(limit, after, before int) ([]T, error)) ([]T, error) {
+	expr, err := cli.parseWhere()
+	if err != nil {
+		return nil, err
+	}
+	if expr == nil {
+		return fetch(limit, after, before)
+	}
+
+	now := cli.now()
+	var matches []T
+	var matchErr error
+	err = walkPages(after, before, wherePageSize, id, fetch, func(item T) bool {
+		ok, err := expr.Match(item, now)
+		if err != nil {
+			matchErr = &api.ValidationError{Field: "where", Message: fmt.Sprintf("item %d: %v", id(item), err)}
+			return false
+		}
+		if ok {
+			matches = append(matches, item)
+		}
+		return limit <= 0 || len(matches) < limit
+	})
+	if err != nil {
+		return nil, err
+	}
+	if matchErr != nil {
+		return nil, matchErr
+	}
+	return matches, nil
+}
+
+// walkPages requests pages of pageSize items starting at the after/before cursor and calls
+// visit for each item until visit returns false or the server has no more items.
+func walkPages[T any](after, before, pageSize int, id func(T) int, fetch func(limit, after, before int) ([]T, error), visit func(T) bool) error {
+	for {
+		page, err := fetch(pageSize, after, before)
+		if err != nil {
+			return err
+		}
+		for _, item := range page {
+			if !visit(item) {
+				return nil
+			}
+		}
+		if len(page) < pageSize {
+			return nil
+		}
+
+		// Follow the cursor in whichever direction the server sorts: newest-first lists
+		// continue before the last ID, oldest-first lists after it.
+		first, last := id(page[0]), id(page[len(page)-1])
+		if first > last {
+			if before > 0 && last >= before {
+				return nil
+			}
+			before = last
+		} else {
+			if last <= after {
+				return nil
+			}
+			after = last
+		}
+	}
+}
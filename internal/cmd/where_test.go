@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+type whereItem struct {
+	ID     int    `json:"id"`
+	Status string `json:"status"`
+}
+
+// fakeListPages serves ids 1..total newest-first (or oldest-first) honoring after/before.
+func fakeListPages(total int, newestFirst bool, calls *int) func(limit, after, before int) ([]whereItem, error) {
+	return func(limit, after, before int) ([]whereItem, error) {
+		*calls++
+		var out []whereItem
+		for i := 1; i <= total; i++ {
+			id := i
+			if newestFirst {
+				id = total - i + 1
+			}
+			if (after > 0 && id <= after) || (before > 0 && id >= before) {
+				continue
+			}
+			status := "completed"
+			if id%50 == 0 {
+				status = "pending"
+			}
+			out = append(out, whereItem{ID: id, Status: status})
+			if limit > 0 && len(out) == limit {
+				break
+			}
+		}
+		return out, nil
+	}
+}
+
+func TestListFiltered(t *testing.T) {
+	id := func(w whereItem) int { return w.ID }
+
+	tests := []struct {
+		name        string
+		where       string
+		limit       int
+		newestFirst bool
+		wantIDs     []int
+		wantCalls   int
+	}{
+		{name: "no filter is a single request", limit: 2, newestFirst: true, wantIDs: []int{250, 249}, wantCalls: 1},
+		{name: "newest first pages via before", where: `status == "pending"`, newestFirst: true, wantIDs: []int{250, 200, 150, 100, 50}, wantCalls: 3},
+		{name: "oldest first pages via after", where: `status == "pending"`, wantIDs: []int{50, 100, 150, 200, 250}, wantCalls: 3},
+		{name: "stops at limit", where: `status == "pending"`, limit: 2, newestFirst: true, wantIDs: []int{250, 200}, wantCalls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cli := New(Options{})
+			cli.whereFlag = tt.where
+			calls := 0
+			items, err := listFiltered(context.Background(), cli, tt.limit, 0, 0, id, fakeListPages(250, tt.newestFirst, &calls))
+			if err != nil {
+				t.Fatalf("listFiltered() error = %v", err)
+			}
+			var got []int
+			for _, it := range items {
+				got = append(got, it.ID)
+			}
+			if !reflect.DeepEqual(got, tt.wantIDs) {
+				t.Errorf("listFiltered() ids = %v, want %v", got, tt.wantIDs)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("listFiltered() made %d requests, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestListFilteredInvalidWhere(t *testing.T) {
+	cli := New(Options{})
+	cli.whereFlag = `status ==`
+	calls := 0
+	_, err := listFiltered(context.Background(), cli, 0, 0, 0, func(w whereItem) int { return w.ID }, fakeListPages(1, true, &calls))
+	if err == nil || classifyError(err) != "validation" {
+		t.Fatalf("listFiltered() error = %v, want validation error", err)
+	}
+	if calls != 0 {
+		t.Errorf("listFiltered() made %d requests before validating --where", calls)
+	}
+}
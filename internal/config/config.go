@@ -22,6 +22,7 @@ const (
 	sharedCredentialsDirEnvName       = "CW_CREDENTIALS_DIR"
 	sharedCredentialsDirLegacyEnvName = "OPENCLAW_CREDENTIALS_DIR" // #nosec G101 -- env var name, not a credential value
 	dbusSessionEnvName                = "DBUS_SESSION_BUS_ADDRESS"
+	configDirEnvName                  = "DOCUSEAL_CONFIG_DIR"
 )
 
 // Credentials holds DocuSeal connection details
@@ -88,10 +89,70 @@ func keyringFileDir() string {
 	return filepath.Join(homeDir, ".config", "docuseal", "keyring")
 }
 
+// Dir returns the directory for CLI state files (policy, history, caches).
+// DOCUSEAL_CONFIG_DIR overrides the default of ~/.config/docuseal.
+func Dir() (string, error) {
+	if dir := strings.TrimSpace(os.Getenv(configDirEnvName)); dir != "" {
+		return dir, nil
+	}
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to determine home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".config", "docuseal"), nil
+}
+
 func shouldForceFileBackend(goosValue, dbusAddr string) bool {
 	return goosValue == "linux" && strings.TrimSpace(dbusAddr) == ""
 }
 
+// KeyringInfo describes the keyring credentials are stored in.
+type KeyringInfo struct {
+	// Backend is the backend keyring.Open settles on ("" when none opens).
+	Backend string `json:"backend"`
+	// Candidates are the backends tried, in order.
+	Candidates []string `json:"candidates"`
+	// ForcedFile says why only the file backend is allowed ("" when it is not forced).
+	ForcedFile string `json:"forced_file,omitempty"`
+	// FileDir is the file backend's directory.
+	FileDir string `json:"file_dir,omitempty"`
+	// DefaultPassphrase is true when the file backend uses the built-in passphrase
+	// because DOCUSEAL_KEYRING_PASSWORD is unset.
+	DefaultPassphrase bool `json:"default_passphrase"`
+}
+
+// DescribeKeyring reports which keyring backend Load and Save use and why.
+func DescribeKeyring() KeyringInfo {
+	cfg := keyringConfig()
+	_, passphraseSet := os.LookupEnv(keyringPasswordEnvName)
+	info := KeyringInfo{FileDir: cfg.FileDir, DefaultPassphrase: !passphraseSet}
+
+	switch {
+	case os.Getenv("KEYRING_BACKEND") == "file":
+		info.ForcedFile = "KEYRING_BACKEND=file"
+	case shouldForceFileBackend(runtime.GOOS, os.Getenv(dbusSessionEnvName)):
+		info.ForcedFile = "Linux session without " + dbusSessionEnvName
+	}
+
+	backends := cfg.AllowedBackends
+	if backends == nil {
+		backends = keyring.AvailableBackends()
+	}
+	for _, b := range backends {
+		info.Candidates = append(info.Candidates, string(b))
+	}
+	// Open each candidate alone, in order, to learn which one keyring.Open would pick.
+	for _, b := range backends {
+		one := cfg
+		one.AllowedBackends = []keyring.BackendType{b}
+		if _, err := keyring.Open(one); err == nil {
+			info.Backend = string(b)
+			break
+		}
+	}
+	return info
+}
+
 // Load retrieves credentials with env var override
 // Priority: 1. Environment variables, 2. Keychain
 func Load() (Credentials, error) {
@@ -131,6 +131,21 @@ func TestKeyringConfig_ExplicitFileBackend(t *testing.T) {
 	}
 }
 
+func TestDescribeKeyring_ExplicitFileBackend(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("KEYRING_BACKEND", "file")
+	t.Setenv("KEYRING_FILE_DIR", dir)
+	t.Setenv(keyringPasswordEnvName, "secret")
+
+	info := DescribeKeyring()
+	if info.Backend != string(keyring.FileBackend) || info.ForcedFile != "KEYRING_BACKEND=file" {
+		t.Fatalf("DescribeKeyring() = %+v, want forced file backend", info)
+	}
+	if info.FileDir != dir || info.DefaultPassphrase {
+		t.Fatalf("DescribeKeyring() = %+v, want dir %q and custom passphrase", info, dir)
+	}
+}
+
 func TestLoad_EnvironmentVariables(t *testing.T) {
 	// Set up file backend to avoid macOS Keychain prompts in CI
 	tmpDir := t.TempDir()
@@ -1010,3 +1025,73 @@ func TestDelete_Integration(t *testing.T) {
 		}
 	})
 }
+
+func TestDir(t *testing.T) {
+	t.Run("DOCUSEAL_CONFIG_DIR overrides default", func(t *testing.T) {
+		t.Setenv(configDirEnvName, "/tmp/docuseal-config")
+		got, err := Dir()
+		if err != nil {
+			t.Fatalf("Dir() error = %v", err)
+		}
+		if got != "/tmp/docuseal-config" {
+			t.Fatalf("Dir() = %q, want %q", got, "/tmp/docuseal-config")
+		}
+	})
+
+	t.Run("defaults under home directory", func(t *testing.T) {
+		t.Setenv(configDirEnvName, "")
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+		t.Setenv("USERPROFILE", home)
+		got, err := Dir()
+		if err != nil {
+			t.Fatalf("Dir() error = %v", err)
+		}
+		want := filepath.Join(home, ".config", "docuseal")
+		if got != want {
+			t.Fatalf("Dir() = %q, want %q", got, want)
+		}
+	})
+}
+
+func TestSaveProfile(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(configDirEnvName, dir)
+	t.Setenv("KEYRING_BACKEND", "file")
+	t.Setenv("KEYRING_FILE_DIR", t.TempDir())
+
+	if p, err := LoadProfile("a.example.com"); err != nil || p.APIPath != "" || p.Headers != nil {
+		t.Fatalf("LoadProfile() without a file = %+v, %v", p, err)
+	}
+	if err := SaveProfile("a.example.com", Profile{APIPath: "/docuseal/api", Headers: map[string]string{"X-Tenant": "acme"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := SaveProfile("b.example.com", Profile{APIPath: "auto"}); err != nil {
+		t.Fatal(err)
+	}
+	p, err := LoadProfile("a.example.com")
+	if err != nil || p.APIPath != "/docuseal/api" || p.Headers["X-Tenant"] != "acme" {
+		t.Fatalf("LoadProfile() = %+v, %v", p, err)
+	}
+	info, err := os.Stat(filepath.Join(dir, ProfilesFileName))
+	if err != nil || info.Mode().Perm() != 0o600 {
+		t.Fatalf("profiles file mode = %v, %v, want 0600", info, err)
+	}
+	// Header values stay out of the file.
+	data, err := os.ReadFile(filepath.Join(dir, ProfilesFileName))
+	if err != nil || !strings.Contains(string(data), `"X-Tenant"`) || strings.Contains(string(data), "acme") {
+		t.Fatalf("profiles file = %s, %v; want the header name without its value", data, err)
+	}
+
+	// Saving empty settings removes the profile.
+	if err := SaveProfile("a.example.com", Profile{}); err != nil {
+		t.Fatal(err)
+	}
+	profiles, err := loadProfiles()
+	if err != nil || len(profiles) != 1 || profiles["b.example.com"].APIPath != "auto" {
+		t.Fatalf("loadProfiles() = %+v, %v", profiles, err)
+	}
+	if values, err := loadHeaderValues("a.example.com"); err != nil || len(values) != 0 {
+		t.Fatalf("header values after removal = %v, %v", values, err)
+	}
+}
@@ -0,0 +1,182 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/99designs/keyring"
+)
+
+// ProfilesFileName is the file in Dir that holds per-profile settings.
+const ProfilesFileName = "profiles.json"
+
+// Profile holds the connection settings of one server (profile), keyed by host, that
+// are not credentials: where the API lives and the headers a gateway in front of it needs.
+type Profile struct {
+	// APIPath is the path of the API below the URL ("/api" when empty, "/" for the root,
+	// "auto" to probe for it).
+	APIPath string
+	// Headers are sent with every request, e.g. {"CF-Access-Client-Id": "..."}. Their
+	// values are often gateway credentials, so only the names are written to the
+	// profiles file and the values are kept in the keyring with the API key.
+	Headers map[string]string
+}
+
+// storedProfile is a Profile as written to the profiles file.
+type storedProfile struct {
+	APIPath string   `json:"api_path,omitempty"`
+	Headers []string `json:"headers,omitempty"`
+}
+
+// loadProfiles reads every profile from Dir; a missing file yields none.
+func loadProfiles() (map[string]storedProfile, error) {
+	path, err := profilesPath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return map[string]storedProfile{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read profiles: %w", err)
+	}
+	profiles := map[string]storedProfile{}
+	if err := json.Unmarshal(data, &profiles); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return profiles, nil
+}
+
+// LoadProfile returns the settings of the named profile (zero when none are stored),
+// reading header values from the keyring.
+func LoadProfile(name string) (Profile, error) {
+	profiles, err := loadProfiles()
+	if err != nil {
+		return Profile{}, err
+	}
+	stored, ok := profiles[name]
+	if !ok {
+		return Profile{}, nil
+	}
+	p := Profile{APIPath: stored.APIPath}
+	if len(stored.Headers) == 0 {
+		return p, nil
+	}
+
+	values, err := loadHeaderValues(name)
+	if err != nil {
+		return Profile{}, err
+	}
+	p.Headers = make(map[string]string, len(stored.Headers))
+	for _, header := range stored.Headers {
+		value, ok := values[header]
+		if !ok {
+			return Profile{}, fmt.Errorf("profile %s: the value of header %s is missing from the keyring; pass it again with --header", name, header)
+		}
+		p.Headers[header] = value
+	}
+	return p, nil
+}
+
+// SaveProfile stores the settings of the named profile, keeping the others: header
+// values go to the keyring and everything else to the profiles file.
+func SaveProfile(name string, p Profile) error {
+	profiles, err := loadProfiles()
+	if err != nil {
+		return err
+	}
+	// Only touch the keyring when there are header values to store or remove.
+	if len(p.Headers) > 0 || len(profiles[name].Headers) > 0 {
+		if err := saveHeaderValues(name, p.Headers); err != nil {
+			return err
+		}
+	}
+	if p.APIPath == "" && len(p.Headers) == 0 {
+		delete(profiles, name)
+	} else {
+		stored := storedProfile{APIPath: p.APIPath}
+		for header := range p.Headers {
+			stored.Headers = append(stored.Headers, header)
+		}
+		sort.Strings(stored.Headers)
+		profiles[name] = stored
+	}
+
+	path, err := profilesPath()
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(profiles, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal profiles: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to save profiles: %w", err)
+	}
+	return nil
+}
+
+// headersKey returns the keyring item that holds the header values of a profile.
+func headersKey(name string) string {
+	return "headers:" + name
+}
+
+// loadHeaderValues reads the header values of the named profile from the keyring.
+func loadHeaderValues(name string) (map[string]string, error) {
+	ring, err := keyring.Open(keyringConfig())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open keyring: %w", err)
+	}
+	item, err := ring.Get(headersKey(name))
+	if errors.Is(err, keyring.ErrKeyNotFound) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile headers: %w", err)
+	}
+	var values map[string]string
+	if err := json.Unmarshal(item.Data, &values); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal profile headers: %w", err)
+	}
+	return values, nil
+}
+
+// saveHeaderValues stores the header values of the named profile in the keyring,
+// removing them when there are none.
+func saveHeaderValues(name string, headers map[string]string) error {
+	ring, err := keyring.Open(keyringConfig())
+	if err != nil {
+		return fmt.Errorf("failed to open keyring: %w", err)
+	}
+	if len(headers) == 0 {
+		err := ring.Remove(headersKey(name))
+		if err != nil && !errors.Is(err, keyring.ErrKeyNotFound) && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove profile headers: %w", err)
+		}
+		return nil
+	}
+	data, err := json.Marshal(headers)
+	if err != nil {
+		return fmt.Errorf("failed to marshal profile headers: %w", err)
+	}
+	if err := ring.Set(keyring.Item{Key: headersKey(name), Data: data}); err != nil {
+		return fmt.Errorf("failed to save profile headers: %w", err)
+	}
+	return nil
+}
+
+func profilesPath() (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, ProfilesFileName), nil
+}
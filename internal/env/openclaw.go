@@ -16,18 +16,22 @@ var (
 	readFile    = os.ReadFile
 	setEnv      = os.Setenv
 	lookupEnv   = os.LookupEnv
+
+	// loadedKeys are the variables the last LoadOpenClawEnv call set.
+	loadedKeys []string
 )
 
 // LoadOpenClawEnv loads environment variables from ~/.openclaw/.env when it exists.
 //
 // Existing environment variables are not overwritten.
 func LoadOpenClawEnv() error {
-	home, err := userHomeDir()
-	if err != nil || strings.TrimSpace(home) == "" {
+	loadedKeys = nil
+	path := OpenClawPath()
+	if path == "" {
 		return nil
 	}
 
-	return loadDotEnvFile(filepath.Join(home, openClawEnvRelativePath))
+	return loadDotEnvFile(path)
 }
 
 func loadDotEnvFile(path string) error {
@@ -65,6 +69,7 @@ func loadDotEnvFile(path string) error {
 		if err := setEnv(key, value); err != nil {
 			return fmt.Errorf("set env %s from %s:%d: %w", key, path, lineNo, err)
 		}
+		loadedKeys = append(loadedKeys, key)
 	}
 	if err := scanner.Err(); err != nil {
 		return fmt.Errorf("scan %s: %w", path, err)
@@ -72,6 +77,21 @@ func loadDotEnvFile(path string) error {
 	return nil
 }
 
+// OpenClawPath returns the path of the OpenClaw env file ("" without a home directory).
+func OpenClawPath() string {
+	home, err := userHomeDir()
+	if err != nil || strings.TrimSpace(home) == "" {
+		return ""
+	}
+	return filepath.Join(home, openClawEnvRelativePath)
+}
+
+// OpenClawKeys returns the variables LoadOpenClawEnv set, in file order. Keys that were
+// already in the environment are not included.
+func OpenClawKeys() []string {
+	return append([]string(nil), loadedKeys...)
+}
+
 func normalizeEnvValue(value string) string {
 	if value == "" {
 		return value
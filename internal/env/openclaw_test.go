@@ -80,6 +80,10 @@ func TestLoadOpenClawEnv_LoadsValuesWithoutOverwritingExisting(t *testing.T) {
 	if got := os.Getenv("EXISTING"); got != "from-env" {
 		t.Fatalf("EXISTING = %q, want %q", got, "from-env")
 	}
+	want := "DOCUSEAL_URL,DOCUSEAL_API_KEY,QUOTED_WITH_COMMENT,QUOTED_WITH_HASH,CW_CREDENTIALS_DIR"
+	if got := strings.Join(OpenClawKeys(), ","); got != want {
+		t.Fatalf("OpenClawKeys() = %s, want %s", got, want)
+	}
 }
 
 func TestLoadOpenClawEnv_ReadError(t *testing.T) {
@@ -0,0 +1,565 @@
+// Package filter implements the --where expression language used to filter list results
+// client-side.
+//
+// Expressions are evaluated against the JSON form of each item:
+//
+//	status == "pending" && created_at < now-7d && submitters.any(s, s.opened_at == null)
+//
+// Supported syntax:
+//   - literals: "strings", 'strings', numbers, true, false, null, durations (30s, 15m, 36h, 7d, 2w),
+//     lists ["a", "b"], and now
+//   - fields: name, nested.name, items.0.name, items[0]
+//   - operators: || && ! == != < <= > >= =~ (regular expression) in, plus + and - for
+//     time/duration arithmetic
+//   - methods: list.any(x, expr), list.all(x, expr), value.size(),
+//     value.contains(v), s.startsWith(v), s.endsWith(v)
+//
+// Missing fields evaluate to null. Strings are compared as times when the other side is a time
+// (now, or the result of time arithmetic), so RFC 3339 timestamps and plain dates both work.
+package filter
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SyntaxError reports an invalid expression.
+type SyntaxError struct {
+	Pos int
+	Msg string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("at position %d: %s", e.Pos+1, e.Msg)
+}
+
+// Expr is a compiled filter expression. It is safe for concurrent use.
+type Expr struct {
+	src  string
+	root node
+}
+
+// Parse compiles a filter expression.
+func Parse(src string) (*Expr, error) {
+	if strings.TrimSpace(src) == "" {
+		return nil, &SyntaxError{Msg: "empty expression"}
+	}
+	toks, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{toks: toks}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, &SyntaxError{Pos: t.pos, Msg: fmt.Sprintf("unexpected %q", t.text)}
+	}
+	return &Expr{src: src, root: root}, nil
+}
+
+// String returns the source of the expression.
+func (e *Expr) String() string { return e.src }
+
+// Match reports whether v satisfies the expression. v is converted to its JSON form first,
+// so field names are the JSON names (e.g. created_at). now is the value of the now keyword.
+func (e *Expr) Match(v any, now time.Time) (bool, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode item: %w", err)
+	}
+	var doc any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return false, fmt.Errorf("failed to decode item: %w", err)
+	}
+	result, err := e.root.eval(&env{root: doc, now: now})
+	if err != nil {
+		return false, err
+	}
+	return truthy(result), nil
+}
+
+// env is the evaluation scope: the item, the current time, and any-/all- variables.
+type env struct {
+	root any
+	now  time.Time
+	vars map[string]any
+}
+
+func (e *env) with(name string, v any) *env {
+	vars := make(map[string]any, len(e.vars)+1)
+	for k, val := range e.vars {
+		vars[k] = val
+	}
+	vars[name] = v
+	return &env{root: e.root, now: e.now, vars: vars}
+}
+
+type node interface {
+	eval(*env) (any, error)
+}
+
+type (
+	literalNode struct{ v any }
+	nowNode     struct{}
+	identNode   struct{ name string }
+	fieldNode   struct {
+		target node
+		name   string
+	}
+	indexNode struct {
+		target node
+		index  node
+	}
+	listNode  struct{ elems []node }
+	unaryNode struct {
+		op string
+		x  node
+	}
+	binaryNode struct {
+		op   string
+		l, r node
+		re   *regexp.Regexp // precompiled when the =~ pattern is a literal
+	}
+	methodNode struct {
+		target node
+		name   string
+		args   []node
+		param  string // variable bound by any/all
+		body   node
+	}
+)
+
+func (n literalNode) eval(*env) (any, error) { return n.v, nil }
+
+func (nowNode) eval(e *env) (any, error) { return e.now, nil }
+
+func (n identNode) eval(e *env) (any, error) {
+	if v, ok := e.vars[n.name]; ok {
+		return v, nil
+	}
+	return member(e.root, n.name), nil
+}
+
+func (n fieldNode) eval(e *env) (any, error) {
+	v, err := n.target.eval(e)
+	if err != nil {
+		return nil, err
+	}
+	return member(v, n.name), nil
+}
+
+func (n indexNode) eval(e *env) (any, error) {
+	v, err := n.target.eval(e)
+	if err != nil {
+		return nil, err
+	}
+	idx, err := n.index.eval(e)
+	if err != nil {
+		return nil, err
+	}
+	switch key := idx.(type) {
+	case float64:
+		return member(v, strconv.Itoa(int(key))), nil
+	case string:
+		return member(v, key), nil
+	default:
+		return nil, nil
+	}
+}
+
+func (n listNode) eval(e *env) (any, error) {
+	out := make([]any, 0, len(n.elems))
+	for _, el := range n.elems {
+		v, err := el.eval(e)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+func (n unaryNode) eval(e *env) (any, error) {
+	v, err := n.x.eval(e)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "!":
+		return !truthy(v), nil
+	case "-":
+		switch x := v.(type) {
+		case float64:
+			return -x, nil
+		case time.Duration:
+			return -x, nil
+		case nil:
+			return nil, nil
+		}
+		return nil, fmt.Errorf("cannot negate %s", typeName(v))
+	}
+	return nil, fmt.Errorf("unknown operator %q", n.op)
+}
+
+func (n binaryNode) eval(e *env) (any, error) {
+	l, err := n.l.eval(e)
+	if err != nil {
+		return nil, err
+	}
+	// Short-circuit so guards like `x != null && x.size() > 0` work.
+	switch n.op {
+	case "&&":
+		if !truthy(l) {
+			return false, nil
+		}
+		r, err := n.r.eval(e)
+		return truthy(r), err
+	case "||":
+		if truthy(l) {
+			return true, nil
+		}
+		r, err := n.r.eval(e)
+		return truthy(r), err
+	}
+
+	r, err := n.r.eval(e)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "==":
+		return equal(l, r), nil
+	case "!=":
+		return !equal(l, r), nil
+	case "<", "<=", ">", ">=":
+		c, ok := compare(l, r)
+		if !ok {
+			return false, nil
+		}
+		switch n.op {
+		case "<":
+			return c < 0, nil
+		case "<=":
+			return c <= 0, nil
+		case ">":
+			return c > 0, nil
+		default:
+			return c >= 0, nil
+		}
+	case "=~":
+		s, ok := l.(string)
+		if !ok {
+			return false, nil
+		}
+		re := n.re
+		if re == nil {
+			pattern, ok := r.(string)
+			if !ok {
+				return nil, fmt.Errorf("=~ needs a string pattern, got %s", typeName(r))
+			}
+			if re, err = regexp.Compile(pattern); err != nil {
+				return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+			}
+		}
+		return re.MatchString(s), nil
+	case "in":
+		switch list := r.(type) {
+		case []any:
+			for _, el := range list {
+				if equal(l, el) {
+					return true, nil
+				}
+			}
+			return false, nil
+		case string:
+			s, ok := l.(string)
+			return ok && strings.Contains(list, s), nil
+		case nil:
+			return false, nil
+		}
+		return nil, fmt.Errorf("in needs a list, got %s", typeName(r))
+	case "+", "-":
+		return arith(n.op, l, r)
+	}
+	return nil, fmt.Errorf("unknown operator %q", n.op)
+}
+
+func (n methodNode) eval(e *env) (any, error) {
+	target, err := n.target.eval(e)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.name {
+	case "any", "all":
+		list, _ := target.([]any)
+		for _, el := range list {
+			v, err := n.body.eval(e.with(n.param, el))
+			if err != nil {
+				return nil, err
+			}
+			if truthy(v) == (n.name == "any") {
+				return n.name == "any", nil
+			}
+		}
+		return n.name == "all", nil
+	case "size":
+		switch t := target.(type) {
+		case []any:
+			return float64(len(t)), nil
+		case map[string]any:
+			return float64(len(t)), nil
+		case string:
+			return float64(len([]rune(t))), nil
+		}
+		return float64(0), nil
+	}
+
+	arg, err := n.args[0].eval(e)
+	if err != nil {
+		return nil, err
+	}
+	switch n.name {
+	case "contains":
+		switch t := target.(type) {
+		case string:
+			s, ok := arg.(string)
+			return ok && strings.Contains(t, s), nil
+		case []any:
+			for _, el := range t {
+				if equal(el, arg) {
+					return true, nil
+				}
+			}
+		case map[string]any:
+			s, ok := arg.(string)
+			if ok {
+				_, has := t[s]
+				return has, nil
+			}
+		}
+		return false, nil
+	case "startsWith", "endsWith":
+		s, ok1 := target.(string)
+		affix, ok2 := arg.(string)
+		if !ok1 || !ok2 {
+			return false, nil
+		}
+		if n.name == "startsWith" {
+			return strings.HasPrefix(s, affix), nil
+		}
+		return strings.HasSuffix(s, affix), nil
+	}
+	return nil, fmt.Errorf("unknown method %q", n.name)
+}
+
+// member returns v[name] for objects, or v[i] for lists when name is an index.
+func member(v any, name string) any {
+	switch t := v.(type) {
+	case map[string]any:
+		return t[name]
+	case []any:
+		i, err := strconv.Atoi(name)
+		if err != nil || i < 0 || i >= len(t) {
+			return nil
+		}
+		return t[i]
+	}
+	return nil
+}
+
+func truthy(v any) bool {
+	switch t := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return t
+	case float64:
+		return t != 0
+	case string:
+		return t != ""
+	case []any:
+		return len(t) > 0
+	case map[string]any:
+		return len(t) > 0
+	case time.Time:
+		return !t.IsZero()
+	case time.Duration:
+		return t != 0
+	}
+	return true
+}
+
+// timeLayouts are accepted when a string is compared with a time.
+var timeLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02"}
+
+func parseTime(s string) (time.Time, bool) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// coerce converts a string operand to a time when the other operand is a time.
+func coerce(l, r any) (any, any) {
+	if _, ok := r.(time.Time); ok {
+		if s, ok := l.(string); ok {
+			if t, ok := parseTime(s); ok {
+				l = t
+			}
+		}
+	}
+	if _, ok := l.(time.Time); ok {
+		if s, ok := r.(string); ok {
+			if t, ok := parseTime(s); ok {
+				r = t
+			}
+		}
+	}
+	return l, r
+}
+
+func equal(l, r any) bool {
+	l, r = coerce(l, r)
+	switch a := l.(type) {
+	case nil:
+		return r == nil
+	case time.Time:
+		b, ok := r.(time.Time)
+		return ok && a.Equal(b)
+	case []any:
+		b, ok := r.([]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !equal(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]any:
+		b, ok := r.(map[string]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for k, v := range a {
+			if !equal(v, b[k]) {
+				return false
+			}
+		}
+		return true
+	}
+	c, ok := compare(l, r)
+	if ok {
+		return c == 0
+	}
+	return l == r
+}
+
+// compare orders two values of the same kind. ok is false when they are not comparable.
+func compare(l, r any) (c int, ok bool) {
+	l, r = coerce(l, r)
+	switch a := l.(type) {
+	case float64:
+		if b, ok := r.(float64); ok {
+			return cmp(a < b, a > b), true
+		}
+	case string:
+		if b, ok := r.(string); ok {
+			return strings.Compare(a, b), true
+		}
+	case time.Time:
+		if b, ok := r.(time.Time); ok {
+			return cmp(a.Before(b), a.After(b)), true
+		}
+	case time.Duration:
+		if b, ok := r.(time.Duration); ok {
+			return cmp(a < b, a > b), true
+		}
+	case bool:
+		if b, ok := r.(bool); ok {
+			return cmp(!a && b, a && !b), true
+		}
+	}
+	return 0, false
+}
+
+func cmp(less, greater bool) int {
+	switch {
+	case less:
+		return -1
+	case greater:
+		return 1
+	}
+	return 0
+}
+
+func arith(op string, l, r any) (any, error) {
+	if l == nil || r == nil {
+		return nil, nil
+	}
+	sign := time.Duration(1)
+	if op == "-" {
+		sign = -1
+	}
+	switch a := l.(type) {
+	case time.Time:
+		switch b := r.(type) {
+		case time.Duration:
+			return a.Add(sign * b), nil
+		case time.Time:
+			if op == "-" {
+				return a.Sub(b), nil
+			}
+		}
+	case string:
+		if t, ok := parseTime(a); ok {
+			if b, ok := r.(time.Duration); ok {
+				return t.Add(sign * b), nil
+			}
+		}
+	case time.Duration:
+		if b, ok := r.(time.Duration); ok {
+			return a + sign*b, nil
+		}
+	case float64:
+		if b, ok := r.(float64); ok {
+			if op == "-" {
+				return a - b, nil
+			}
+			return a + b, nil
+		}
+	}
+	return nil, fmt.Errorf("cannot apply %s to %s and %s", op, typeName(l), typeName(r))
+}
+
+func typeName(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "bool"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "list"
+	case map[string]any:
+		return "object"
+	case time.Time:
+		return "time"
+	case time.Duration:
+		return "duration"
+	}
+	return fmt.Sprintf("%T", v)
+}
@@ -0,0 +1,94 @@
+package filter
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMatch(t *testing.T) {
+	now := time.Date(2024, 5, 20, 12, 0, 0, 0, time.UTC)
+	item := map[string]any{
+		"id":         101,
+		"status":     "pending",
+		"created_at": "2024-05-10T09:00:00.000Z",
+		"metadata":   map[string]any{"team": "sales"},
+		"tags":       []string{"nda", "q2"},
+		"submitters": []map[string]any{
+			{"email": "a@example.com", "status": "completed", "opened_at": "2024-05-11T10:00:00Z"},
+			{"email": "b@example.com", "status": "sent", "opened_at": nil},
+		},
+	}
+
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{`status == "pending"`, true},
+		{`status != 'pending'`, false},
+		{`id > 100 && id <= 101`, true},
+		{`created_at < now-7d`, true},
+		{`created_at < now - 14d`, false},
+		{`created_at >= "2024-05-10"`, true},
+		{`submitters.any(s, s.opened_at == null)`, true},
+		{`submitters.all(s, s.opened_at != null)`, false},
+		{`status == "pending" && created_at < now-7d && submitters.any(s, s.opened_at == null)`, true},
+		{`submitters.0.email == "a@example.com"`, true},
+		{`submitters[1].status == "sent"`, true},
+		{`submitters.size() == 2`, true},
+		{`metadata.team in ["sales", "ops"]`, true},
+		{`tags.contains("nda")`, true},
+		{`submitters.any(s, s.email.endsWith("@example.com") && s.status =~ "^comp")`, true},
+		{`missing == null`, true},
+		{`missing.deep > 3`, false},
+		{`!(status == "completed") || id == 0`, true},
+		{`metadata.team`, true},
+		{`submitters.any(s, s.opened_at != null && s.opened_at > now - 10d)`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			expr, err := Parse(tt.expr)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			got, err := expr.Match(item, now)
+			if err != nil {
+				t.Fatalf("Match() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []string{
+		``,
+		`status ==`,
+		`status == "pending`,
+		`(status == "x"`,
+		`created_at < now - 7y`,
+		`submitters.any(s.opened_at == null)`,
+		`name.lower()`,
+		`name =~ "("`,
+		`status = "x"`,
+	}
+	for _, src := range tests {
+		t.Run(src, func(t *testing.T) {
+			if _, err := Parse(src); err == nil {
+				t.Errorf("Parse(%q) expected error", src)
+			}
+		})
+	}
+}
+
+func TestMatchTypeError(t *testing.T) {
+	expr, err := Parse(`status - 7d < now`)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if _, err := expr.Match(map[string]any{"status": "pending"}, time.Now()); err == nil {
+		t.Error("Match() expected error for string minus duration")
+	}
+}
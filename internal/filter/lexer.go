@@ -0,0 +1,150 @@
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokNumber
+	tokDuration
+	tokString
+	tokOp
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+
+	num float64
+	dur time.Duration
+	str string
+}
+
+// durationUnits are the suffixes accepted on duration literals such as 7d or 36h.
+var durationUnits = map[byte]time.Duration{
+	's': time.Second,
+	'm': time.Minute,
+	'h': time.Hour,
+	'd': 24 * time.Hour,
+	'w': 7 * 24 * time.Hour,
+}
+
+// twoCharOps are matched before single-character operators.
+var twoCharOps = []string{"==", "!=", "<=", ">=", "&&", "||", "=~"}
+
+const singleCharOps = "<>!()[],.+-"
+
+func lex(src string) ([]token, error) {
+	var toks []token
+	i := 0
+	for i < len(src) {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+
+		case c == '"' || c == '\'':
+			s, n, err := lexString(src[i:])
+			if err != nil {
+				return nil, &SyntaxError{Pos: i, Msg: err.Error()}
+			}
+			toks = append(toks, token{kind: tokString, text: src[i : i+n], pos: i, str: s})
+			i += n
+
+		case c >= '0' && c <= '9':
+			start := i
+			for i < len(src) && src[i] >= '0' && src[i] <= '9' {
+				i++
+			}
+			if i+1 < len(src) && src[i] == '.' && src[i+1] >= '0' && src[i+1] <= '9' {
+				i++
+				for i < len(src) && src[i] >= '0' && src[i] <= '9' {
+					i++
+				}
+			}
+			text := src[start:i]
+			num, _ := strconv.ParseFloat(text, 64)
+			if i < len(src) {
+				if unit, ok := durationUnits[src[i]]; ok && (i+1 == len(src) || !isIdentChar(src[i+1])) {
+					i++
+					toks = append(toks, token{kind: tokDuration, text: src[start:i], pos: start, dur: time.Duration(num * float64(unit))})
+					continue
+				}
+				if isIdentChar(src[i]) {
+					return nil, &SyntaxError{Pos: i, Msg: fmt.Sprintf("unknown duration unit in %q (use s, m, h, d, or w)", src[start:i+1])}
+				}
+			}
+			toks = append(toks, token{kind: tokNumber, text: text, pos: start, num: num})
+
+		case isIdentStart(c):
+			start := i
+			for i < len(src) && isIdentChar(src[i]) {
+				i++
+			}
+			toks = append(toks, token{kind: tokIdent, text: src[start:i], pos: start})
+
+		default:
+			matched := false
+			for _, op := range twoCharOps {
+				if strings.HasPrefix(src[i:], op) {
+					toks = append(toks, token{kind: tokOp, text: op, pos: i})
+					i += len(op)
+					matched = true
+					break
+				}
+			}
+			if matched {
+				continue
+			}
+			if strings.IndexByte(singleCharOps, c) >= 0 {
+				toks = append(toks, token{kind: tokOp, text: string(c), pos: i})
+				i++
+				continue
+			}
+			return nil, &SyntaxError{Pos: i, Msg: fmt.Sprintf("unexpected character %q", c)}
+		}
+	}
+	toks = append(toks, token{kind: tokEOF, pos: len(src)})
+	return toks, nil
+}
+
+// lexString reads a quoted string at the start of s and returns its value and length.
+// Double-quoted strings use Go escapes; single-quoted strings are taken literally.
+func lexString(s string) (string, int, error) {
+	quote := s[0]
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if quote == '"' {
+				i++
+			}
+		case quote:
+			if quote == '\'' {
+				return s[1:i], i + 1, nil
+			}
+			v, err := strconv.Unquote(s[:i+1])
+			if err != nil {
+				return "", 0, fmt.Errorf("invalid string %s", s[:i+1])
+			}
+			return v, i + 1, nil
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated string")
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || c == '$' || unicode.IsLetter(rune(c))
+}
+
+func isIdentChar(c byte) bool {
+	return isIdentStart(c) || (c >= '0' && c <= '9')
+}
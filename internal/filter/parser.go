@@ -0,0 +1,266 @@
+package filter
+
+import (
+	"fmt"
+	"regexp"
+)
+
+type parser struct {
+	toks []token
+	pos  int
+}
+
+func (p *parser) peek() token { return p.toks[p.pos] }
+
+func (p *parser) next() token {
+	t := p.toks[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+// accept consumes the next token if it is the operator or keyword op.
+func (p *parser) accept(op string) bool {
+	t := p.peek()
+	if (t.kind == tokOp || t.kind == tokIdent) && t.text == op {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(op string) error {
+	if p.accept(op) {
+		return nil
+	}
+	t := p.peek()
+	if t.kind == tokEOF {
+		return &SyntaxError{Pos: t.pos, Msg: fmt.Sprintf("expected %q, got end of expression", op)}
+	}
+	return &SyntaxError{Pos: t.pos, Msg: fmt.Sprintf("expected %q, got %q", op, t.text)}
+}
+
+func (p *parser) parseOr() (node, error) {
+	l, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("||") {
+		r, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		l = binaryNode{op: "||", l: l, r: r}
+	}
+	return l, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	l, err := p.parseComparison()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("&&") {
+		r, err := p.parseComparison()
+		if err != nil {
+			return nil, err
+		}
+		l = binaryNode{op: "&&", l: l, r: r}
+	}
+	return l, nil
+}
+
+func (p *parser) parseComparison() (node, error) {
+	l, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	for _, op := range []string{"==", "!=", "<=", ">=", "<", ">", "=~", "in"} {
+		t := p.peek()
+		if !p.accept(op) {
+			continue
+		}
+		r, err := p.parseAdditive()
+		if err != nil {
+			return nil, err
+		}
+		n := binaryNode{op: op, l: l, r: r}
+		if lit, ok := r.(literalNode); ok && op == "=~" {
+			pattern, ok := lit.v.(string)
+			if !ok {
+				return nil, &SyntaxError{Pos: t.pos, Msg: "=~ needs a string pattern"}
+			}
+			if n.re, err = regexp.Compile(pattern); err != nil {
+				return nil, &SyntaxError{Pos: t.pos, Msg: fmt.Sprintf("invalid pattern %q: %v", pattern, err)}
+			}
+		}
+		return n, nil
+	}
+	return l, nil
+}
+
+func (p *parser) parseAdditive() (node, error) {
+	l, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var op string
+		switch {
+		case p.accept("+"):
+			op = "+"
+		case p.accept("-"):
+			op = "-"
+		default:
+			return l, nil
+		}
+		r, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		l = binaryNode{op: op, l: l, r: r}
+	}
+}
+
+func (p *parser) parseUnary() (node, error) {
+	for _, op := range []string{"!", "-"} {
+		if p.accept(op) {
+			x, err := p.parseUnary()
+			if err != nil {
+				return nil, err
+			}
+			return unaryNode{op: op, x: x}, nil
+		}
+	}
+	return p.parsePostfix()
+}
+
+func (p *parser) parsePostfix() (node, error) {
+	n, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch {
+		case p.accept("."):
+			t := p.next()
+			switch t.kind {
+			case tokIdent:
+				if p.peek().kind == tokOp && p.peek().text == "(" {
+					if n, err = p.parseMethod(n, t); err != nil {
+						return nil, err
+					}
+					continue
+				}
+				n = fieldNode{target: n, name: t.text}
+			case tokNumber:
+				n = fieldNode{target: n, name: t.text}
+			default:
+				return nil, &SyntaxError{Pos: t.pos, Msg: "expected a field name after '.'"}
+			}
+		case p.accept("["):
+			idx, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect("]"); err != nil {
+				return nil, err
+			}
+			n = indexNode{target: n, index: idx}
+		default:
+			return n, nil
+		}
+	}
+}
+
+func (p *parser) parseMethod(target node, name token) (node, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	m := methodNode{target: target, name: name.text}
+
+	switch name.text {
+	case "any", "all":
+		param := p.next()
+		if param.kind != tokIdent {
+			return nil, &SyntaxError{Pos: param.pos, Msg: fmt.Sprintf("%s() needs a variable name, e.g. %s(x, x.status == \"completed\")", name.text, name.text)}
+		}
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+		body, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		m.param, m.body = param.text, body
+	case "size":
+	case "contains", "startsWith", "endsWith":
+		arg, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		m.args = []node{arg}
+	default:
+		return nil, &SyntaxError{Pos: name.pos, Msg: fmt.Sprintf("unknown method %q (want any, all, size, contains, startsWith, or endsWith)", name.text)}
+	}
+
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	t := p.next()
+	switch t.kind {
+	case tokNumber:
+		return literalNode{v: t.num}, nil
+	case tokDuration:
+		return literalNode{v: t.dur}, nil
+	case tokString:
+		return literalNode{v: t.str}, nil
+	case tokIdent:
+		switch t.text {
+		case "true":
+			return literalNode{v: true}, nil
+		case "false":
+			return literalNode{v: false}, nil
+		case "null":
+			return literalNode{v: nil}, nil
+		case "now":
+			return nowNode{}, nil
+		}
+		return identNode{name: t.text}, nil
+	case tokOp:
+		switch t.text {
+		case "(":
+			n, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return n, nil
+		case "[":
+			var elems []node
+			for !p.accept("]") {
+				if len(elems) > 0 {
+					if err := p.expect(","); err != nil {
+						return nil, err
+					}
+				}
+				el, err := p.parseOr()
+				if err != nil {
+					return nil, err
+				}
+				elems = append(elems, el)
+			}
+			return listNode{elems: elems}, nil
+		}
+	case tokEOF:
+		return nil, &SyntaxError{Pos: t.pos, Msg: "unexpected end of expression"}
+	}
+	return nil, &SyntaxError{Pos: t.pos, Msg: fmt.Sprintf("unexpected %q", t.text)}
+}
@@ -0,0 +1,152 @@
+// Package index keeps a local, per-profile index of identifiers (IDs, slugs, names and
+// external IDs) so names resolve to IDs without listing every page from the API.
+package index
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// Entry is one indexed object.
+type Entry struct {
+	ID         int    `json:"id"`
+	Slug       string `json:"slug,omitempty"`
+	Name       string `json:"name,omitempty"`
+	ExternalID string `json:"external_id,omitempty"`
+	Archived   bool   `json:"archived,omitempty"`
+}
+
+// Match kinds, in the order they are tried.
+const (
+	MatchSlug       = "slug"
+	MatchName       = "name"
+	MatchExternalID = "external_id"
+	MatchPartial    = "partial match"
+)
+
+// Collection is the index of one kind of object.
+type Collection struct {
+	Entries []Entry `json:"entries"`
+	// MaxID is the highest ID seen; incremental refreshes fetch items after it.
+	MaxID int `json:"max_id"`
+	// Dirty lists IDs changed by this CLI since they were indexed; they are refetched
+	// on the next refresh.
+	Dirty []int `json:"dirty,omitempty"`
+	// BuiltAt is when the collection was last rebuilt from scratch.
+	BuiltAt time.Time `json:"built_at"`
+	// RefreshedAt is when the collection was last brought up to date.
+	RefreshedAt time.Time `json:"refreshed_at"`
+}
+
+// Empty reports whether the collection has never been built.
+func (c *Collection) Empty() bool {
+	return c.BuiltAt.IsZero()
+}
+
+// Upsert adds e or replaces the entry with the same ID.
+func (c *Collection) Upsert(e Entry) {
+	if e.ID > c.MaxID {
+		c.MaxID = e.ID
+	}
+	for i := range c.Entries {
+		if c.Entries[i].ID == e.ID {
+			c.Entries[i] = e
+			return
+		}
+	}
+	c.Entries = append(c.Entries, e)
+}
+
+// Remove drops the entry with id.
+func (c *Collection) Remove(id int) {
+	for i := range c.Entries {
+		if c.Entries[i].ID == id {
+			c.Entries = append(c.Entries[:i], c.Entries[i+1:]...)
+			return
+		}
+	}
+}
+
+// MarkDirty records ids as changed so the next refresh refetches them.
+func (c *Collection) MarkDirty(ids ...int) {
+	for _, id := range ids {
+		found := false
+		for _, d := range c.Dirty {
+			if d == id {
+				found = true
+				break
+			}
+		}
+		if !found {
+			c.Dirty = append(c.Dirty, id)
+		}
+	}
+}
+
+// Find returns entries whose slug (case-insensitive) or external ID (exact) equals value,
+// depending on field (MatchSlug or MatchExternalID). Active entries are tried before
+// archived ones.
+func (c *Collection) Find(field, value string) []Entry {
+	var match func(Entry) bool
+	switch field {
+	case MatchSlug:
+		match = func(e Entry) bool { return strings.EqualFold(e.Slug, value) }
+	case MatchExternalID:
+		match = func(e Entry) bool { return e.ExternalID != "" && e.ExternalID == value }
+	default:
+		return nil
+	}
+	for _, archived := range []bool{false, true} {
+		var matches []Entry
+		for _, e := range c.Entries {
+			if e.Archived == archived && match(e) {
+				matches = append(matches, e)
+			}
+		}
+		if len(matches) > 0 {
+			sort.Slice(matches, func(i, j int) bool { return matches[i].ID < matches[j].ID })
+			return matches
+		}
+	}
+	return nil
+}
+
+// Lookup finds entries matching ident, trying exact slug, exact name, exact external ID,
+// then a case-insensitive substring of slug or name. Active entries are tried before
+// archived ones. It returns the first non-empty set of matches and the kind of match.
+func (c *Collection) Lookup(ident string) ([]Entry, string) {
+	ident = strings.TrimSpace(ident)
+	if ident == "" {
+		return nil, ""
+	}
+	needle := strings.ToLower(ident)
+
+	tiers := []struct {
+		kind  string
+		match func(Entry) bool
+	}{
+		{MatchSlug, func(e Entry) bool { return strings.EqualFold(e.Slug, ident) }},
+		{MatchName, func(e Entry) bool { return strings.EqualFold(e.Name, ident) }},
+		{MatchExternalID, func(e Entry) bool { return e.ExternalID != "" && e.ExternalID == ident }},
+		{MatchPartial, func(e Entry) bool {
+			return strings.Contains(strings.ToLower(e.Slug), needle) || strings.Contains(strings.ToLower(e.Name), needle)
+		}},
+	}
+
+	for _, archived := range []bool{false, true} {
+		for _, tier := range tiers {
+			var matches []Entry
+			for _, e := range c.Entries {
+				if e.Archived == archived && tier.match(e) {
+					matches = append(matches, e)
+				}
+			}
+			if len(matches) > 0 {
+				sort.Slice(matches, func(i, j int) bool { return matches[i].ID < matches[j].ID })
+				return matches, tier.kind
+			}
+		}
+	}
+	return nil, ""
+}
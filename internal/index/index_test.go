@@ -0,0 +1,108 @@
+package index
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	var c Collection
+	for _, e := range []Entry{
+		{ID: 1, Slug: "abc123", Name: "NDA"},
+		{ID: 2, Slug: "def456", Name: "Offer Letter", ExternalID: "HR-7"},
+		{ID: 3, Slug: "ghi789", Name: "Offer Letter (old)", Archived: true},
+		{ID: 4, Slug: "jkl000", Name: "nda"},
+		{ID: 5, Slug: "mno111", Name: "Retired", Archived: true},
+	} {
+		c.Upsert(e)
+	}
+
+	tests := []struct {
+		ident    string
+		wantIDs  []int
+		wantKind string
+	}{
+		{"ABC123", []int{1}, MatchSlug},
+		{"offer letter", []int{2}, MatchName},
+		{"HR-7", []int{2}, MatchExternalID},
+		{"nda", []int{1, 4}, MatchName},
+		{"letter", []int{2}, MatchPartial},
+		{"retired", []int{5}, MatchName},
+		{"missing", nil, ""},
+		{"  ", nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ident, func(t *testing.T) {
+			matches, kind := c.Lookup(tt.ident)
+			var ids []int
+			for _, m := range matches {
+				ids = append(ids, m.ID)
+			}
+			if !reflect.DeepEqual(ids, tt.wantIDs) || kind != tt.wantKind {
+				t.Errorf("Lookup(%q) = %v (%s), want %v (%s)", tt.ident, ids, kind, tt.wantIDs, tt.wantKind)
+			}
+		})
+	}
+}
+
+func TestFind(t *testing.T) {
+	var c Collection
+	for _, e := range []Entry{
+		{ID: 1, Slug: "abc123", Name: "NDA", ExternalID: "HR-7"},
+		{ID: 2, Slug: "def456", Name: "HR-7"},
+		{ID: 3, Slug: "ghi789", ExternalID: "HR-8", Archived: true},
+		{ID: 4, Slug: "jkl000", ExternalID: "HR-8"},
+	} {
+		c.Upsert(e)
+	}
+
+	tests := []struct {
+		field   string
+		value   string
+		wantIDs []int
+	}{
+		{MatchSlug, "DEF456", []int{2}},
+		{MatchSlug, "def", nil},
+		{MatchExternalID, "HR-7", []int{1}},
+		{MatchExternalID, "hr-7", nil},
+		{MatchExternalID, "HR-8", []int{4}},
+		{MatchName, "NDA", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.field+"="+tt.value, func(t *testing.T) {
+			var ids []int
+			for _, m := range c.Find(tt.field, tt.value) {
+				ids = append(ids, m.ID)
+			}
+			if !reflect.DeepEqual(ids, tt.wantIDs) {
+				t.Errorf("Find(%q, %q) = %v, want %v", tt.field, tt.value, ids, tt.wantIDs)
+			}
+		})
+	}
+}
+
+func TestUpsertRemoveDirty(t *testing.T) {
+	var c Collection
+	c.Upsert(Entry{ID: 10, Name: "a"})
+	c.Upsert(Entry{ID: 3, Name: "b"})
+	c.Upsert(Entry{ID: 10, Name: "renamed"})
+	if len(c.Entries) != 2 || c.Entries[0].Name != "renamed" {
+		t.Errorf("Upsert() entries = %+v, want 2 entries with id 10 renamed", c.Entries)
+	}
+	if c.MaxID != 10 {
+		t.Errorf("MaxID = %d, want 10", c.MaxID)
+	}
+
+	c.Remove(3)
+	if len(c.Entries) != 1 {
+		t.Errorf("Remove() entries = %+v, want 1", c.Entries)
+	}
+
+	c.MarkDirty(10, 11, 10)
+	if !reflect.DeepEqual(c.Dirty, []int{10, 11}) {
+		t.Errorf("MarkDirty() = %v, want [10 11]", c.Dirty)
+	}
+	if !c.Empty() {
+		t.Error("Empty() = false for a collection that was never built")
+	}
+}
@@ -0,0 +1,269 @@
+// Package journal records mutating CLI actions in a local, size-limited JSONL audit log.
+package journal
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// FileName is the journal file created in the config directory.
+	FileName = "history.jsonl"
+	// DisableEnvName disables journaling when set to "off", "0" or "false".
+	DisableEnvName = "DOCUSEAL_HISTORY"
+
+	// DefaultMaxSize is the size at which the journal is rotated.
+	DefaultMaxSize = 5 << 20
+	// DefaultMaxBackups is the number of rotated files kept (history.jsonl.1 ... .N).
+	DefaultMaxBackups = 3
+
+	// maxArgLen bounds recorded argument length (e.g. inline HTML or base64).
+	maxArgLen = 200
+)
+
+// Result values recorded in entries.
+const (
+	ResultOK    = "ok"
+	ResultError = "error"
+)
+
+// Entry is a single journaled action.
+type Entry struct {
+	Time      time.Time `json:"time"`
+	Profile   string    `json:"profile,omitempty"`
+	Command   string    `json:"command"`
+	Args      []string  `json:"args"`
+	Resources []string  `json:"resources,omitempty"`
+	Result    string    `json:"result"`
+	ErrorType string    `json:"error_type,omitempty"`
+	ExitCode  int       `json:"exit_code,omitempty"`
+}
+
+// HasResource reports whether the entry affected resource (e.g. "submission:123").
+func (e Entry) HasResource(resource string) bool {
+	for _, r := range e.Resources {
+		if r == resource {
+			return true
+		}
+	}
+	return false
+}
+
+// Filter selects entries when reading the journal.
+type Filter struct {
+	Since    time.Time
+	Resource string
+	Command  string
+}
+
+func (f Filter) match(e Entry) bool {
+	if !f.Since.IsZero() && e.Time.Before(f.Since) {
+		return false
+	}
+	if f.Resource != "" && !e.HasResource(f.Resource) {
+		return false
+	}
+	if f.Command != "" && e.Command != f.Command && !strings.HasPrefix(e.Command, f.Command+" ") {
+		return false
+	}
+	return true
+}
+
+// Journal is an append-only JSONL file with size-based rotation.
+type Journal struct {
+	Path       string
+	MaxSize    int64
+	MaxBackups int
+
+	mu sync.Mutex
+}
+
+// New returns a journal stored in dir with default limits.
+func New(dir string) *Journal {
+	return &Journal{
+		Path:       filepath.Join(dir, FileName),
+		MaxSize:    DefaultMaxSize,
+		MaxBackups: DefaultMaxBackups,
+	}
+}
+
+// Disabled reports whether journaling was turned off via DOCUSEAL_HISTORY.
+func Disabled() bool {
+	switch strings.ToLower(strings.TrimSpace(os.Getenv(DisableEnvName))) {
+	case "off", "0", "false", "no":
+		return true
+	}
+	return false
+}
+
+// Append writes e to the journal, rotating first if the file would exceed MaxSize.
+func (j *Journal) Append(e Entry) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("failed to encode journal entry: %w", err)
+	}
+	data = append(data, '\n')
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(j.Path), 0o700); err != nil {
+		return fmt.Errorf("failed to create journal directory: %w", err)
+	}
+	if info, err := os.Stat(j.Path); err == nil && j.MaxSize > 0 && info.Size()+int64(len(data)) > j.MaxSize {
+		if err := j.rotate(); err != nil {
+			return err
+		}
+	}
+
+	f, err := os.OpenFile(j.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open journal: %w", err)
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write journal: %w", err)
+	}
+	return f.Close()
+}
+
+// rotate shifts history.jsonl -> .1 -> .2 ..., dropping the oldest backup.
+func (j *Journal) rotate() error {
+	if j.MaxBackups <= 0 {
+		if err := os.Remove(j.Path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to rotate journal: %w", err)
+		}
+		return nil
+	}
+	_ = os.Remove(j.backupPath(j.MaxBackups))
+	for i := j.MaxBackups - 1; i >= 1; i-- {
+		if err := os.Rename(j.backupPath(i), j.backupPath(i+1)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to rotate journal: %w", err)
+		}
+	}
+	if err := os.Rename(j.Path, j.backupPath(1)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to rotate journal: %w", err)
+	}
+	return nil
+}
+
+func (j *Journal) backupPath(n int) string {
+	return fmt.Sprintf("%s.%d", j.Path, n)
+}
+
+// Read returns matching entries from rotated backups and the current file, oldest first.
+// Malformed lines are skipped.
+func (j *Journal) Read(f Filter) ([]Entry, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	paths := make([]string, 0, j.MaxBackups+1)
+	for i := j.MaxBackups; i >= 1; i-- {
+		paths = append(paths, j.backupPath(i))
+	}
+	paths = append(paths, j.Path)
+
+	entries := []Entry{}
+	for _, p := range paths {
+		file, err := os.Open(p)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read journal: %w", err)
+		}
+		scanner := bufio.NewScanner(file)
+		scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
+		for scanner.Scan() {
+			var e Entry
+			if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+				continue
+			}
+			if f.match(e) {
+				entries = append(entries, e)
+			}
+		}
+		err = scanner.Err()
+		_ = file.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read journal: %w", err)
+		}
+	}
+	return entries, nil
+}
+
+// sensitiveFlagWords mark flags whose values are never recorded.
+var sensitiveFlagWords = []string{"key", "token", "secret", "password"}
+
+// redactFlagValue redacts the value of a sensitive flag; a "Name: value" header keeps its name.
+func redactFlagValue(flag, value string) string {
+	if isHeaderFlag(flag) {
+		if name, _, ok := strings.Cut(value, ":"); ok {
+			return name + ": [REDACTED]"
+		}
+	}
+	return "[REDACTED]"
+}
+
+func isHeaderFlag(name string) bool {
+	return strings.TrimLeft(name, "-") == "header"
+}
+
+func isSensitiveFlag(name string) bool {
+	name = strings.ToLower(strings.TrimLeft(name, "-"))
+	for _, w := range sensitiveFlagWords {
+		if strings.Contains(name, w) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasSecrets reports whether args carry a value RedactArgs would redact: a sensitive
+// flag (--api-key, --token, ...) or a --header.
+func HasSecrets(args []string) bool {
+	for _, a := range args {
+		if !strings.HasPrefix(a, "-") || a == "--" {
+			continue
+		}
+		name, _, _ := strings.Cut(a, "=")
+		if isSensitiveFlag(name) || isHeaderFlag(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactArgs returns a copy of args with secret flag values replaced by
+// "[REDACTED]" and overly long values truncated. Header flags keep the header name.
+func RedactArgs(args []string) []string {
+	out := make([]string, 0, len(args))
+	redactNext := ""
+	for _, a := range args {
+		switch {
+		case redactNext != "":
+			a = redactFlagValue(redactNext, a)
+			redactNext = ""
+		case strings.HasPrefix(a, "-") && a != "--":
+			name, value, hasValue := strings.Cut(a, "=")
+			if isSensitiveFlag(name) || isHeaderFlag(name) {
+				if hasValue {
+					a = name + "=" + redactFlagValue(name, value)
+				} else {
+					redactNext = name
+				}
+			}
+		}
+		if r := []rune(a); len(r) > maxArgLen {
+			a = string(r[:maxArgLen]) + fmt.Sprintf("...(%d chars)", len(r))
+		}
+		out = append(out, a)
+	}
+	return out
+}
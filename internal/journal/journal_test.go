@@ -0,0 +1,114 @@
+package journal
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestJournal_AppendAndRead(t *testing.T) {
+	j := New(t.TempDir())
+	now := time.Now().UTC()
+
+	entries := []Entry{
+		{Time: now.Add(-48 * time.Hour), Command: "submissions archive", Resources: []string{"submission:1"}, Result: ResultOK},
+		{Time: now.Add(-time.Hour), Command: "submitters update", Resources: []string{"submitter:7"}, Result: ResultError, ErrorType: "auth"},
+		{Time: now, Command: "submissions archive", Resources: []string{"submission:2"}, Result: ResultOK},
+	}
+	for _, e := range entries {
+		if err := j.Append(e); err != nil {
+			t.Fatalf("Append() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   int
+	}{
+		{"all", Filter{}, 3},
+		{"since", Filter{Since: now.Add(-2 * time.Hour)}, 2},
+		{"resource", Filter{Resource: "submission:2"}, 1},
+		{"command prefix", Filter{Command: "submissions"}, 2},
+		{"no match", Filter{Resource: "template:9"}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := j.Read(tt.filter)
+			if err != nil {
+				t.Fatalf("Read() error = %v", err)
+			}
+			if len(got) != tt.want {
+				t.Fatalf("Read() returned %d entries, want %d", len(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestJournal_Rotation(t *testing.T) {
+	j := New(t.TempDir())
+	j.MaxSize = 300
+	j.MaxBackups = 2
+
+	for i := 0; i < 20; i++ {
+		if err := j.Append(Entry{Time: time.Now(), Command: "submissions archive", Args: []string{strings.Repeat("x", 50)}, Result: ResultOK}); err != nil {
+			t.Fatalf("Append() error = %v", err)
+		}
+	}
+
+	for _, p := range []string{j.Path, j.backupPath(1), j.backupPath(2)} {
+		info, err := os.Stat(p)
+		if err != nil {
+			t.Fatalf("expected %s to exist: %v", p, err)
+		}
+		if info.Size() > j.MaxSize {
+			t.Errorf("%s size = %d, want <= %d", p, info.Size(), j.MaxSize)
+		}
+	}
+	if _, err := os.Stat(j.backupPath(3)); !os.IsNotExist(err) {
+		t.Errorf("backup beyond MaxBackups exists")
+	}
+
+	got, err := j.Read(Filter{})
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if len(got) == 0 || len(got) >= 20 {
+		t.Errorf("Read() returned %d entries, want a bounded non-empty set", len(got))
+	}
+}
+
+func TestRedactArgs(t *testing.T) {
+	long := strings.Repeat("a", 500)
+	got := RedactArgs([]string{"auth", "login", "--api-key", "secret1", "--url=https://x", "--api-key=secret2", "--html", long})
+	want := []string{"auth", "login", "--api-key", "[REDACTED]", "--url=https://x", "--api-key=[REDACTED]", "--html"}
+	if !reflect.DeepEqual(got[:len(want)], want) {
+		t.Fatalf("RedactArgs() = %v, want prefix %v", got, want)
+	}
+	if last := got[len(got)-1]; strings.Contains(last, long) || !strings.HasSuffix(last, "(500 chars)") {
+		t.Errorf("long arg not truncated: %q", last)
+	}
+
+	headers := RedactArgs([]string{"--header", "CF-Access-Client-Secret: s3cret", "--header=X-Tenant: acme"})
+	wantHeaders := []string{"--header", "CF-Access-Client-Secret: [REDACTED]", "--header=X-Tenant: [REDACTED]"}
+	if !reflect.DeepEqual(headers, wantHeaders) {
+		t.Errorf("RedactArgs() = %v, want %v", headers, wantHeaders)
+	}
+}
+
+func TestHasSecrets(t *testing.T) {
+	for _, tt := range []struct {
+		args []string
+		want bool
+	}{
+		{[]string{"templates", "list", "--limit", "5"}, false},
+		{[]string{"auth", "login", "--api-key", "k"}, true},
+		{[]string{"templates", "list", "--header=X-Tenant: acme"}, true},
+	} {
+		if got := HasSecrets(tt.args); got != tt.want {
+			t.Errorf("HasSecrets(%q) = %v, want %v", tt.args, got, tt.want)
+		}
+	}
+}
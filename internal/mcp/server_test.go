@@ -0,0 +1,89 @@
+package mcp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func serve(t *testing.T, s *Server, lines ...string) []map[string]any {
+	t.Helper()
+	var out strings.Builder
+	if err := s.Serve(context.Background(), strings.NewReader(strings.Join(lines, "\n")+"\n"), &out); err != nil {
+		t.Fatalf("Serve() error = %v", err)
+	}
+	var responses []map[string]any
+	scanner := bufio.NewScanner(strings.NewReader(out.String()))
+	for scanner.Scan() {
+		var m map[string]any
+		if err := json.Unmarshal(scanner.Bytes(), &m); err != nil {
+			t.Fatalf("invalid response %q: %v", scanner.Text(), err)
+		}
+		responses = append(responses, m)
+	}
+	return responses
+}
+
+func TestServer_InitializeAndList(t *testing.T) {
+	s := &Server{
+		Name:    "docuseal",
+		Version: "test",
+		Tools:   []Tool{{Name: "templates_list", InputSchema: map[string]any{"type": "object"}}},
+	}
+
+	responses := serve(t, s,
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2025-03-26"}}`,
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+		`{"jsonrpc":"2.0","id":2,"method":"tools/list"}`,
+		`{"jsonrpc":"2.0","id":3,"method":"bogus"}`,
+	)
+	if len(responses) != 3 {
+		t.Fatalf("got %d responses, want 3 (notifications get none)", len(responses))
+	}
+
+	init := responses[0]["result"].(map[string]any)
+	if init["protocolVersion"] != "2025-03-26" {
+		t.Errorf("protocolVersion = %v, want client's version echoed", init["protocolVersion"])
+	}
+
+	tools := responses[1]["result"].(map[string]any)["tools"].([]any)
+	if len(tools) != 1 || tools[0].(map[string]any)["name"] != "templates_list" {
+		t.Errorf("tools = %v", tools)
+	}
+
+	errObj, ok := responses[2]["error"].(map[string]any)
+	if !ok || errObj["code"].(float64) != codeMethodNotFound {
+		t.Errorf("unknown method response = %v", responses[2])
+	}
+}
+
+func TestServer_ToolsCall(t *testing.T) {
+	var gotArgs map[string]any
+	s := &Server{
+		Tools: []Tool{{Name: "submissions_get"}},
+		Call: func(_ context.Context, name string, args map[string]any) (*CallResult, error) {
+			gotArgs = args
+			return TextResult(`{"id":5}`, false), nil
+		},
+	}
+
+	responses := serve(t, s,
+		`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"submissions_get","arguments":{"id":"5"}}}`,
+		`{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"nope"}}`,
+	)
+
+	result := responses[0]["result"].(map[string]any)
+	content := result["content"].([]any)[0].(map[string]any)
+	if content["text"] != `{"id":5}` {
+		t.Errorf("content = %v", content)
+	}
+	if gotArgs["id"] != "5" {
+		t.Errorf("args = %v", gotArgs)
+	}
+
+	if _, ok := responses[1]["error"]; !ok {
+		t.Errorf("expected error for unknown tool, got %v", responses[1])
+	}
+}
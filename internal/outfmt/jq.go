@@ -0,0 +1,60 @@
+package outfmt
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/itchyny/gojq"
+)
+
+// ParseJQ compiles a --jq expression.
+func ParseJQ(expr string) (*gojq.Code, error) {
+	query, err := gojq.Parse(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --jq expression: %w", err)
+	}
+	code, err := gojq.Compile(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --jq expression: %w", err)
+	}
+	return code, nil
+}
+
+// WriteJQ runs code against the JSON form of v and writes each result on its own
+// line: strings raw (like jq -r), everything else as compact JSON. List envelopes
+// are unwrapped to their results and "_meta" items are dropped.
+func WriteJQ(ctx context.Context, w io.Writer, code *gojq.Code, v any) error {
+	normalized, err := normalizeToAny(v)
+	if err != nil {
+		return err
+	}
+	// Lists are presented as plain arrays, whether or not they came in an envelope.
+	var input any = normalized
+	if _, isList := normalized.([]any); isList || isEnvelope(normalized) {
+		input = listItems(normalized)
+	}
+
+	iter := code.RunWithContext(ctx, input)
+	for {
+		result, ok := iter.Next()
+		if !ok {
+			return nil
+		}
+		if err, isErr := result.(error); isErr {
+			if he, halted := err.(*gojq.HaltError); halted && he.Value() == nil {
+				return nil
+			}
+			return fmt.Errorf("--jq: %w", err)
+		}
+		if s, isString := result.(string); isString {
+			if _, err := fmt.Fprintln(w, s); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := WriteJSONCompact(w, result); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package outfmt
+
+import (
+	"bytes"
+	"context"
+	"testing"
+)
+
+func TestWriteJQ(t *testing.T) {
+	type submitter struct {
+		ID    int    `json:"id"`
+		Email string `json:"email"`
+	}
+	rows := []submitter{{ID: 1, Email: "a@example.com"}, {ID: 2, Email: "b@example.com"}}
+
+	tests := []struct {
+		name string
+		expr string
+		data any
+		want string
+	}{
+		{name: "raw strings", expr: ".[] | .email", data: rows, want: "a@example.com\nb@example.com\n"},
+		{name: "compact objects", expr: ".[0] | {id}", data: rows, want: "{\"id\":1}\n"},
+		{name: "single object", expr: ".id", data: rows[1], want: "2\n"},
+		{
+			name: "envelope unwrapped",
+			expr: "length",
+			data: map[string]any{"results": rows, "has_more": true},
+			want: "2\n",
+		},
+		{name: "halt stops cleanly", expr: "halt", data: rows, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := ParseJQ(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseJQ() error = %v", err)
+			}
+			var buf bytes.Buffer
+			if err := WriteJQ(context.Background(), &buf, code, tt.data); err != nil {
+				t.Fatalf("WriteJQ() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("WriteJQ() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseJQInvalid(t *testing.T) {
+	for _, expr := range []string{".[", "undefined_fn(1)"} {
+		if _, err := ParseJQ(expr); err == nil {
+			t.Errorf("ParseJQ(%q) expected error", expr)
+		}
+	}
+}
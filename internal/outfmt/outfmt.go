@@ -15,6 +15,9 @@ const (
 	Text Mode = iota
 	JSON
 	NDJSON
+	CSV
+	TSV
+	YAML
 )
 
 type contextKey struct{}
@@ -28,8 +31,14 @@ func Parse(s string) (Mode, error) {
 		return JSON, nil
 	case "ndjson", "jsonl":
 		return NDJSON, nil
+	case "csv":
+		return CSV, nil
+	case "tsv":
+		return TSV, nil
+	case "yaml", "yml":
+		return YAML, nil
 	default:
-		return Text, fmt.Errorf("invalid output format: %q (use 'text', 'json', or 'ndjson')", s)
+		return Text, fmt.Errorf("invalid output format: %q (use 'text', 'json', 'ndjson', 'csv', 'tsv', or 'yaml')", s)
 	}
 }
 
@@ -184,6 +193,12 @@ func (m Mode) String() string {
 		return "json"
 	case NDJSON:
 		return "ndjson"
+	case CSV:
+		return "csv"
+	case TSV:
+		return "tsv"
+	case YAML:
+		return "yaml"
 	default:
 		return "text"
 	}
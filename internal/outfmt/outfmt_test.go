@@ -27,6 +27,24 @@ func TestParse(t *testing.T) {
 			wantMode:  JSON,
 			wantError: false,
 		},
+		{
+			name:      "csv mode",
+			input:     "csv",
+			wantMode:  CSV,
+			wantError: false,
+		},
+		{
+			name:      "tsv mode",
+			input:     "tsv",
+			wantMode:  TSV,
+			wantError: false,
+		},
+		{
+			name:      "yaml mode",
+			input:     "yaml",
+			wantMode:  YAML,
+			wantError: false,
+		},
 		{
 			name:      "empty string defaults to text",
 			input:     "",
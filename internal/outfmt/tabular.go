@@ -0,0 +1,240 @@
+package outfmt
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// TabularOptions controls CSV/TSV output.
+type TabularOptions struct {
+	// Comma is the field separator (',' for CSV, '\t' for TSV).
+	Comma rune
+	// Select lists columns as comma-separated keys or dot paths. A path that names an
+	// object or array selects all of its flattened leaves.
+	Select string
+	// NoHeader omits the header row.
+	NoHeader bool
+}
+
+// orderedObject is a decoded JSON object that remembers key order,
+// so columns follow struct field order.
+type orderedObject struct {
+	keys   []string
+	values map[string]any
+}
+
+// WriteCSV writes v as CSV (or TSV, depending on opts.Comma) with RFC 4180 quoting.
+//
+// Rows are the elements of a slice, the "results" of a list envelope, or v itself for a
+// single object. Nested objects and arrays are flattened to dot-path columns
+// (e.g. "submitters.0.email"). NDJSON "_meta" objects are skipped.
+func WriteCSV(w io.Writer, v any, opts TabularOptions) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to encode rows: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	root, err := decodeOrdered(dec)
+	if err != nil {
+		return fmt.Errorf("failed to decode rows: %w", err)
+	}
+
+	var rows []any
+	switch tv := root.(type) {
+	case []any:
+		rows = tv
+	case *orderedObject:
+		if results, ok := tv.values["results"].([]any); ok {
+			rows = results
+		} else {
+			rows = []any{tv}
+		}
+	case nil:
+	default:
+		rows = []any{tv}
+	}
+
+	var columns []string
+	seen := map[string]bool{}
+	flat := make([]map[string]string, 0, len(rows))
+	for _, row := range rows {
+		if obj, ok := row.(*orderedObject); ok {
+			if _, isMeta := obj.values["_meta"]; isMeta {
+				continue
+			}
+		}
+		cells := map[string]string{}
+		var keys []string
+		flatten("", row, cells, &keys)
+		for _, k := range keys {
+			if !seen[k] {
+				seen[k] = true
+				columns = append(columns, k)
+			}
+		}
+		flat = append(flat, cells)
+	}
+
+	if paths := parseSelect(opts.Select); len(paths) > 0 {
+		columns = selectColumns(columns, paths)
+	}
+
+	cw := csv.NewWriter(w)
+	if opts.Comma != 0 {
+		cw.Comma = opts.Comma
+	}
+	if !opts.NoHeader && len(columns) > 0 {
+		if err := cw.Write(columns); err != nil {
+			return err
+		}
+	}
+	record := make([]string, len(columns))
+	for _, cells := range flat {
+		for i, c := range columns {
+			record[i] = cells[c]
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// selectColumns orders columns by the selected paths. A selected path matches the column
+// itself and any column nested under it; selected paths absent from every row still get
+// an (empty) column so the layout is predictable.
+func selectColumns(columns []string, paths [][]string) []string {
+	var out []string
+	used := map[string]bool{}
+	for _, p := range paths {
+		name := strings.Join(p, ".")
+		matched := false
+		for _, c := range columns {
+			if c == name || strings.HasPrefix(c, name+".") {
+				matched = true
+				if !used[c] {
+					used[c] = true
+					out = append(out, c)
+				}
+			}
+		}
+		if !matched && !used[name] {
+			used[name] = true
+			out = append(out, name)
+		}
+	}
+	return out
+}
+
+// flatten records leaf values of v under dot-path keys, in document order.
+func flatten(prefix string, v any, cells map[string]string, keys *[]string) {
+	join := func(k string) string {
+		if prefix == "" {
+			return k
+		}
+		return prefix + "." + k
+	}
+	set := func(k, val string) {
+		if _, ok := cells[k]; !ok {
+			*keys = append(*keys, k)
+		}
+		cells[k] = val
+	}
+
+	switch tv := v.(type) {
+	case *orderedObject:
+		if len(tv.keys) == 0 && prefix != "" {
+			set(prefix, "")
+			return
+		}
+		for _, k := range tv.keys {
+			flatten(join(k), tv.values[k], cells, keys)
+		}
+	case []any:
+		if len(tv) == 0 && prefix != "" {
+			set(prefix, "")
+			return
+		}
+		for i, el := range tv {
+			flatten(join(strconv.Itoa(i)), el, cells, keys)
+		}
+	default:
+		if prefix == "" {
+			prefix = "value"
+		}
+		set(prefix, scalarString(tv))
+	}
+}
+
+func scalarString(v any) string {
+	switch tv := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return tv
+	case json.Number:
+		return tv.String()
+	case bool:
+		return strconv.FormatBool(tv)
+	default:
+		return fmt.Sprint(tv)
+	}
+}
+
+// decodeOrdered decodes the next JSON value, keeping object key order.
+func decodeOrdered(dec *json.Decoder) (any, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	delim, ok := tok.(json.Delim)
+	if !ok {
+		return tok, nil
+	}
+
+	switch delim {
+	case '{':
+		obj := &orderedObject{values: map[string]any{}}
+		for dec.More() {
+			keyTok, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			key, _ := keyTok.(string)
+			val, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			if _, dup := obj.values[key]; !dup {
+				obj.keys = append(obj.keys, key)
+			}
+			obj.values[key] = val
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return obj, nil
+	case '[':
+		arr := []any{}
+		for dec.More() {
+			val, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, val)
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return arr, nil
+	default:
+		return nil, fmt.Errorf("unexpected delimiter %q", delim)
+	}
+}
@@ -0,0 +1,74 @@
+package outfmt
+
+import (
+	"bytes"
+	"testing"
+)
+
+type tabularSubmitter struct {
+	Email string `json:"email"`
+	Role  string `json:"role"`
+}
+
+type tabularSubmission struct {
+	ID         int                `json:"id"`
+	Name       string             `json:"name"`
+	Submitters []tabularSubmitter `json:"submitters"`
+	Metadata   map[string]any     `json:"metadata"`
+	Completed  *string            `json:"completed_at"`
+}
+
+func TestWriteCSV(t *testing.T) {
+	rows := []tabularSubmission{
+		{ID: 1, Name: `Contract, "final"`, Submitters: []tabularSubmitter{{Email: "a@example.com", Role: "Signer"}}},
+		{ID: 2, Name: "Line\nbreak", Submitters: []tabularSubmitter{}},
+	}
+
+	tests := []struct {
+		name string
+		v    any
+		opts TabularOptions
+		want string
+	}{
+		{
+			name: "flattened with quoting",
+			v:    rows,
+			opts: TabularOptions{Comma: ','},
+			want: "id,name,submitters.0.email,submitters.0.role,metadata,completed_at,submitters\n" +
+				"1,\"Contract, \"\"final\"\"\",a@example.com,Signer,,,\n" +
+				"2,\"Line\nbreak\",,,,,\n",
+		},
+		{
+			name: "select orders columns and expands prefixes",
+			v:    rows,
+			opts: TabularOptions{Comma: ',', Select: "submitters,id,missing"},
+			want: "submitters.0.email,submitters.0.role,submitters,id,missing\n" +
+				"a@example.com,Signer,,1,\n" +
+				",,,2,\n",
+		},
+		{
+			name: "tsv without header",
+			v:    map[string]any{"results": rows[:1], "count": 1},
+			opts: TabularOptions{Comma: '\t', Select: "id,name", NoHeader: true},
+			want: "1\t\"Contract, \"\"final\"\"\"\n",
+		},
+		{
+			name: "single object and meta skipped",
+			v:    []any{map[string]any{"id": 3}, map[string]any{"_meta": map[string]any{"count": 1}}},
+			opts: TabularOptions{Comma: ','},
+			want: "id\n3\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := WriteCSV(&buf, tt.v, tt.opts); err != nil {
+				t.Fatalf("WriteCSV() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("WriteCSV() =\n%q\nwant\n%q", got, tt.want)
+			}
+		})
+	}
+}
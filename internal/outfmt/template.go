@@ -0,0 +1,147 @@
+package outfmt
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// ParseTemplate parses a --format Go template. Literal "\t" and "\n" escapes are
+// expanded so shell-quoted formats like '{{.ID}}\t{{.Status}}' work as expected.
+func ParseTemplate(format string, now func() time.Time) (*template.Template, error) {
+	format = strings.NewReplacer(`\t`, "\t", `\n`, "\n").Replace(format)
+	if now == nil {
+		now = time.Now
+	}
+	tmpl, err := template.New("format").Funcs(templateFuncs(now)).Parse(format)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --format template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// WriteTemplate executes tmpl once per item of v (or once if v is not a list),
+// writing a newline after each item unless the template already ends with one.
+// List envelopes are unwrapped to their results and "_meta" items are skipped.
+func WriteTemplate(w io.Writer, tmpl *template.Template, v any) error {
+	for _, item := range listItems(v) {
+		var b strings.Builder
+		if err := tmpl.Execute(&b, item); err != nil {
+			return fmt.Errorf("failed to execute --format template: %w", err)
+		}
+		out := b.String()
+		if !strings.HasSuffix(out, "\n") {
+			out += "\n"
+		}
+		if _, err := io.WriteString(w, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isEnvelope reports whether v is a list envelope ({"results": [...], "has_more": ...}).
+func isEnvelope(v any) bool {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return false
+	}
+	_, hasResults := m["results"]
+	_, hasMore := m["has_more"]
+	return hasResults && hasMore
+}
+
+// listItems returns the items a per-item formatter should see.
+func listItems(v any) []any {
+	if isEnvelope(v) {
+		v = v.(map[string]any)["results"]
+	}
+
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() || (rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array) {
+		return []any{v}
+	}
+	items := make([]any, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		item := rv.Index(i).Interface()
+		if m, ok := item.(map[string]any); ok {
+			if _, isMeta := m["_meta"]; isMeta {
+				continue
+			}
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+func templateFuncs(now func() time.Time) template.FuncMap {
+	return template.FuncMap{
+		"ago": func(v any) string {
+			t, ok := asTime(v)
+			if !ok {
+				return "-"
+			}
+			return humanizeAgo(now().Sub(t))
+		},
+		"join": func(sep string, v any) string {
+			rv := reflect.ValueOf(v)
+			if !rv.IsValid() || (rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array) {
+				return fmt.Sprint(v)
+			}
+			parts := make([]string, 0, rv.Len())
+			for i := 0; i < rv.Len(); i++ {
+				parts = append(parts, fmt.Sprint(rv.Index(i).Interface()))
+			}
+			return strings.Join(parts, sep)
+		},
+		"upper": strings.ToUpper,
+		"lower": strings.ToLower,
+		"json": func(v any) (string, error) {
+			data, err := json.Marshal(v)
+			return string(data), err
+		},
+	}
+}
+
+func asTime(v any) (time.Time, bool) {
+	switch tv := v.(type) {
+	case time.Time:
+		return tv, !tv.IsZero()
+	case *time.Time:
+		if tv == nil {
+			return time.Time{}, false
+		}
+		return *tv, !tv.IsZero()
+	case string:
+		t, err := time.Parse(time.RFC3339, tv)
+		return t, err == nil
+	default:
+		return time.Time{}, false
+	}
+}
+
+func humanizeAgo(d time.Duration) string {
+	future := d < 0
+	if future {
+		d = -d
+	}
+	var s string
+	switch {
+	case d < time.Minute:
+		s = fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		s = fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 48*time.Hour:
+		s = fmt.Sprintf("%dh", int(d.Hours()))
+	default:
+		s = fmt.Sprintf("%dd", int(d.Hours()/24))
+	}
+	if future {
+		return "in " + s
+	}
+	return s + " ago"
+}
@@ -0,0 +1,78 @@
+package outfmt
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestWriteTemplate(t *testing.T) {
+	type submission struct {
+		ID        int
+		Status    string
+		Emails    []string
+		CreatedAt time.Time
+	}
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	rows := []submission{
+		{ID: 1, Status: "pending", Emails: []string{"a@example.com", "b@example.com"}, CreatedAt: now.Add(-3 * time.Hour)},
+		{ID: 2, Status: "completed", CreatedAt: now.Add(-72 * time.Hour)},
+	}
+
+	tests := []struct {
+		name   string
+		format string
+		data   any
+		want   string
+	}{
+		{
+			name:   "per item with tab escape",
+			format: `{{.ID}}\t{{.Status}}`,
+			data:   rows,
+			want:   "1\tpending\n2\tcompleted\n",
+		},
+		{
+			name:   "helpers",
+			format: `{{upper .Status}} {{join "," .Emails}} {{ago .CreatedAt}}`,
+			data:   rows,
+			want:   "PENDING a@example.com,b@example.com 3h ago\nCOMPLETED  3d ago\n",
+		},
+		{
+			name:   "single object",
+			format: `{{json .Emails}}`,
+			data:   rows[0],
+			want:   "[\"a@example.com\",\"b@example.com\"]\n",
+		},
+		{
+			name:   "envelope unwrapped",
+			format: `{{.id}}`,
+			data: map[string]any{
+				"results":  []any{map[string]any{"id": 7}, map[string]any{"_meta": true}},
+				"has_more": false,
+			},
+			want: "7\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := ParseTemplate(tt.format, func() time.Time { return now })
+			if err != nil {
+				t.Fatalf("ParseTemplate() error = %v", err)
+			}
+			var buf bytes.Buffer
+			if err := WriteTemplate(&buf, tmpl, tt.data); err != nil {
+				t.Fatalf("WriteTemplate() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("WriteTemplate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTemplateInvalid(t *testing.T) {
+	if _, err := ParseTemplate("{{.ID", nil); err == nil {
+		t.Error("ParseTemplate() expected error for unterminated action")
+	}
+}
@@ -0,0 +1,43 @@
+package outfmt
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+// WriteYAML writes a value as block-style YAML.
+// Values are encoded through JSON first so json tags, omitempty and field order
+// match WriteJSON. Nil slices are normalized to empty arrays.
+func WriteYAML(w io.Writer, v any) error {
+	NilSlicesToEmpty(v)
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to encode YAML: %w", err)
+	}
+
+	// JSON is valid YAML; decoding into a node keeps key order.
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return fmt.Errorf("failed to encode YAML: %w", err)
+	}
+	blockStyle(&node)
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return fmt.Errorf("failed to encode YAML: %w", err)
+	}
+	return enc.Close()
+}
+
+// blockStyle drops the flow/quoted styles inherited from JSON input so the
+// encoder picks idiomatic YAML, quoting only where needed.
+func blockStyle(n *yaml.Node) {
+	n.Style = 0
+	for _, c := range n.Content {
+		blockStyle(c)
+	}
+}
@@ -0,0 +1,47 @@
+package outfmt
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWriteYAML(t *testing.T) {
+	type submitter struct {
+		Email string `json:"email"`
+	}
+	type submission struct {
+		ID         int            `json:"id"`
+		Status     string         `json:"status"`
+		Slug       string         `json:"slug"`
+		Note       string         `json:"note,omitempty"`
+		Submitters []submitter    `json:"submitters"`
+		Values     []string       `json:"values"`
+		Metadata   map[string]any `json:"metadata"`
+	}
+
+	v := &submission{
+		ID:         1,
+		Status:     "pending",
+		Slug:       "123",
+		Submitters: []submitter{{Email: "a@example.com"}},
+		Metadata:   map[string]any{"flag": "true"},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteYAML(&buf, v); err != nil {
+		t.Fatalf("WriteYAML() error = %v", err)
+	}
+
+	want := `id: 1
+status: pending
+slug: "123"
+submitters:
+  - email: a@example.com
+values: []
+metadata:
+  flag: "true"
+`
+	if got := buf.String(); got != want {
+		t.Errorf("WriteYAML() =\n%s\nwant\n%s", got, want)
+	}
+}
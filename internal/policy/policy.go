@@ -0,0 +1,190 @@
+// Package policy implements agent safety rules that restrict what the CLI may do.
+package policy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const (
+	// EnvName holds either an inline JSON policy or a path to a policy file.
+	EnvName = "DOCUSEAL_POLICY"
+	// FileName is the policy file looked up in the config directory when EnvName is unset.
+	FileName = "policy.json"
+
+	// ApprovalSendEmail is the approval required for sending emails when
+	// RequireSendEmailApproval is set.
+	ApprovalSendEmail = "send-email"
+)
+
+// Rule names reported in policy errors.
+const (
+	RuleReadOnly          = "read_only"
+	RuleDeny              = "deny"
+	RuleAllow             = "allow"
+	RuleMaxSubmissions    = "max_submissions_created"
+	RuleSendEmailApproval = "require_send_email_approval"
+)
+
+// Policy restricts which commands may run and what they may do.
+type Policy struct {
+	// ReadOnly blocks every command that changes server state.
+	ReadOnly bool `json:"read_only,omitempty"`
+	// Allow lists command paths (e.g. "submissions archive" or "templates") that may run.
+	// When non-empty, any command not matched is blocked.
+	Allow []string `json:"allow,omitempty"`
+	// Deny lists command paths that may not run. Deny wins over Allow.
+	Deny []string `json:"deny,omitempty"`
+	// MaxSubmissionsCreated caps the number of submissions created per run (0 = unlimited).
+	MaxSubmissionsCreated int `json:"max_submissions_created,omitempty"`
+	// RequireSendEmailApproval requires "--approve send-email" before emails are sent.
+	RequireSendEmailApproval bool `json:"require_send_email_approval,omitempty"`
+
+	// Source describes where the policy was loaded from.
+	Source string `json:"-"`
+
+	mu                sync.Mutex
+	submissionsCreate int
+}
+
+// Error is returned when a policy rule blocks an action.
+type Error struct {
+	Rule    string
+	Command string
+	Reason  string
+}
+
+func (e *Error) Error() string {
+	if e.Command != "" {
+		return fmt.Sprintf("blocked by policy (%s): %s: %s", e.Rule, e.Command, e.Reason)
+	}
+	return fmt.Sprintf("blocked by policy (%s): %s", e.Rule, e.Reason)
+}
+
+// IsPolicyError checks if an error is a policy violation.
+func IsPolicyError(err error) bool {
+	var pe *Error
+	return errors.As(err, &pe)
+}
+
+// Load reads the active policy.
+// DOCUSEAL_POLICY may contain inline JSON or a file path; otherwise policy.json in
+// configDir is used if present. It returns nil when no policy is configured.
+func Load(configDir string) (*Policy, error) {
+	if v := strings.TrimSpace(os.Getenv(EnvName)); v != "" {
+		if strings.HasPrefix(v, "{") {
+			return Parse([]byte(v), EnvName)
+		}
+		return loadFile(v)
+	}
+
+	if configDir == "" {
+		return nil, nil
+	}
+	path := filepath.Join(configDir, FileName)
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read policy file: %w", err)
+	}
+	return loadFile(path)
+}
+
+func loadFile(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy file: %w", err)
+	}
+	return Parse(data, path)
+}
+
+// Parse decodes a JSON policy. Unknown keys are rejected so typos fail closed.
+func Parse(data []byte, source string) (*Policy, error) {
+	dec := json.NewDecoder(strings.NewReader(string(data)))
+	dec.DisallowUnknownFields()
+	var p Policy
+	if err := dec.Decode(&p); err != nil {
+		return nil, fmt.Errorf("invalid policy (%s): %w", source, err)
+	}
+	if p.MaxSubmissionsCreated < 0 {
+		return nil, fmt.Errorf("invalid policy (%s): max_submissions_created must be >= 0", source)
+	}
+	p.Source = source
+	return &p, nil
+}
+
+// CheckCommand reports whether the command at path may run.
+// path is the command path without the binary name, e.g. "submissions archive".
+func (p *Policy) CheckCommand(path string, mutating bool) error {
+	if p == nil {
+		return nil
+	}
+	for _, rule := range p.Deny {
+		if matchPath(rule, path) {
+			return &Error{Rule: RuleDeny, Command: path, Reason: fmt.Sprintf("command matches deny rule %q", rule)}
+		}
+	}
+	if len(p.Allow) > 0 {
+		allowed := false
+		for _, rule := range p.Allow {
+			if matchPath(rule, path) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return &Error{Rule: RuleAllow, Command: path, Reason: "command is not in the allow list"}
+		}
+	}
+	if p.ReadOnly && mutating {
+		return &Error{Rule: RuleReadOnly, Command: path, Reason: "policy is read-only and this command changes data"}
+	}
+	return nil
+}
+
+// CheckSendEmail reports whether emails may be sent given the approvals granted.
+func (p *Policy) CheckSendEmail(path string, approvals []string) error {
+	if p == nil || !p.RequireSendEmailApproval {
+		return nil
+	}
+	for _, a := range approvals {
+		if strings.EqualFold(strings.TrimSpace(a), ApprovalSendEmail) {
+			return nil
+		}
+	}
+	return &Error{Rule: RuleSendEmailApproval, Command: path, Reason: "sending email requires --approve " + ApprovalSendEmail}
+}
+
+// ReserveSubmissions records n submissions about to be created and reports
+// whether that stays within MaxSubmissionsCreated for this run.
+func (p *Policy) ReserveSubmissions(n int) error {
+	if p == nil || p.MaxSubmissionsCreated == 0 {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.submissionsCreate+n > p.MaxSubmissionsCreated {
+		return &Error{
+			Rule:   RuleMaxSubmissions,
+			Reason: fmt.Sprintf("creating %d more submission(s) would exceed the limit of %d per run (%d already created)", n, p.MaxSubmissionsCreated, p.submissionsCreate),
+		}
+	}
+	p.submissionsCreate += n
+	return nil
+}
+
+// matchPath reports whether rule matches path exactly or as a parent command.
+// "*" matches every command.
+func matchPath(rule, path string) bool {
+	rule = strings.Join(strings.Fields(rule), " ")
+	if rule == "*" {
+		return true
+	}
+	return path == rule || strings.HasPrefix(path, rule+" ")
+}
@@ -0,0 +1,100 @@
+package policy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParse_RejectsUnknownKeys(t *testing.T) {
+	if _, err := Parse([]byte(`{"readonly": true}`), "test"); err == nil {
+		t.Fatal("Parse() error = nil, want error for unknown key")
+	}
+	if _, err := Parse([]byte(`{"max_submissions_created": -1}`), "test"); err == nil {
+		t.Fatal("Parse() error = nil, want error for negative cap")
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Setenv(EnvName, "")
+	p, err := Load(dir)
+	if err != nil || p != nil {
+		t.Fatalf("Load() = %v, %v, want nil policy", p, err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, FileName), []byte(`{"read_only": true}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	p, err = Load(dir)
+	if err != nil || p == nil || !p.ReadOnly {
+		t.Fatalf("Load() from file = %+v, %v", p, err)
+	}
+
+	t.Setenv(EnvName, `{"deny": ["webhooks"]}`)
+	p, err = Load(dir)
+	if err != nil || p == nil || p.ReadOnly || len(p.Deny) != 1 || p.Source != EnvName {
+		t.Fatalf("Load() from env = %+v, %v", p, err)
+	}
+}
+
+func TestCheckCommand(t *testing.T) {
+	p := &Policy{
+		ReadOnly: true,
+		Allow:    []string{"templates", "submissions list"},
+		Deny:     []string{"templates archive"},
+	}
+
+	tests := []struct {
+		path     string
+		mutating bool
+		wantRule string
+	}{
+		{"templates list", false, ""},
+		{"submissions list", false, ""},
+		{"templates archive", true, RuleDeny},
+		{"submissions get", false, RuleAllow},
+		{"submissionsx list", false, RuleAllow},
+		{"templates clone", true, RuleReadOnly},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			err := p.CheckCommand(tt.path, tt.mutating)
+			if tt.wantRule == "" {
+				if err != nil {
+					t.Fatalf("CheckCommand() error = %v", err)
+				}
+				return
+			}
+			pe, ok := err.(*Error)
+			if !ok || pe.Rule != tt.wantRule {
+				t.Fatalf("CheckCommand() error = %v, want rule %s", err, tt.wantRule)
+			}
+		})
+	}
+}
+
+func TestCheckSendEmail(t *testing.T) {
+	p := &Policy{RequireSendEmailApproval: true}
+	if err := p.CheckSendEmail("submissions create", nil); !IsPolicyError(err) {
+		t.Fatalf("CheckSendEmail() error = %v, want policy error", err)
+	}
+	if err := p.CheckSendEmail("submissions create", []string{"send-email"}); err != nil {
+		t.Fatalf("CheckSendEmail() with approval error = %v", err)
+	}
+}
+
+func TestReserveSubmissions(t *testing.T) {
+	p := &Policy{MaxSubmissionsCreated: 3}
+	if err := p.ReserveSubmissions(2); err != nil {
+		t.Fatalf("ReserveSubmissions(2) error = %v", err)
+	}
+	if err := p.ReserveSubmissions(2); !IsPolicyError(err) {
+		t.Fatalf("ReserveSubmissions(2) error = %v, want policy error", err)
+	}
+	if err := p.ReserveSubmissions(1); err != nil {
+		t.Fatalf("ReserveSubmissions(1) error = %v", err)
+	}
+}
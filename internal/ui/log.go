@@ -0,0 +1,143 @@
+package ui
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/muesli/termenv"
+)
+
+// NewLogHandler returns a slog handler that writes one line per record to w in the
+// CLI's message style: warnings start with "Warning: ", errors with "Error: " and debug
+// records with "[debug] ", followed by the message and the attributes as key=value.
+// Colors follow mode like in NewWithStreams, with w as the terminal.
+func NewLogHandler(w io.Writer, level slog.Leveler, mode ColorMode) slog.Handler {
+	output := termenv.NewOutput(w)
+	color := false
+	switch mode {
+	case ColorAlways:
+		color = true
+	case ColorAuto:
+		color = isTerminal(w) && output.Profile != termenv.Ascii
+	}
+	if os.Getenv("NO_COLOR") != "" && mode != ColorAlways {
+		color = false
+	}
+	if level == nil {
+		level = slog.LevelInfo
+	}
+	return &logHandler{shared: &logShared{w: w, output: output, color: color}, level: level}
+}
+
+// logShared is the state every handler derived with WithAttrs and WithGroup writes through.
+type logShared struct {
+	mu     sync.Mutex
+	w      io.Writer
+	output *termenv.Output
+	color  bool
+}
+
+type logHandler struct {
+	shared *logShared
+	level  slog.Leveler
+	attrs  string // preformatted " key=value" pairs from WithAttrs
+	group  string // "name." prefix from WithGroup
+}
+
+func (h *logHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.level.Level()
+}
+
+func (h *logHandler) Handle(_ context.Context, r slog.Record) error {
+	var b strings.Builder
+	b.WriteString(r.Message)
+	b.WriteString(h.attrs)
+	r.Attrs(func(a slog.Attr) bool {
+		appendLogAttr(&b, h.group, a)
+		return true
+	})
+
+	line := b.String()
+	var prefix string
+	var color termenv.Color
+	switch {
+	case r.Level >= slog.LevelError:
+		prefix, color = "Error: ", termenv.ANSIRed
+	case r.Level >= slog.LevelWarn:
+		prefix, color = "Warning: ", termenv.ANSIYellow
+	case r.Level < slog.LevelInfo:
+		prefix = "[debug] "
+	}
+	line = prefix + line
+	if h.shared.color && color != nil {
+		line = h.shared.output.String(line).Foreground(color).String()
+	}
+
+	h.shared.mu.Lock()
+	defer h.shared.mu.Unlock()
+	_, err := io.WriteString(h.shared.w, line+"\n")
+	return err
+}
+
+func (h *logHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	var b strings.Builder
+	for _, a := range attrs {
+		appendLogAttr(&b, h.group, a)
+	}
+	h2 := *h
+	h2.attrs += b.String()
+	return &h2
+}
+
+func (h *logHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	h2 := *h
+	h2.group += name + "."
+	return &h2
+}
+
+// appendLogAttr writes a as " key=value", flattening groups into dotted keys.
+func appendLogAttr(b *strings.Builder, group string, a slog.Attr) {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return
+	}
+	if a.Value.Kind() == slog.KindGroup {
+		if a.Key != "" {
+			group += a.Key + "."
+		}
+		for _, ga := range a.Value.Group() {
+			appendLogAttr(b, group, ga)
+		}
+		return
+	}
+	b.WriteString(" ")
+	b.WriteString(group + a.Key)
+	b.WriteString("=")
+	b.WriteString(logValue(a.Value))
+}
+
+// logValue formats v, quoting it when it is empty or would not read as one token.
+func logValue(v slog.Value) string {
+	var s string
+	switch v.Kind() {
+	case slog.KindDuration:
+		s = v.Duration().Round(time.Millisecond).String()
+	case slog.KindTime:
+		s = v.Time().Format(time.RFC3339)
+	default:
+		s = v.String()
+	}
+	if s == "" || strings.ContainsAny(s, " \t\r\n\"=") {
+		return strconv.Quote(s)
+	}
+	return s
+}
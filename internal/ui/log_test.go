@@ -0,0 +1,32 @@
+package ui
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+	"time"
+)
+
+func TestLogHandler(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(NewLogHandler(&buf, slog.LevelInfo, ColorNever))
+
+	logger.Debug("hidden")
+	logger.Info("More results may be available", "next", "--after 42")
+	logger.Warn("rate limited; retrying", "attempt", 2, "delay", 1500*time.Microsecond)
+	logger.With("step", "first").WithGroup("http").Error("failed", slog.Group("headers", "Accept", "application/json"), "body", "")
+
+	want := "More results may be available next=\"--after 42\"\n" +
+		"Warning: rate limited; retrying attempt=2 delay=2ms\n" +
+		"Error: failed step=first http.headers.Accept=application/json http.body=\"\"\n"
+	if got := buf.String(); got != want {
+		t.Errorf("log =\n%s\nwant\n%s", got, want)
+	}
+
+	buf.Reset()
+	logger = slog.New(NewLogHandler(&buf, slog.LevelDebug, ColorNever))
+	logger.Debug("--> GET https://example.com/api/user")
+	if got := buf.String(); got != "[debug] --> GET https://example.com/api/user\n" {
+		t.Errorf("debug log = %q", got)
+	}
+}
@@ -0,0 +1,389 @@
+package ui
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/term"
+)
+
+// ErrPickCanceled is returned by Pick when the user cancels with Esc or Ctrl-C.
+var ErrPickCanceled = errors.New("selection canceled")
+
+// PickItem is one choice offered by Pick.
+type PickItem struct {
+	// Value is returned when the item is selected.
+	Value string
+	// Label is shown in the list and matched against the query.
+	Label string
+}
+
+// PickOptions configures Pick.
+type PickOptions struct {
+	// Prompt is shown above the query line.
+	Prompt string
+	// Multi allows marking several items with Tab.
+	Multi bool
+	// Height is the maximum number of visible items (default 10).
+	Height int
+}
+
+// IsInteractive reports whether both the UI's stdin and stdout are terminals.
+func (u *UI) IsInteractive() bool {
+	return isTerminal(u.stdin()) && isTerminal(u.stdout())
+}
+
+// Pick shows a fuzzy finder on the terminal and returns the values of the selected items.
+// Typing filters the list; Up/Down (or Ctrl-P/Ctrl-N) move; Tab marks items when
+// opts.Multi is set; Enter confirms; Esc or Ctrl-C cancels. The picker is drawn on stderr
+// so stdout stays clean.
+func (u *UI) Pick(items []PickItem, opts PickOptions) ([]string, error) {
+	in, ok := u.stdin().(*os.File)
+	if !ok {
+		return nil, errors.New("failed to open terminal: stdin is not a terminal")
+	}
+	fd := int(in.Fd())
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open terminal: %w", err)
+	}
+	defer func() { _ = term.Restore(fd, state) }()
+
+	width := terminalWidth(u.stdout())
+	return RunPicker(in, u.stderr(), items, opts, width, u.colorActive)
+}
+
+// RunPicker runs the picker reading keys from in (a terminal in raw mode) and drawing on out.
+// width truncates rows when positive; color highlights the current row.
+func RunPicker(in io.Reader, out io.Writer, items []PickItem, opts PickOptions, width int, color bool) ([]string, error) {
+	if opts.Height <= 0 {
+		opts.Height = 10
+	}
+	p := &picker{items: items, opts: opts, width: width, color: color, marked: map[int]bool{}}
+	p.filter()
+
+	r := bufio.NewReader(in)
+	defer p.clear(out)
+	for {
+		p.draw(out)
+		key, err := readKey(r)
+		if err != nil {
+			return nil, ErrPickCanceled
+		}
+		done, err := p.handle(key)
+		if err != nil {
+			return nil, err
+		}
+		if done {
+			return p.selected(), nil
+		}
+	}
+}
+
+// Keys produced by readKey besides printable runes.
+const (
+	keyEnter = -(iota + 1)
+	keyCancel
+	keyUp
+	keyDown
+	keyBackspace
+	keyClear
+	keyTab
+	keyShiftTab
+	keyToggleAll
+	keyIgnore
+)
+
+// readKey reads one key press. Printable characters are returned as runes and control
+// keys as the negative key constants.
+func readKey(r *bufio.Reader) (rune, error) {
+	c, _, err := r.ReadRune()
+	if err != nil {
+		return 0, err
+	}
+	switch c {
+	case '\r', '\n':
+		return keyEnter, nil
+	case 0x03, 0x04, 0x07: // Ctrl-C, Ctrl-D, Ctrl-G
+		return keyCancel, nil
+	case 0x10, 0x0b: // Ctrl-P, Ctrl-K
+		return keyUp, nil
+	case 0x0e: // Ctrl-N
+		return keyDown, nil
+	case 0x7f, 0x08:
+		return keyBackspace, nil
+	case 0x15: // Ctrl-U
+		return keyClear, nil
+	case '\t':
+		return keyTab, nil
+	case 0x01: // Ctrl-A
+		return keyToggleAll, nil
+	case 0x1b:
+		// A lone Esc cancels; escape sequences arrive in one read and are already buffered.
+		if r.Buffered() == 0 {
+			return keyCancel, nil
+		}
+		next, _, _ := r.ReadRune()
+		if next != '[' && next != 'O' {
+			return keyCancel, nil
+		}
+		code, _, _ := r.ReadRune()
+		switch code {
+		case 'A':
+			return keyUp, nil
+		case 'B':
+			return keyDown, nil
+		case 'Z':
+			return keyShiftTab, nil
+		}
+		// Skip the rest of longer sequences such as "\x1b[3~".
+		for code >= '0' && code <= '9' || code == ';' {
+			if code, _, err = r.ReadRune(); err != nil {
+				return 0, err
+			}
+		}
+		return keyIgnore, nil
+	}
+	if !unicode.IsPrint(c) {
+		return keyIgnore, nil
+	}
+	return c, nil
+}
+
+type picker struct {
+	items  []PickItem
+	opts   PickOptions
+	width  int
+	color  bool
+	query  []rune
+	marked map[int]bool
+
+	matches []int // indexes into items, best match first
+	cursor  int   // index into matches
+	offset  int   // first visible index into matches
+	drawn   int   // lines drawn by the previous frame
+}
+
+// handle applies key and reports whether the selection is complete.
+func (p *picker) handle(key rune) (bool, error) {
+	switch key {
+	case keyEnter:
+		return len(p.matches) > 0 || len(p.marked) > 0, nil
+	case keyCancel:
+		return false, ErrPickCanceled
+	case keyUp:
+		p.move(-1)
+	case keyDown:
+		p.move(1)
+	case keyBackspace:
+		if len(p.query) > 0 {
+			p.query = p.query[:len(p.query)-1]
+			p.filter()
+		}
+	case keyClear:
+		p.query = nil
+		p.filter()
+	case keyTab, keyShiftTab:
+		if p.opts.Multi && len(p.matches) > 0 {
+			p.toggle(p.matches[p.cursor])
+			if key == keyTab {
+				p.move(1)
+			} else {
+				p.move(-1)
+			}
+		}
+	case keyToggleAll:
+		if p.opts.Multi {
+			for _, i := range p.matches {
+				p.toggle(i)
+			}
+		}
+	case keyIgnore:
+	default:
+		p.query = append(p.query, key)
+		p.filter()
+	}
+	return false, nil
+}
+
+func (p *picker) toggle(i int) {
+	if p.marked[i] {
+		delete(p.marked, i)
+	} else {
+		p.marked[i] = true
+	}
+}
+
+func (p *picker) move(delta int) {
+	if len(p.matches) == 0 {
+		return
+	}
+	p.cursor = (p.cursor + delta + len(p.matches)) % len(p.matches)
+	if p.cursor < p.offset {
+		p.offset = p.cursor
+	}
+	if p.cursor >= p.offset+p.opts.Height {
+		p.offset = p.cursor - p.opts.Height + 1
+	}
+}
+
+// filter recomputes the matches for the current query.
+func (p *picker) filter() {
+	type scored struct{ index, score int }
+	var ranked []scored
+	terms := strings.Fields(string(p.query))
+	for i, item := range p.items {
+		total, ok := 0, true
+		for _, t := range terms {
+			score, matched := FuzzyScore(t, item.Label)
+			if !matched {
+				ok = false
+				break
+			}
+			total += score
+		}
+		if ok {
+			ranked = append(ranked, scored{i, total})
+		}
+	}
+	sort.SliceStable(ranked, func(a, b int) bool { return ranked[a].score > ranked[b].score })
+
+	p.matches = p.matches[:0]
+	for _, s := range ranked {
+		p.matches = append(p.matches, s.index)
+	}
+	p.cursor, p.offset = 0, 0
+}
+
+// selected returns the marked values in item order, or the current item's value.
+func (p *picker) selected() []string {
+	if len(p.marked) > 0 {
+		var out []string
+		for i, item := range p.items {
+			if p.marked[i] {
+				out = append(out, item.Value)
+			}
+		}
+		return out
+	}
+	return []string{p.items[p.matches[p.cursor]].Value}
+}
+
+// draw redraws the picker in place, below the cursor position where it started.
+func (p *picker) draw(out io.Writer) {
+	var b strings.Builder
+	p.rewind(&b)
+
+	hint := "type to filter, ↑/↓ to move, enter to select, esc to cancel"
+	if p.opts.Multi {
+		hint = "type to filter, ↑/↓ to move, tab to mark, enter to select, esc to cancel"
+	}
+	lines := []string{fmt.Sprintf("%s (%s)", p.opts.Prompt, hint), "> " + string(p.query)}
+
+	end := p.offset + p.opts.Height
+	if end > len(p.matches) {
+		end = len(p.matches)
+	}
+	for pos := p.offset; pos < end; pos++ {
+		i := p.matches[pos]
+		prefix := "  "
+		if pos == p.cursor {
+			prefix = "> "
+		}
+		if p.opts.Multi {
+			if p.marked[i] {
+				prefix += "[x] "
+			} else {
+				prefix += "[ ] "
+			}
+		}
+		line := prefix + p.items[i].Label
+		if p.width > 0 {
+			line = Truncate(line, p.width-1)
+		}
+		if pos == p.cursor && p.color {
+			line = "\x1b[7m" + line + "\x1b[0m"
+		}
+		lines = append(lines, line)
+	}
+	status := fmt.Sprintf("  %d/%d", len(p.matches), len(p.items))
+	if p.opts.Multi && len(p.marked) > 0 {
+		status += fmt.Sprintf(" (%d marked)", len(p.marked))
+	}
+	lines = append(lines, status)
+
+	for i, line := range lines {
+		if i == 0 && p.width > 0 {
+			line = Truncate(line, p.width-1)
+		}
+		if i > 0 {
+			b.WriteString("\r\n")
+		}
+		b.WriteString(line)
+	}
+	// Park the cursor at the end of the query line.
+	if up := len(lines) - 2; up > 0 {
+		fmt.Fprintf(&b, "\x1b[%dA", up)
+	}
+	fmt.Fprintf(&b, "\r\x1b[%dC", 2+utf8.RuneCountInString(string(p.query)))
+	p.drawn = len(lines)
+	_, _ = io.WriteString(out, b.String())
+}
+
+// rewind moves the cursor to the first line of the previous frame and erases it.
+func (p *picker) rewind(b *strings.Builder) {
+	if p.drawn == 0 {
+		return
+	}
+	// The cursor rests on the query line, one below the prompt.
+	b.WriteString("\x1b[1A\r\x1b[J")
+}
+
+// clear erases the picker.
+func (p *picker) clear(out io.Writer) {
+	var b strings.Builder
+	p.rewind(&b)
+	p.drawn = 0
+	_, _ = io.WriteString(out, b.String())
+}
+
+// FuzzyScore reports whether the runes of pattern appear in text in order, ignoring case,
+// and scores the match: runs of consecutive runes and matches at word starts score
+// higher, gaps lower.
+func FuzzyScore(pattern, text string) (int, bool) {
+	pat := []rune(strings.ToLower(pattern))
+	if len(pat) == 0 {
+		return 0, true
+	}
+	txt := []rune(strings.ToLower(text))
+
+	score, pi, last := 0, 0, -1
+	for ti := 0; ti < len(txt) && pi < len(pat); ti++ {
+		if txt[ti] != pat[pi] {
+			continue
+		}
+		score++
+		switch {
+		case last >= 0 && ti == last+1:
+			score += 4
+		case last >= 0:
+			score -= min(ti-last-1, 3)
+		}
+		if ti == 0 || !unicode.IsLetter(txt[ti-1]) && !unicode.IsDigit(txt[ti-1]) {
+			score += 3
+		}
+		last = ti
+		pi++
+	}
+	if pi < len(pat) {
+		return 0, false
+	}
+	return score, true
+}
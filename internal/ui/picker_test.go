@@ -0,0 +1,111 @@
+package ui
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var testPickItems = []PickItem{
+	{Value: "1", Label: "#1  Employment Agreement [pending]"},
+	{Value: "2", Label: "#2  NDA [completed]"},
+	{Value: "3", Label: "#3  Offer Letter [pending]"},
+	{Value: "4", Label: "#4  Non-Disclosure Addendum [declined]"},
+}
+
+func TestRunPicker(t *testing.T) {
+	tests := []struct {
+		name    string
+		multi   bool
+		input   string
+		want    []string
+		wantErr error
+	}{
+		{name: "enter picks first", input: "\r", want: []string{"1"}},
+		{name: "arrow down", input: "\x1b[B\x1b[B\r", want: []string{"3"}},
+		{name: "arrow up wraps", input: "\x1b[A\r", want: []string{"4"}},
+		{name: "ctrl-n ctrl-p", input: "\x0e\x0e\x10\r", want: []string{"2"}},
+		{name: "filter", input: "offer\r", want: []string{"3"}},
+		{name: "filter terms", input: "non add\r", want: []string{"4"}},
+		{name: "backspace", input: "offerx\x7f\r", want: []string{"3"}},
+		{name: "clear query", input: "offer\x15\r", want: []string{"1"}},
+		{name: "no match ignores enter", input: "zzz\r\x15\r", want: []string{"1"}},
+		{name: "tab ignored in single mode", input: "\t\r", want: []string{"1"}},
+		{name: "multi marks", multi: true, input: "\t\x1b[B\t\r", want: []string{"1", "3"}},
+		{name: "multi without marks", multi: true, input: "\x1b[B\r", want: []string{"2"}},
+		{name: "multi toggle all", multi: true, input: "pending\x01\r", want: []string{"1", "3"}},
+		{name: "multi unmark", multi: true, input: "\t\x1b[Z\t\x1b[B\r", want: []string{"2"}},
+		{name: "esc cancels", input: "off\x1b", wantErr: ErrPickCanceled},
+		{name: "ctrl-c cancels", input: "\x03", wantErr: ErrPickCanceled},
+		{name: "eof cancels", input: "off", wantErr: ErrPickCanceled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			got, err := RunPicker(strings.NewReader(tt.input), &out, testPickItems, PickOptions{Prompt: "Select", Multi: tt.multi}, 80, false)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RunPicker() error = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RunPicker() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunPickerDraw(t *testing.T) {
+	var out bytes.Buffer
+	_, err := RunPicker(strings.NewReader("\x1b[B\t\r"), &out, testPickItems, PickOptions{Prompt: "Select template", Multi: true, Height: 2}, 30, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := out.String()
+	for _, want := range []string{"Select template", "> [ ] #1  Employment", "  [x] #2  NDA [completed]", "> [ ] #3  Offer", "4/4 (1 marked)"} {
+		if !strings.Contains(s, want) {
+			t.Errorf("output missing %q:\n%q", want, s)
+		}
+	}
+	// Height 2 scrolls item 1 out of view when the cursor reaches item 3.
+	frames := strings.Split(s, "\x1b[J")
+	if last := frames[len(frames)-2]; strings.Contains(last, "#1") {
+		t.Errorf("last frame not scrolled: %q", last)
+	}
+	// Rows are truncated to the terminal width.
+	if strings.Contains(s, "Employment Agreement [pending]") {
+		t.Errorf("row not truncated:\n%q", s)
+	}
+	// The final frame is erased.
+	if !strings.HasSuffix(s, "\x1b[1A\r\x1b[J") {
+		t.Errorf("picker not cleared: %q", s[len(s)-20:])
+	}
+}
+
+func TestFuzzyScore(t *testing.T) {
+	tests := []struct {
+		pattern, text string
+		ok            bool
+	}{
+		{"", "anything", true},
+		{"nda", "NDA [completed]", true},
+		{"nda", "Non-Disclosure Addendum", true},
+		{"ofl", "Offer Letter", true},
+		{"xyz", "Offer Letter", false},
+		{"letteroffer", "Offer Letter", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern+"/"+tt.text, func(t *testing.T) {
+			if _, ok := FuzzyScore(tt.pattern, tt.text); ok != tt.ok {
+				t.Errorf("FuzzyScore(%q, %q) ok = %v, want %v", tt.pattern, tt.text, ok, tt.ok)
+			}
+		})
+	}
+
+	// Contiguous and word-start matches rank above scattered ones.
+	exact, _ := FuzzyScore("nda", "#2  NDA [completed]")
+	scattered, _ := FuzzyScore("nda", "#4  Non-Disclosure Addendum [declined]")
+	if exact <= scattered {
+		t.Errorf("FuzzyScore contiguous = %d, scattered = %d; want contiguous higher", exact, scattered)
+	}
+}
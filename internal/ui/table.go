@@ -0,0 +1,264 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/muesli/termenv"
+	"github.com/rivo/uniseg"
+	"golang.org/x/term"
+)
+
+// columnGap is the number of spaces between table columns.
+const columnGap = 2
+
+// minShrinkWidth is the narrowest a shrinkable column gets when fitting the terminal.
+const minShrinkWidth = 8
+
+// Column describes one table column.
+type Column struct {
+	// Name identifies the column in --columns, e.g. "created".
+	Name string
+	// Header is shown in the header row, e.g. "CREATED".
+	Header string
+	// Fixed columns (IDs, dates) are never shrunk to fit the terminal.
+	Fixed bool
+	// Status colors cell values by status (completed, pending, declined, ...).
+	Status bool
+	// Hidden columns are only shown when selected with --columns.
+	Hidden bool
+}
+
+// Table renders rows as aligned columns. On a terminal it shrinks the widest columns to fit
+// the screen and colors status values; when piped it prints plain, untruncated text.
+type Table struct {
+	columns []Column
+	visible []int
+	rows    [][]string
+	width   int
+	color   bool
+	output  *termenv.Output
+}
+
+// NewTable creates a table with the given columns, sized to the terminal and using the
+// UI's color settings.
+func (u *UI) NewTable(columns []Column) *Table {
+	t := &Table{
+		columns: columns,
+		width:   terminalWidth(u.stdout()),
+		color:   u.colorActive,
+		output:  u.output,
+	}
+	for i, c := range columns {
+		if !c.Hidden {
+			t.visible = append(t.visible, i)
+		}
+	}
+	return t
+}
+
+// SetColumns selects and orders the visible columns by name (comma-separated).
+// An empty list keeps the default columns.
+func (t *Table) SetColumns(names string) error {
+	if strings.TrimSpace(names) == "" {
+		return nil
+	}
+	idx, err := columnIndexes(t.columns, names)
+	if err != nil {
+		return err
+	}
+	t.visible = idx
+	return nil
+}
+
+// ValidateColumns reports whether names (comma-separated) are all valid column names.
+func ValidateColumns(columns []Column, names string) error {
+	if strings.TrimSpace(names) == "" {
+		return nil
+	}
+	_, err := columnIndexes(columns, names)
+	return err
+}
+
+func columnIndexes(columns []Column, names string) ([]int, error) {
+	var idx []int
+	for _, name := range strings.Split(names, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		found := -1
+		for i, c := range columns {
+			if c.Name == name {
+				found = i
+				break
+			}
+		}
+		if found < 0 {
+			return nil, fmt.Errorf("unknown column %q (available: %s)", name, strings.Join(ColumnNames(columns), ", "))
+		}
+		idx = append(idx, found)
+	}
+	return idx, nil
+}
+
+// ColumnNames returns the names of all columns, including hidden ones.
+func ColumnNames(columns []Column) []string {
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = c.Name
+	}
+	return names
+}
+
+// SetWidth overrides the detected width; 0 disables fitting.
+func (t *Table) SetWidth(width int) { t.width = width }
+
+// AddRow appends a row; cells correspond to the table's columns, in order.
+func (t *Table) AddRow(cells ...string) {
+	t.rows = append(t.rows, cells)
+}
+
+// Render writes the table to w.
+func (t *Table) Render(w io.Writer) error {
+	widths := make([]int, len(t.visible))
+	for i, ci := range t.visible {
+		widths[i] = uniseg.StringWidth(t.columns[ci].Header)
+		for _, row := range t.rows {
+			if cw := uniseg.StringWidth(t.cell(row, ci)); cw > widths[i] {
+				widths[i] = cw
+			}
+		}
+	}
+	t.fit(widths)
+
+	var b strings.Builder
+	headers := make([]string, len(t.visible))
+	for i, ci := range t.visible {
+		headers[i] = t.columns[ci].Header
+	}
+	t.writeLine(&b, headers, widths, true)
+	for _, row := range t.rows {
+		cells := make([]string, len(t.visible))
+		for i, ci := range t.visible {
+			cells[i] = t.cell(row, ci)
+		}
+		t.writeLine(&b, cells, widths, false)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func (t *Table) cell(row []string, ci int) string {
+	if ci < len(row) {
+		return row[ci]
+	}
+	return ""
+}
+
+// fit shrinks the widest shrinkable columns, one character at a time, until the
+// table fits in t.width or nothing more can shrink.
+func (t *Table) fit(widths []int) {
+	if t.width <= 0 || len(widths) == 0 {
+		return
+	}
+	total := columnGap * (len(widths) - 1)
+	for _, w := range widths {
+		total += w
+	}
+	for total > t.width {
+		widest := -1
+		for i, ci := range t.visible {
+			if t.columns[ci].Fixed || widths[i] <= minShrinkWidth {
+				continue
+			}
+			if widest < 0 || widths[i] > widths[widest] {
+				widest = i
+			}
+		}
+		if widest < 0 {
+			return
+		}
+		widths[widest]--
+		total--
+	}
+}
+
+func (t *Table) writeLine(b *strings.Builder, cells []string, widths []int, header bool) {
+	for i, cell := range cells {
+		cell = Truncate(cell, widths[i])
+		pad := widths[i] - uniseg.StringWidth(cell)
+
+		styled := cell
+		if t.color {
+			switch {
+			case header:
+				styled = t.output.String(cell).Bold().String()
+			case t.columns[t.visible[i]].Status:
+				if c, ok := statusColor(cell); ok {
+					styled = t.output.String(cell).Foreground(c).String()
+				}
+			}
+		}
+		b.WriteString(styled)
+		if i < len(cells)-1 {
+			b.WriteString(strings.Repeat(" ", pad+columnGap))
+		}
+	}
+	b.WriteByte('\n')
+}
+
+// statusColor maps a status value to its color.
+func statusColor(status string) (termenv.Color, bool) {
+	switch strings.ToLower(status) {
+	case "completed", "signed", "active", "pass":
+		return termenv.ANSIGreen, true
+	case "pending", "sent", "opened", "awaiting", "warn":
+		return termenv.ANSIYellow, true
+	case "declined", "expired", "failed", "fail", "error", "archived":
+		return termenv.ANSIRed, true
+	}
+	return nil, false
+}
+
+// Truncate shortens s to at most width display cells, ending in "..." when cut.
+func Truncate(s string, width int) string {
+	if uniseg.StringWidth(s) <= width {
+		return s
+	}
+	if width <= 3 {
+		return strings.Repeat(".", width)
+	}
+	var b strings.Builder
+	used := 0
+	g := uniseg.NewGraphemes(s)
+	for g.Next() {
+		w := g.Width()
+		if used+w > width-3 {
+			break
+		}
+		b.WriteString(g.Str())
+		used += w
+	}
+	return b.String() + "..."
+}
+
+// terminalWidth returns the width of the terminal w, or 0 when w is not a terminal.
+// A positive COLUMNS environment variable overrides the detected width.
+func terminalWidth(w io.Writer) int {
+	if !isTerminal(w) {
+		return 0
+	}
+	fd := int(w.(*os.File).Fd())
+	if n, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && n > 0 {
+		return n
+	}
+	width, _, err := term.GetSize(fd)
+	if err != nil {
+		return 0
+	}
+	return width
+}
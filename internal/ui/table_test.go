@@ -0,0 +1,118 @@
+package ui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/muesli/termenv"
+)
+
+var testColumns = []Column{
+	{Name: "id", Header: "ID", Fixed: true},
+	{Name: "name", Header: "NAME"},
+	{Name: "status", Header: "STATUS", Status: true},
+	{Name: "slug", Header: "SLUG", Hidden: true},
+}
+
+func newTestTable(width int) *Table {
+	u := New(ColorNever)
+	t := u.NewTable(testColumns)
+	t.SetWidth(width)
+	t.AddRow("1", "Employment Agreement 2024 Final Version", "pending", "abc")
+	t.AddRow("22", "NDA", "completed", "def")
+	return t
+}
+
+func TestTableRender(t *testing.T) {
+	tests := []struct {
+		name    string
+		width   int
+		columns string
+		want    string
+	}{
+		{
+			name:  "unlimited width",
+			width: 0,
+			want: "ID  NAME                                     STATUS\n" +
+				"1   Employment Agreement 2024 Final Version  pending\n" +
+				"22  NDA                                      completed\n",
+		},
+		{
+			name:  "shrinks widest column",
+			width: 30,
+			want: "ID  NAME             STATUS\n" +
+				"1   Employment A...  pending\n" +
+				"22  NDA              completed\n",
+		},
+		{
+			name:    "selected columns",
+			columns: "slug, id",
+			want: "SLUG  ID\n" +
+				"abc   1\n" +
+				"def   22\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := newTestTable(tt.width)
+			if err := table.SetColumns(tt.columns); err != nil {
+				t.Fatalf("SetColumns() error = %v", err)
+			}
+			var buf bytes.Buffer
+			if err := table.Render(&buf); err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("Render() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTableUnknownColumn(t *testing.T) {
+	err := ValidateColumns(testColumns, "id,bogus")
+	if err == nil || !strings.Contains(err.Error(), "available: id, name, status, slug") {
+		t.Errorf("ValidateColumns() error = %v, want unknown column error listing names", err)
+	}
+}
+
+func TestTableStatusColor(t *testing.T) {
+	table := newTestTable(0)
+	table.color = true
+	table.output = termenv.NewOutput(&bytes.Buffer{}, termenv.WithProfile(termenv.ANSI))
+
+	var buf bytes.Buffer
+	if err := table.Render(&buf); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	got := buf.String()
+	if !strings.Contains(got, "\x1b[33mpending\x1b[0m") {
+		t.Errorf("Render() should color pending yellow, got %q", got)
+	}
+	if !strings.Contains(got, "\x1b[32mcompleted\x1b[0m") {
+		t.Errorf("Render() should color completed green, got %q", got)
+	}
+	if strings.Contains(got, "mNDA") {
+		t.Errorf("Render() should not color non-status columns, got %q", got)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		in    string
+		width int
+		want  string
+	}{
+		{"hello", 10, "hello"},
+		{"hello world", 8, "hello..."},
+		{"日本語テキスト", 9, "日本語..."},
+		{"abcdef", 2, ".."},
+	}
+	for _, tt := range tests {
+		if got := Truncate(tt.in, tt.width); got != tt.want {
+			t.Errorf("Truncate(%q, %d) = %q, want %q", tt.in, tt.width, got, tt.want)
+		}
+	}
+}
@@ -23,11 +23,24 @@ type UI struct {
 	output      *termenv.Output
 	colorMode   ColorMode
 	colorActive bool
+
+	// Streams used instead of the process's stdin, stdout and stderr when set.
+	in     io.Reader
+	out    io.Writer
+	errOut io.Writer
 }
 
 // New creates a new UI instance
 func New(mode ColorMode) *UI {
-	output := termenv.NewOutput(os.Stdout)
+	return NewWithStreams(mode, nil, nil, nil)
+}
+
+// NewWithStreams creates a UI that reads keys from in and writes to out and errOut. Nil
+// streams fall back to the process's stdin, stdout and stderr. Colors are only enabled
+// automatically when out is a terminal.
+func NewWithStreams(mode ColorMode, in io.Reader, out, errOut io.Writer) *UI {
+	u := &UI{colorMode: mode, in: in, out: out, errOut: errOut}
+	output := termenv.NewOutput(u.stdout())
 
 	// Determine if colors should be active
 	colorActive := false
@@ -39,18 +52,43 @@ func New(mode ColorMode) *UI {
 		colorActive = false
 	case ColorAuto:
 		// Enable colors only on an interactive terminal with color support.
-		colorActive = term.IsTerminal(int(os.Stdout.Fd())) && output.Profile != termenv.Ascii
+		colorActive = isTerminal(u.stdout()) && output.Profile != termenv.Ascii
 	}
 	// Let explicit --color always win over NO_COLOR.
 	if noColor && mode != ColorAlways {
 		colorActive = false
 	}
 
-	return &UI{
-		output:      output,
-		colorMode:   mode,
-		colorActive: colorActive,
+	u.output = output
+	u.colorActive = colorActive
+	return u
+}
+
+func (u *UI) stdin() io.Reader {
+	if u.in != nil {
+		return u.in
 	}
+	return os.Stdin
+}
+
+func (u *UI) stdout() io.Writer {
+	if u.out != nil {
+		return u.out
+	}
+	return os.Stdout
+}
+
+func (u *UI) stderr() io.Writer {
+	if u.errOut != nil {
+		return u.errOut
+	}
+	return os.Stderr
+}
+
+// isTerminal reports whether v is a file attached to a terminal.
+func isTerminal(v any) bool {
+	f, ok := v.(*os.File)
+	return ok && term.IsTerminal(int(f.Fd()))
 }
 
 // Success prints a success message in green
@@ -58,9 +96,9 @@ func (u *UI) Success(format string, args ...interface{}) {
 	msg := fmt.Sprintf(format, args...)
 	if u.colorActive {
 		styled := u.output.String(msg).Foreground(termenv.ANSIGreen)
-		_, _ = fmt.Fprintln(os.Stdout, styled)
+		_, _ = fmt.Fprintln(u.stdout(), styled)
 	} else {
-		_, _ = fmt.Fprintln(os.Stdout, msg)
+		_, _ = fmt.Fprintln(u.stdout(), msg)
 	}
 }
 
@@ -69,9 +107,9 @@ func (u *UI) Error(format string, args ...interface{}) {
 	msg := fmt.Sprintf(format, args...)
 	if u.colorActive {
 		styled := u.output.String(msg).Foreground(termenv.ANSIRed)
-		fmt.Fprintln(os.Stderr, styled)
+		fmt.Fprintln(u.stderr(), styled)
 	} else {
-		fmt.Fprintln(os.Stderr, msg)
+		fmt.Fprintln(u.stderr(), msg)
 	}
 }
 
@@ -80,9 +118,9 @@ func (u *UI) Warning(format string, args ...interface{}) {
 	msg := fmt.Sprintf(format, args...)
 	if u.colorActive {
 		styled := u.output.String(msg).Foreground(termenv.ANSIYellow)
-		fmt.Fprintln(os.Stderr, styled)
+		fmt.Fprintln(u.stderr(), styled)
 	} else {
-		fmt.Fprintln(os.Stderr, msg)
+		fmt.Fprintln(u.stderr(), msg)
 	}
 }
 
@@ -91,9 +129,9 @@ func (u *UI) Info(format string, args ...interface{}) {
 	msg := fmt.Sprintf(format, args...)
 	if u.colorActive {
 		styled := u.output.String(msg).Foreground(termenv.ANSIBlue)
-		_, _ = fmt.Fprintln(os.Stdout, styled)
+		_, _ = fmt.Fprintln(u.stdout(), styled)
 	} else {
-		_, _ = fmt.Fprintln(os.Stdout, msg)
+		_, _ = fmt.Fprintln(u.stdout(), msg)
 	}
 }
 
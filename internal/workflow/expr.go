@@ -0,0 +1,144 @@
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/itchyny/gojq"
+)
+
+// Expressions are jq. steps and inputs are functions, so GitHub-style references such as
+// steps.create.outputs.id work, and "." is the value the expression applies to (a
+// command's result for outputs and wait.until, null elsewhere).
+const exprPrelude = "def steps: $steps; def inputs: $inputs; "
+
+// scope is what expressions can see.
+type scope struct {
+	steps  map[string]any
+	inputs map[string]any
+}
+
+func compile(expr string) (*gojq.Code, error) {
+	expr = unwrap(expr)
+	if expr == "" {
+		expr = "null"
+	}
+	q, err := gojq.Parse(exprPrelude + expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expression %q: %w", expr, err)
+	}
+	code, err := gojq.Compile(q, gojq.WithVariables([]string{"$steps", "$inputs"}))
+	if err != nil {
+		return nil, fmt.Errorf("invalid expression %q: %w", expr, err)
+	}
+	return code, nil
+}
+
+// unwrap strips an optional ${{ }} around a whole expression (as in if: and until:).
+func unwrap(expr string) string {
+	expr = strings.TrimSpace(expr)
+	if strings.HasPrefix(expr, "${{") && strings.HasSuffix(expr, "}}") && strings.Count(expr, "${{") == 1 {
+		expr = strings.TrimSpace(expr[3 : len(expr)-2])
+	}
+	return expr
+}
+
+// eval returns the first value expr produces for input.
+func (sc scope) eval(ctx context.Context, expr string, input any) (any, error) {
+	code, err := compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	iter := code.RunWithContext(ctx, input, sc.steps, sc.inputs)
+	v, ok := iter.Next()
+	if !ok {
+		return nil, nil
+	}
+	if err, isErr := v.(error); isErr {
+		return nil, fmt.Errorf("expression %q: %w", unwrap(expr), err)
+	}
+	return v, nil
+}
+
+// truthy evaluates a condition with jq truthiness: only false and null are false. An
+// empty condition is true.
+func (sc scope) truthy(ctx context.Context, expr string, input any) (bool, error) {
+	if strings.TrimSpace(expr) == "" {
+		return true, nil
+	}
+	v, err := sc.eval(ctx, expr, input)
+	if err != nil {
+		return false, err
+	}
+	return v != nil && v != false, nil
+}
+
+// expand replaces each ${{ expr }} in s with the expression's value.
+func (sc scope) expand(ctx context.Context, s string) (string, error) {
+	var out strings.Builder
+	for {
+		start := strings.Index(s, "${{")
+		if start < 0 {
+			out.WriteString(s)
+			return out.String(), nil
+		}
+		end := strings.Index(s[start:], "}}")
+		if end < 0 {
+			return "", fmt.Errorf("unterminated ${{ in %q", s)
+		}
+		out.WriteString(s[:start])
+		v, err := sc.eval(ctx, s[start+3:start+end], nil)
+		if err != nil {
+			return "", err
+		}
+		str, err := valueString(v)
+		if err != nil {
+			return "", err
+		}
+		out.WriteString(str)
+		s = s[start+end+2:]
+	}
+}
+
+// templateExprs returns the expressions inside ${{ }} in s.
+func templateExprs(s string) ([]string, error) {
+	var out []string
+	for {
+		start := strings.Index(s, "${{")
+		if start < 0 {
+			return out, nil
+		}
+		end := strings.Index(s[start:], "}}")
+		if end < 0 {
+			return nil, fmt.Errorf("unterminated ${{ in %q", s)
+		}
+		out = append(out, s[start+3:start+end])
+		s = s[start+end+2:]
+	}
+}
+
+// valueString renders a value as a command-line argument: strings as-is, whole numbers
+// without exponent, null as empty, and objects and arrays as compact JSON.
+func valueString(v any) (string, error) {
+	switch x := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return x, nil
+	case bool:
+		return strconv.FormatBool(x), nil
+	case int:
+		return strconv.Itoa(x), nil
+	case float64:
+		return strconv.FormatFloat(x, 'f', -1, 64), nil
+	default:
+		data, err := json.Marshal(x)
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	}
+}
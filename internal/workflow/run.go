@@ -0,0 +1,261 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Runner executes workflows.
+type Runner struct {
+	// Exec runs a command line and returns its decoded JSON result.
+	Exec func(ctx context.Context, args []string) (any, error)
+	// Save persists the state after every change (optional).
+	Save func(*State) error
+	// StepDone is called after each step finishes or is skipped (optional).
+	StepDone func(StepState)
+	// Now and Sleep default to the real clock.
+	Now   func() time.Time
+	Sleep func(ctx context.Context, d time.Duration) error
+}
+
+// StepError reports the step a run failed at. It wraps the command's error, so callers
+// can still classify it.
+type StepError struct {
+	Step string
+	Err  error
+}
+
+func (e *StepError) Error() string { return fmt.Sprintf("step %q failed: %v", e.Step, e.Err) }
+
+func (e *StepError) Unwrap() error { return e.Err }
+
+// Run runs the pending steps of st in order. It returns a *StepError when a step fails
+// without continue_on_error, or the context's error when interrupted; st records the
+// outcome either way.
+func (r *Runner) Run(ctx context.Context, wf *Workflow, st *State) error {
+	if len(st.Steps) != len(wf.Steps) {
+		return fmt.Errorf("run state does not match the workflow's %d steps", len(wf.Steps))
+	}
+	sc := scope{steps: map[string]any{}, inputs: map[string]any{}}
+	for k, v := range st.Inputs {
+		sc.inputs[k] = v
+	}
+
+	st.Status = StatusRunning
+	if err := r.save(st); err != nil {
+		return err
+	}
+	for i := range wf.Steps {
+		step, ss := &wf.Steps[i], &st.Steps[i]
+		if ss.Status == StatusPending {
+			err := r.runStep(ctx, sc, st, step, ss)
+			if r.StepDone != nil {
+				r.StepDone(*ss)
+			}
+			if err != nil {
+				return r.finish(st, err, step)
+			}
+			if serr := r.save(st); serr != nil {
+				return serr
+			}
+		}
+		sc.steps[ss.ID] = map[string]any{"status": ss.Status, "outputs": ss.Outputs, "result": ss.Result}
+	}
+	return r.finish(st, nil, nil)
+}
+
+func (r *Runner) finish(st *State, err error, step *Step) error {
+	now := r.now().UTC()
+	switch {
+	case err == nil:
+		st.Status = StatusSucceeded
+	case ctxErr(err):
+		st.Status = StatusInterrupted
+	default:
+		st.Status = StatusFailed
+		err = &StepError{Step: step.ID, Err: err}
+	}
+	if st.Status != StatusInterrupted {
+		st.FinishedAt = &now
+	}
+	if serr := r.save(st); serr != nil && err == nil {
+		return serr
+	}
+	return err
+}
+
+// runStep runs one step and records its outcome. It returns an error only when the run
+// must stop.
+func (r *Runner) runStep(ctx context.Context, sc scope, st *State, step *Step, ss *StepState) error {
+	ok, err := sc.truthy(ctx, step.If, nil)
+	if err != nil {
+		return r.fail(ss, err, false)
+	}
+	if !ok {
+		now := r.now().UTC()
+		ss.Status, ss.FinishedAt = StatusSkipped, &now
+		return nil
+	}
+
+	start := r.now().UTC()
+	if ss.StartedAt == nil {
+		ss.StartedAt = &start
+	}
+	ss.Status = StatusRunning
+	if err := r.save(st); err != nil {
+		return err
+	}
+
+	var result any
+	if step.Wait != nil {
+		result, err = r.wait(ctx, sc, step, ss)
+	} else {
+		result, err = r.attempts(ctx, sc, step, step.Run, step.Timeout, ss)
+	}
+	if err == nil {
+		ss.Result = result
+		ss.Outputs, err = outputs(ctx, sc, step, result)
+	}
+	end := r.now().UTC()
+	ss.FinishedAt = &end
+	ss.DurationMS = end.Sub(start).Milliseconds()
+	if err != nil {
+		return r.fail(ss, err, step.ContinueOnError && !ctxErr(err))
+	}
+	ss.Status = StatusSucceeded
+	return nil
+}
+
+func (r *Runner) fail(ss *StepState, err error, cont bool) error {
+	ss.Error = err.Error()
+	if ctxErr(err) {
+		ss.Status = StatusInterrupted
+	} else {
+		ss.Status = StatusFailed
+	}
+	if cont {
+		return nil
+	}
+	return err
+}
+
+// attempts expands and runs a command, retrying failures as configured.
+func (r *Runner) attempts(ctx context.Context, sc scope, step *Step, run Command, timeout time.Duration, ss *StepState) (any, error) {
+	args := make([]string, len(run))
+	for i, arg := range run {
+		v, err := sc.expand(ctx, arg)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = v
+	}
+	ss.Command = args
+
+	max, delay := 1, DefaultRetryDelay
+	if step.Retry != nil {
+		max = step.Retry.Attempts
+		if step.Retry.Delay > 0 {
+			delay = step.Retry.Delay
+		}
+	}
+	for attempt := 1; ; attempt++ {
+		ss.Attempts++
+		actx, cancel := ctx, context.CancelFunc(func() {})
+		if timeout > 0 {
+			actx, cancel = context.WithTimeout(ctx, timeout)
+		}
+		result, err := r.Exec(actx, args)
+		cancel()
+		if err == nil || attempt >= max || ctx.Err() != nil {
+			return result, err
+		}
+		if err := r.sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+		delay *= 2
+	}
+}
+
+// wait polls the wait command until its condition holds or the wait times out. The
+// deadline counts from the step's first start, so a resumed wait does not restart it.
+func (r *Runner) wait(ctx context.Context, sc scope, step *Step, ss *StepState) (any, error) {
+	w := step.Wait
+	interval, timeout := w.Interval, w.Timeout
+	if interval <= 0 {
+		interval = DefaultWaitInterval
+	}
+	if timeout <= 0 {
+		timeout = DefaultWaitTimeout
+	}
+	deadline := ss.StartedAt.Add(timeout)
+	for {
+		ss.Polls++
+		result, err := r.attempts(ctx, sc, step, w.Run, 0, ss)
+		if err != nil {
+			return nil, err
+		}
+		done, err := sc.truthy(ctx, w.Until, result)
+		if err != nil {
+			return nil, err
+		}
+		if done {
+			return result, nil
+		}
+		if !r.now().Add(interval).Before(deadline) {
+			return result, fmt.Errorf("timed out after %s waiting for %s", timeout, unwrap(w.Until))
+		}
+		if err := r.sleep(ctx, interval); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// outputs derives a step's outputs from its result.
+func outputs(ctx context.Context, sc scope, step *Step, result any) (any, error) {
+	if len(step.Outputs) == 0 {
+		return result, nil
+	}
+	out := map[string]any{}
+	for name, expr := range step.Outputs {
+		v, err := sc.eval(ctx, expr, result)
+		if err != nil {
+			return nil, fmt.Errorf("output %q: %w", name, err)
+		}
+		out[name] = v
+	}
+	return out, nil
+}
+
+func (r *Runner) save(st *State) error {
+	if r.Save == nil {
+		return nil
+	}
+	return r.Save(st)
+}
+
+func (r *Runner) now() time.Time {
+	if r.Now != nil {
+		return r.Now()
+	}
+	return time.Now()
+}
+
+func (r *Runner) sleep(ctx context.Context, d time.Duration) error {
+	if r.Sleep != nil {
+		return r.Sleep(ctx, d)
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+func ctxErr(err error) bool {
+	return errors.Is(err, context.Canceled)
+}
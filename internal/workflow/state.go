@@ -0,0 +1,165 @@
+package workflow
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+)
+
+// DirName is the directory inside the config directory that holds run state.
+const DirName = "runs"
+
+// Run and step statuses.
+const (
+	StatusPending     = "pending"
+	StatusRunning     = "running"
+	StatusSucceeded   = "succeeded"
+	StatusFailed      = "failed"
+	StatusSkipped     = "skipped"
+	StatusInterrupted = "interrupted"
+)
+
+var runIDRe = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// State is the persisted progress of a run. It doubles as the run report.
+type State struct {
+	RunID      string            `json:"run_id"`
+	Workflow   string            `json:"workflow"`
+	File       string            `json:"file"`
+	Digest     string            `json:"digest"`
+	Status     string            `json:"status"`
+	Inputs     map[string]string `json:"inputs"`
+	StartedAt  time.Time         `json:"started_at"`
+	FinishedAt *time.Time        `json:"finished_at,omitempty"`
+	Steps      []StepState       `json:"steps"`
+}
+
+// StepState is the progress of one step.
+type StepState struct {
+	ID         string     `json:"id"`
+	Status     string     `json:"status"`
+	Attempts   int        `json:"attempts,omitempty"`
+	Polls      int        `json:"polls,omitempty"`
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	DurationMS int64      `json:"duration_ms,omitempty"`
+	Command    []string   `json:"command,omitempty"`
+	Result     any        `json:"result,omitempty"`
+	Outputs    any        `json:"outputs,omitempty"`
+	Error      string     `json:"error,omitempty"`
+}
+
+// NewState starts a run of wf with the given inputs (declared defaults already applied).
+func NewState(runID, file string, wf *Workflow, inputs map[string]string, now time.Time) *State {
+	st := &State{
+		RunID:     runID,
+		Workflow:  wf.Name,
+		File:      file,
+		Digest:    wf.Digest,
+		Status:    StatusPending,
+		Inputs:    inputs,
+		StartedAt: now.UTC(),
+	}
+	for _, s := range wf.Steps {
+		st.Steps = append(st.Steps, StepState{ID: s.ID, Status: StatusPending})
+	}
+	return st
+}
+
+// Resume prepares a stored run of wf to continue: finished steps keep their results and
+// the rest run again. Waits that were interrupted keep their original deadline.
+func (st *State) Resume(wf *Workflow) error {
+	if st.Digest != wf.Digest {
+		return fmt.Errorf("workflow file changed since run %s started; start a new run instead", st.RunID)
+	}
+	if st.Status == StatusSucceeded {
+		return fmt.Errorf("run %s already succeeded", st.RunID)
+	}
+	for i := range st.Steps {
+		s := &st.Steps[i]
+		switch s.Status {
+		case StatusSucceeded, StatusSkipped:
+			continue
+		case StatusFailed:
+			s.StartedAt = nil
+		}
+		s.Status, s.Attempts, s.FinishedAt, s.DurationMS, s.Error = StatusPending, 0, nil, 0, ""
+	}
+	st.Status, st.FinishedAt = StatusPending, nil
+	return nil
+}
+
+// Store keeps run state as one JSON file per run.
+type Store struct {
+	Dir string
+}
+
+// NewStore returns the store under configDir.
+func NewStore(configDir string) *Store {
+	return &Store{Dir: filepath.Join(configDir, DirName)}
+}
+
+func (s *Store) path(runID string) (string, error) {
+	if !runIDRe.MatchString(runID) {
+		return "", fmt.Errorf("invalid run ID %q", runID)
+	}
+	return filepath.Join(s.Dir, runID+".json"), nil
+}
+
+// Save writes st. The file is replaced atomically so an interrupted write never leaves
+// a partial state behind.
+func (s *Store) Save(st *State) error {
+	path, err := s.path(st.RunID)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(st, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode run state: %w", err)
+	}
+	if err := os.MkdirAll(s.Dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create runs directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(s.Dir, "."+st.RunID+"-*")
+	if err != nil {
+		return fmt.Errorf("failed to write run state: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write run state: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write run state: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write run state: %w", err)
+	}
+	return nil
+}
+
+// Load reads the state of runID.
+func (s *Store) Load(runID string) (*State, error) {
+	path, err := s.path(runID)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("run %s not found", runID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read run state: %w", err)
+	}
+	var st State
+	if err := json.Unmarshal(data, &st); err != nil {
+		return nil, fmt.Errorf("failed to decode run state: %w", err)
+	}
+	return &st, nil
+}
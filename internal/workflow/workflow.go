@@ -0,0 +1,242 @@
+// Package workflow parses and runs declarative YAML workflows whose steps are CLI
+// command lines. Steps reference earlier results with ${{ }} expressions, can be
+// conditional, retried or polled until a condition holds, and the run's state is
+// persisted after every step so an interrupted run can resume.
+package workflow
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Defaults applied to steps that leave these unset.
+const (
+	DefaultWaitInterval = 10 * time.Second
+	DefaultWaitTimeout  = 30 * time.Minute
+	DefaultRetryDelay   = time.Second
+)
+
+// stepIDRe matches step IDs. Dashes are excluded so IDs work unquoted in expressions
+// (steps.send_a.outputs, not steps.send-a.outputs).
+var stepIDRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Workflow is a parsed workflow file.
+type Workflow struct {
+	Name string `yaml:"name"`
+	// Inputs declares the inputs with their default values; --input overrides them.
+	Inputs map[string]string `yaml:"inputs"`
+	Steps  []Step            `yaml:"steps"`
+
+	// Digest identifies the file contents, so a run is only resumed against the same file.
+	Digest string `yaml:"-"`
+}
+
+// Step is one workflow step. Exactly one of Run and Wait is set.
+type Step struct {
+	ID string `yaml:"id"`
+	// If skips the step unless the expression is truthy.
+	If string `yaml:"if"`
+	// Run is the command line, without the leading "docuseal".
+	Run Command `yaml:"run"`
+	// Wait polls a command until a condition holds.
+	Wait *Wait `yaml:"wait"`
+	// Outputs names values derived from the result with expressions, where "." is the
+	// command's JSON result. Without it, the step's outputs are the result itself.
+	Outputs map[string]string `yaml:"outputs"`
+	Retry   *Retry            `yaml:"retry"`
+	// Timeout bounds each attempt of a run step.
+	Timeout time.Duration `yaml:"timeout"`
+	// ContinueOnError records a failure and goes on with the next step.
+	ContinueOnError bool `yaml:"continue_on_error"`
+}
+
+// Wait polls Run every Interval until Until is truthy for its result, failing after Timeout.
+type Wait struct {
+	Run      Command       `yaml:"run"`
+	Until    string        `yaml:"until"`
+	Interval time.Duration `yaml:"interval"`
+	Timeout  time.Duration `yaml:"timeout"`
+}
+
+// Retry reruns a failed command up to Attempts times in total, doubling Delay between
+// attempts.
+type Retry struct {
+	Attempts int           `yaml:"attempts"`
+	Delay    time.Duration `yaml:"delay"`
+}
+
+// Command is a command line given as a string (split like a shell would) or a list
+// of arguments. Expressions are expanded per argument, after splitting.
+type Command []string
+
+// UnmarshalYAML accepts a string or a sequence of strings.
+func (c *Command) UnmarshalYAML(node *yaml.Node) error {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		words, err := splitWords(node.Value)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", node.Line, err)
+		}
+		*c = words
+		return nil
+	case yaml.SequenceNode:
+		var words []string
+		if err := node.Decode(&words); err != nil {
+			return err
+		}
+		*c = words
+		return nil
+	default:
+		return fmt.Errorf("line %d: run must be a string or a list of arguments", node.Line)
+	}
+}
+
+// Parse decodes and validates a workflow. Unknown keys are errors so typos surface
+// before anything runs.
+func Parse(data []byte) (*Workflow, error) {
+	var wf Workflow
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&wf); err != nil {
+		return nil, fmt.Errorf("invalid workflow: %w", err)
+	}
+	if err := wf.validate(); err != nil {
+		return nil, fmt.Errorf("invalid workflow: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	wf.Digest = hex.EncodeToString(sum[:])
+	return &wf, nil
+}
+
+func (wf *Workflow) validate() error {
+	if len(wf.Steps) == 0 {
+		return fmt.Errorf("no steps")
+	}
+	for name := range wf.Inputs {
+		if !stepIDRe.MatchString(name) {
+			return fmt.Errorf("input %q: names may only contain letters, digits and underscores", name)
+		}
+	}
+	seen := map[string]bool{}
+	for i, s := range wf.Steps {
+		label := fmt.Sprintf("step %d", i+1)
+		if s.ID != "" {
+			label = fmt.Sprintf("step %q", s.ID)
+		}
+		switch {
+		case s.ID == "":
+			return fmt.Errorf("%s: id is required", label)
+		case !stepIDRe.MatchString(s.ID):
+			return fmt.Errorf("%s: id may only contain letters, digits and underscores", label)
+		case seen[s.ID]:
+			return fmt.Errorf("%s: duplicate id", label)
+		case (len(s.Run) > 0) == (s.Wait != nil):
+			return fmt.Errorf("%s: set exactly one of run and wait", label)
+		case s.Wait != nil && len(s.Wait.Run) == 0:
+			return fmt.Errorf("%s: wait.run is required", label)
+		case s.Wait != nil && strings.TrimSpace(s.Wait.Until) == "":
+			return fmt.Errorf("%s: wait.until is required", label)
+		case s.Retry != nil && s.Retry.Attempts < 1:
+			return fmt.Errorf("%s: retry.attempts must be >= 1", label)
+		case s.Timeout < 0, s.Wait != nil && (s.Wait.Interval < 0 || s.Wait.Timeout < 0):
+			return fmt.Errorf("%s: durations must not be negative", label)
+		}
+		seen[s.ID] = true
+
+		exprs := []string{s.If}
+		for _, arg := range append(append(Command{}, s.Run...), s.waitRun()...) {
+			inner, err := templateExprs(arg)
+			if err != nil {
+				return fmt.Errorf("%s: %w", label, err)
+			}
+			exprs = append(exprs, inner...)
+		}
+		if s.Wait != nil {
+			exprs = append(exprs, s.Wait.Until)
+		}
+		for _, e := range s.Outputs {
+			exprs = append(exprs, e)
+		}
+		for _, e := range exprs {
+			if _, err := compile(e); err != nil {
+				return fmt.Errorf("%s: %w", label, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Step) waitRun() Command {
+	if s.Wait == nil {
+		return nil
+	}
+	return s.Wait.Run
+}
+
+// splitWords splits a command line like a POSIX shell: whitespace separates words,
+// quotes group them, and a backslash escapes the next character outside single quotes.
+// A ${{ }} expression is kept whole, spaces included, outside single quotes.
+func splitWords(line string) ([]string, error) {
+	var words []string
+	var cur strings.Builder
+	inWord := false
+	var quote rune
+
+	runes := []rune(line)
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		switch {
+		case quote == '\'':
+			if c == '\'' {
+				quote = 0
+			} else {
+				cur.WriteRune(c)
+			}
+		case c == '$' && strings.HasPrefix(string(runes[i:]), "${{"):
+			end := strings.Index(string(runes[i:]), "}}")
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated ${{ in %q", line)
+			}
+			expr := []rune(string(runes[i:])[:end+2])
+			cur.WriteString(string(expr))
+			i += len(expr) - 1
+			inWord = true
+		case c == '\\' && i+1 < len(runes):
+			i++
+			cur.WriteRune(runes[i])
+			inWord = true
+		case quote == '"':
+			if c == '"' {
+				quote = 0
+			} else {
+				cur.WriteRune(c)
+			}
+		case c == '\'' || c == '"':
+			quote = c
+			inWord = true
+		case c == ' ' || c == '\t' || c == '\n':
+			if inWord {
+				words = append(words, cur.String())
+				cur.Reset()
+				inWord = false
+			}
+		default:
+			cur.WriteRune(c)
+			inWord = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote", quote)
+	}
+	if inWord {
+		words = append(words, cur.String())
+	}
+	return words, nil
+}
@@ -0,0 +1,309 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParse_Validation(t *testing.T) {
+	tests := []struct {
+		name, yaml, want string
+	}{
+		{"no steps", `name: x`, "no steps"},
+		{"unknown key", "steps:\n  - id: a\n    rn: version", "field rn not found"},
+		{"missing id", "steps:\n  - run: version", "id is required"},
+		{"dashed id", "steps:\n  - id: send-a\n    run: version", "may only contain"},
+		{"duplicate id", "steps:\n  - id: a\n    run: version\n  - id: a\n    run: version", "duplicate id"},
+		{"run and wait", "steps:\n  - id: a\n    run: version\n    wait: {run: version, until: 'true'}", "exactly one of run and wait"},
+		{"wait without until", "steps:\n  - id: a\n    wait: {run: version}", "wait.until is required"},
+		{"zero attempts", "steps:\n  - id: a\n    run: version\n    retry: {attempts: 0}", "retry.attempts"},
+		{"bad expression", "steps:\n  - id: a\n    run: get ${{ steps. }}", "invalid expression"},
+		{"unterminated", "steps:\n  - id: a\n    run: get ${{ steps.a", "unterminated"},
+		{"bad if", "steps:\n  - id: a\n    if: ((\n    run: version", "invalid expression"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.yaml))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("Parse() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitWords(t *testing.T) {
+	tests := []struct {
+		line string
+		want []string
+	}{
+		{`templates list  --limit 5`, []string{"templates", "list", "--limit", "5"}},
+		{`create --html '<p>I "agree"</p>'`, []string{"create", "--html", `<p>I "agree"</p>`}},
+		{`get ${{ steps.a.outputs.id }}`, []string{"get", "${{ steps.a.outputs.id }}"}},
+		{`--submitters "${{ inputs.to }}:Signer"`, []string{"--submitters", "${{ inputs.to }}:Signer"}},
+		{`--name a\ b`, []string{"--name", "a b"}},
+	}
+	for _, tt := range tests {
+		got, err := splitWords(tt.line)
+		if err != nil || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("splitWords(%q) = %q, %v, want %q", tt.line, got, err, tt.want)
+		}
+	}
+	if _, err := splitWords(`echo "open`); err == nil {
+		t.Error("splitWords() with unterminated quote: error = nil")
+	}
+}
+
+// fakeClock advances only when the runner sleeps.
+type fakeClock struct{ t time.Time }
+
+func (c *fakeClock) Now() time.Time { return c.t }
+
+func (c *fakeClock) Sleep(ctx context.Context, d time.Duration) error {
+	c.t = c.t.Add(d)
+	return ctx.Err()
+}
+
+func newTestRunner(exec func(args []string) (any, error)) (*Runner, *[]string) {
+	clock := &fakeClock{t: time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)}
+	var calls []string
+	return &Runner{
+		Exec: func(_ context.Context, args []string) (any, error) {
+			calls = append(calls, strings.Join(args, " "))
+			return exec(args)
+		},
+		Now:   clock.Now,
+		Sleep: clock.Sleep,
+	}, &calls
+}
+
+func mustParse(t *testing.T, src string) *Workflow {
+	t.Helper()
+	wf, err := Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	return wf
+}
+
+func TestRun_OutputsAndConditions(t *testing.T) {
+	wf := mustParse(t, `
+name: nda
+inputs:
+  signer: jane@example.com
+steps:
+  - id: create
+    run: templates create-html --name NDA
+  - id: send
+    run: submissions create --template-id ${{ steps.create.outputs.id }} --submitters "${{ inputs.signer }}:Signer"
+    outputs:
+      submission_id: .[0].submission_id
+  - id: skipped
+    if: inputs.signer == "nobody"
+    run: version
+  - id: docs
+    if: ${{ steps.skipped.status == "skipped" }}
+    run: [submissions, documents, "${{ steps.send.outputs.submission_id }}"]
+`)
+	r, calls := newTestRunner(func(args []string) (any, error) {
+		switch args[0] + " " + args[1] {
+		case "templates create-html":
+			return map[string]any{"id": 42.0}, nil
+		case "submissions create":
+			return []any{map[string]any{"submission_id": 7.0}}, nil
+		}
+		return map[string]any{"ok": true}, nil
+	})
+	st := NewState("r1", "nda.yaml", wf, map[string]string{"signer": "bob@example.com"}, r.Now())
+	if err := r.Run(context.Background(), wf, st); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	want := []string{
+		"templates create-html --name NDA",
+		"submissions create --template-id 42 --submitters bob@example.com:Signer",
+		"submissions documents 7",
+	}
+	if !reflect.DeepEqual(*calls, want) {
+		t.Fatalf("calls = %q, want %q", *calls, want)
+	}
+	if st.Status != StatusSucceeded || st.FinishedAt == nil {
+		t.Fatalf("run status = %s, finished = %v", st.Status, st.FinishedAt)
+	}
+	statuses := []string{}
+	for _, s := range st.Steps {
+		statuses = append(statuses, s.Status)
+	}
+	if want := []string{StatusSucceeded, StatusSucceeded, StatusSkipped, StatusSucceeded}; !reflect.DeepEqual(statuses, want) {
+		t.Fatalf("step statuses = %v, want %v", statuses, want)
+	}
+	if got := st.Steps[1].Outputs; !reflect.DeepEqual(got, map[string]any{"submission_id": 7.0}) {
+		t.Fatalf("send outputs = %v", got)
+	}
+}
+
+func TestRun_Retry(t *testing.T) {
+	wf := mustParse(t, `
+steps:
+  - id: flaky
+    run: templates list
+    retry: {attempts: 3, delay: 2s}
+`)
+	clock := &fakeClock{}
+	n := 0
+	r, _ := newTestRunner(func([]string) (any, error) {
+		if n++; n < 3 {
+			return nil, errors.New("boom")
+		}
+		return []any{}, nil
+	})
+	var slept []time.Duration
+	r.Sleep = func(ctx context.Context, d time.Duration) error {
+		slept = append(slept, d)
+		return clock.Sleep(ctx, d)
+	}
+	st := NewState("r1", "", wf, nil, r.Now())
+	if err := r.Run(context.Background(), wf, st); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if st.Steps[0].Attempts != 3 || !reflect.DeepEqual(slept, []time.Duration{2 * time.Second, 4 * time.Second}) {
+		t.Fatalf("attempts = %d, sleeps = %v", st.Steps[0].Attempts, slept)
+	}
+}
+
+func TestRun_FailureAndContinueOnError(t *testing.T) {
+	wf := mustParse(t, `
+steps:
+  - id: optional
+    run: templates get 1
+    continue_on_error: true
+  - id: required
+    run: templates get 2
+  - id: after
+    run: templates get 3
+`)
+	r, calls := newTestRunner(func([]string) (any, error) { return nil, errors.New("not found") })
+	st := NewState("r1", "", wf, nil, r.Now())
+	err := r.Run(context.Background(), wf, st)
+
+	var stepErr *StepError
+	if !errors.As(err, &stepErr) || stepErr.Step != "required" {
+		t.Fatalf("Run() error = %v, want StepError for required", err)
+	}
+	if st.Status != StatusFailed || st.Steps[0].Status != StatusFailed || st.Steps[2].Status != StatusPending {
+		t.Fatalf("state = %s %+v", st.Status, st.Steps)
+	}
+	if len(*calls) != 2 {
+		t.Fatalf("calls = %q, want 2", *calls)
+	}
+}
+
+func TestRun_WaitTimesOutAndResumes(t *testing.T) {
+	wf := mustParse(t, `
+steps:
+  - id: send
+    run: submissions create
+    outputs: {id: .id}
+  - id: signed
+    wait:
+      run: submissions get ${{ steps.send.outputs.id }}
+      until: ${{ .status == "completed" }}
+      interval: 1m
+      timeout: 5m
+`)
+	status := "pending"
+	r, calls := newTestRunner(func(args []string) (any, error) {
+		if args[1] == "create" {
+			return map[string]any{"id": 9.0}, nil
+		}
+		return map[string]any{"status": status}, nil
+	})
+	st := NewState("r1", "", wf, nil, r.Now())
+	err := r.Run(context.Background(), wf, st)
+	if err == nil || !strings.Contains(err.Error(), "timed out after 5m0s") {
+		t.Fatalf("Run() error = %v, want timeout", err)
+	}
+	if polls := st.Steps[1].Polls; polls != 5 {
+		t.Fatalf("polls = %d, want 5", polls)
+	}
+
+	if err := st.Resume(wf); err != nil {
+		t.Fatalf("Resume() error = %v", err)
+	}
+	status, *calls = "completed", nil
+	if err := r.Run(context.Background(), wf, st); err != nil {
+		t.Fatalf("resumed Run() error = %v", err)
+	}
+	// The finished create step is not rerun, and its outputs still feed the wait.
+	if want := []string{"submissions get 9"}; !reflect.DeepEqual(*calls, want) {
+		t.Fatalf("resumed calls = %q, want %q", *calls, want)
+	}
+	if st.Status != StatusSucceeded {
+		t.Fatalf("resumed status = %s", st.Status)
+	}
+}
+
+func TestRun_InterruptedWaitKeepsDeadline(t *testing.T) {
+	wf := mustParse(t, `
+steps:
+  - id: signed
+    wait: {run: submissions get 1, until: .done, interval: 1m, timeout: 10m}
+`)
+	r, _ := newTestRunner(func([]string) (any, error) { return map[string]any{"done": false}, nil })
+	ctx, cancel := context.WithCancel(context.Background())
+	polls := 0
+	sleep := r.Sleep
+	r.Sleep = func(_ context.Context, d time.Duration) error {
+		if polls++; polls == 2 {
+			cancel()
+		}
+		return sleep(ctx, d)
+	}
+	st := NewState("r1", "", wf, nil, r.Now())
+	if err := r.Run(ctx, wf, st); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Run() error = %v, want context.Canceled", err)
+	}
+	if st.Status != StatusInterrupted || st.Steps[0].Status != StatusInterrupted {
+		t.Fatalf("state = %s %+v", st.Status, st.Steps[0])
+	}
+	started := *st.Steps[0].StartedAt
+
+	if err := st.Resume(wf); err != nil {
+		t.Fatalf("Resume() error = %v", err)
+	}
+	if st.Steps[0].StartedAt == nil || !st.Steps[0].StartedAt.Equal(started) {
+		t.Fatalf("resumed StartedAt = %v, want %v", st.Steps[0].StartedAt, started)
+	}
+}
+
+func TestStore_RoundTripAndResumeChecks(t *testing.T) {
+	wf := mustParse(t, "steps:\n  - id: a\n    run: version\n")
+	store := NewStore(t.TempDir())
+	st := NewState("20260105T090000-abcd1234", "wf.yaml", wf, map[string]string{}, time.Now())
+	st.Status = StatusFailed
+	if err := store.Save(st); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	got, err := store.Load(st.RunID)
+	if err != nil || got.Digest != wf.Digest || len(got.Steps) != 1 {
+		t.Fatalf("Load() = %+v, %v", got, err)
+	}
+	if _, err := store.Load("../x"); err == nil {
+		t.Fatal("Load() of an invalid run ID: error = nil")
+	}
+	if _, err := store.Load("missing"); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("Load() of a missing run: error = %v", err)
+	}
+
+	changed := mustParse(t, "steps:\n  - id: a\n    run: version --short\n")
+	if err := got.Resume(changed); err == nil || !strings.Contains(err.Error(), "changed") {
+		t.Fatalf("Resume() with a changed file: error = %v", err)
+	}
+	got.Status = StatusSucceeded
+	if err := got.Resume(wf); err == nil {
+		t.Fatal("Resume() of a succeeded run: error = nil")
+	}
+}